@@ -0,0 +1,192 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+// Package audit lets the holders of the shares of a threshold key prove to an auditor that they still hold them,
+// without signing a message and without revealing anything about the shares.
+//
+// The auditor sends a fresh challenge to every holder, who answers with a Schnorr proof of knowledge of its share Xi
+// for its public share BigXj that is bound to the challenge, the public key and its share ID. The proofs are
+// collected in a Report, which anyone with the public key data, e.g. an outside auditor, can check for the challenge
+// it issued. A proof made for one challenge does not verify for another, so old proofs cannot be replayed.
+package audit
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+
+	"github.com/bnb-chain/tss-lib/crypto"
+	"github.com/bnb-chain/tss-lib/crypto/schnorr"
+	"github.com/bnb-chain/tss-lib/crypto/transcript"
+	ecdsakeygen "github.com/bnb-chain/tss-lib/ecdsa/keygen"
+	eddsakeygen "github.com/bnb-chain/tss-lib/eddsa/keygen"
+	"github.com/bnb-chain/tss-lib/tss"
+)
+
+const (
+	// ChallengeLen is the length in bytes of the challenges made by NewChallenge
+	ChallengeLen = 32
+
+	protocol = "share-audit"
+)
+
+type (
+	// PublicKeyData is the public part of the key data that the proofs are checked against
+	PublicKeyData struct {
+		PubKey *crypto.ECPoint
+		Ks     []*big.Int
+		BigXj  []*crypto.ECPoint
+	}
+
+	// Proof proves that the holder of the share ShareID knows the secret share for its public share
+	Proof struct {
+		ShareID *big.Int
+		Proof   *schnorr.ZKProof
+	}
+
+	// Report collects the proofs of the holders of the shares of PubKey for one challenge. It can be marshalled to
+	// JSON to be handed to an outside auditor.
+	Report struct {
+		Challenge []byte
+		PubKey    *crypto.ECPoint
+		Proofs    []*Proof
+	}
+)
+
+// NewChallenge returns a fresh challenge for an audit, which the auditor should not reuse
+func NewChallenge(rand io.Reader) ([]byte, error) {
+	challenge := make([]byte, ChallengeLen)
+	if _, err := io.ReadFull(rand, challenge); err != nil {
+		return nil, err
+	}
+	return challenge, nil
+}
+
+// FromECDSAKey returns the public key data of ECDSA key data
+func FromECDSAKey(key ecdsakeygen.LocalPartySaveData) PublicKeyData {
+	return PublicKeyData{PubKey: key.ECDSAPub, Ks: key.Ks, BigXj: key.BigXj}
+}
+
+// FromEdDSAKey returns the public key data of EdDSA key data
+func FromEdDSAKey(key eddsakeygen.LocalPartySaveData) PublicKeyData {
+	return PublicKeyData{PubKey: key.EDDSAPub, Ks: key.Ks, BigXj: key.BigXj}
+}
+
+// Prove answers the auditor's challenge with a proof of knowledge of the share `xi` of the share ID `shareID`. It
+// fails if the share does not match its public share in `data`, so a holder also learns that its share is broken.
+func Prove(challenge []byte, data PublicKeyData, shareID, xi *big.Int, rand io.Reader) (*Proof, error) {
+	if len(challenge) == 0 {
+		return nil, errors.New("the challenge must not be empty")
+	}
+	if shareID == nil || xi == nil {
+		return nil, errors.New("the share ID and the share must not be nil")
+	}
+	if err := data.validate(); err != nil {
+		return nil, err
+	}
+	j := data.index(shareID)
+	if j < 0 {
+		return nil, errors.New("the share ID is not one of the key")
+	}
+	BigXi := data.BigXj[j]
+	if !crypto.ScalarBaseMult(BigXi.Curve(), xi).Equals(BigXi) {
+		return nil, errors.New("the share does not match its public share")
+	}
+	pf, err := schnorr.NewZKProof(proofTranscript(challenge, data.PubKey, shareID), xi, BigXi, rand)
+	if err != nil {
+		return nil, err
+	}
+	return &Proof{ShareID: shareID, Proof: pf}, nil
+}
+
+// Verify checks the proof for the challenge against the public key data
+func (pf *Proof) Verify(challenge []byte, data PublicKeyData) error {
+	if pf == nil || pf.ShareID == nil {
+		return errors.New("the proof has no share ID")
+	}
+	if err := data.validate(); err != nil {
+		return err
+	}
+	j := data.index(pf.ShareID)
+	if j < 0 {
+		return fmt.Errorf("share %s is not one of the key", pf.ShareID)
+	}
+	if !pf.Proof.Verify(proofTranscript(challenge, data.PubKey, pf.ShareID), data.BigXj[j]) {
+		return fmt.Errorf("the proof for share %s failed to verify", pf.ShareID)
+	}
+	return nil
+}
+
+// Verify checks the report for the challenge that the auditor issued against the public key data, which the
+// auditor should take from its own records rather than from the report. Every share of the key must be proven.
+func (r *Report) Verify(challenge []byte, data PublicKeyData) error {
+	if len(challenge) == 0 || !bytes.Equal(r.Challenge, challenge) {
+		return errors.New("the report is not for this challenge")
+	}
+	if err := data.validate(); err != nil {
+		return err
+	}
+	if r.PubKey == nil || !r.PubKey.Equals(data.PubKey) {
+		return errors.New("the report is not for this public key")
+	}
+	proven := make([]bool, len(data.Ks))
+	for _, pf := range r.Proofs {
+		if err := pf.Verify(challenge, data); err != nil {
+			return err
+		}
+		j := data.index(pf.ShareID)
+		if proven[j] {
+			return fmt.Errorf("share %s is proven more than once", pf.ShareID)
+		}
+		proven[j] = true
+	}
+	for j, ok := range proven {
+		if !ok {
+			return fmt.Errorf("share %s is not proven", data.Ks[j])
+		}
+	}
+	return nil
+}
+
+// ----- //
+
+func (data PublicKeyData) validate() error {
+	if data.PubKey == nil || !data.PubKey.ValidateBasic() {
+		return errors.New("the public key is not a valid curve point")
+	}
+	if len(data.Ks) == 0 || len(data.Ks) != len(data.BigXj) {
+		return errors.New("the public key data must have one public share for each share ID")
+	}
+	for j, Xj := range data.BigXj {
+		if data.Ks[j] == nil || Xj == nil || !Xj.ValidateBasic() {
+			return fmt.Errorf("the public share %d is not a valid curve point", j)
+		}
+	}
+	return nil
+}
+
+// index returns the index of `shareID` in data.Ks, or -1 if it is not there
+func (data PublicKeyData) index(shareID *big.Int) int {
+	for j, k := range data.Ks {
+		if k.Cmp(shareID) == 0 {
+			return j
+		}
+	}
+	return -1
+}
+
+// proofTranscript binds a proof to the challenge, the curve and public key and the share ID of the prover
+func proofTranscript(challenge []byte, pub *crypto.ECPoint, shareID *big.Int) *transcript.Transcript {
+	t := transcript.New(protocol)
+	t.AppendMessage("challenge", challenge)
+	curveName, _ := tss.GetCurveName(pub.Curve())
+	t.AppendMessage("curve", []byte(curveName))
+	t.AppendPoints("pubkey", pub)
+	t.AppendInts("share", shareID)
+	return t
+}
@@ -0,0 +1,123 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package audit_test
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	. "github.com/bnb-chain/tss-lib/audit"
+	ecdsakeygen "github.com/bnb-chain/tss-lib/ecdsa/keygen"
+	eddsakeygen "github.com/bnb-chain/tss-lib/eddsa/keygen"
+	"github.com/bnb-chain/tss-lib/test"
+	"github.com/bnb-chain/tss-lib/tss"
+)
+
+// report collects the proofs of every holder for the challenge
+func report(t *testing.T, challenge []byte, data []PublicKeyData, shareIDs, xis []*big.Int) *Report {
+	r := &Report{Challenge: challenge, PubKey: data[0].PubKey}
+	for j := range data {
+		pf, err := Prove(challenge, data[j], shareIDs[j], xis[j], rand.Reader)
+		assert.NoError(t, err)
+		r.Proofs = append(r.Proofs, pf)
+	}
+	return r
+}
+
+func ecdsaReport(t *testing.T, challenge []byte) (*Report, []ecdsakeygen.LocalPartySaveData) {
+	keys, _, err := ecdsakeygen.LoadKeygenTestFixtures(test.TestParticipants)
+	assert.NoError(t, err, "should load keygen fixtures")
+	data := make([]PublicKeyData, len(keys))
+	shareIDs, xis := make([]*big.Int, len(keys)), make([]*big.Int, len(keys))
+	for j, key := range keys {
+		data[j], shareIDs[j], xis[j] = FromECDSAKey(key), key.ShareID, key.Xi
+	}
+	return report(t, challenge, data, shareIDs, xis), keys
+}
+
+func TestReportECDSA(t *testing.T) {
+	challenge, err := NewChallenge(rand.Reader)
+	assert.NoError(t, err)
+	r, keys := ecdsaReport(t, challenge)
+
+	// the auditor receives the report as JSON and checks it against its own copy of the public key data
+	bz, err := json.Marshal(r)
+	assert.NoError(t, err)
+	received := new(Report)
+	assert.NoError(t, json.Unmarshal(bz, received))
+	assert.NoError(t, received.Verify(challenge, FromECDSAKey(keys[0])))
+}
+
+func TestReportEdDSA(t *testing.T) {
+	// the EdDSA fixtures are stored without the name of their curve
+	tss.SetCurve(tss.Edwards())
+	defer tss.SetCurve(tss.S256())
+	keys, _, err := eddsakeygen.LoadKeygenTestFixtures(test.TestParticipants)
+	assert.NoError(t, err, "should load keygen fixtures")
+	challenge, err := NewChallenge(rand.Reader)
+	assert.NoError(t, err)
+	data := make([]PublicKeyData, len(keys))
+	shareIDs, xis := make([]*big.Int, len(keys)), make([]*big.Int, len(keys))
+	for j, key := range keys {
+		data[j], shareIDs[j], xis[j] = FromEdDSAKey(key), key.ShareID, key.Xi
+	}
+	r := report(t, challenge, data, shareIDs, xis)
+
+	bz, err := json.Marshal(r)
+	assert.NoError(t, err)
+	received := new(Report)
+	assert.NoError(t, json.Unmarshal(bz, received))
+	assert.NoError(t, received.Verify(challenge, FromEdDSAKey(keys[0])))
+}
+
+func TestReportForAnotherChallengeFails(t *testing.T) {
+	challenge, err := NewChallenge(rand.Reader)
+	assert.NoError(t, err)
+	r, keys := ecdsaReport(t, challenge)
+	data := FromECDSAKey(keys[0])
+
+	// an old report cannot be passed off as the answer to a new challenge, with or without its challenge replaced
+	newChallenge, err := NewChallenge(rand.Reader)
+	assert.NoError(t, err)
+	assert.Error(t, r.Verify(newChallenge, data))
+	r.Challenge = newChallenge
+	assert.Error(t, r.Verify(newChallenge, data))
+}
+
+func TestReportMustProveEveryShare(t *testing.T) {
+	challenge, err := NewChallenge(rand.Reader)
+	assert.NoError(t, err)
+	r, keys := ecdsaReport(t, challenge)
+	data := FromECDSAKey(keys[0])
+	proofs := r.Proofs
+
+	r.Proofs = proofs[1:]
+	assert.Error(t, r.Verify(challenge, data), "a missing proof should be rejected")
+	r.Proofs = append([]*Proof{proofs[1]}, proofs[1:]...)
+	assert.Error(t, r.Verify(challenge, data), "a proof should not count twice")
+
+	// a proof cannot be claimed for the share of another holder
+	r.Proofs = append([]*Proof(nil), proofs...)
+	r.Proofs[0] = &Proof{ShareID: proofs[0].ShareID, Proof: proofs[1].Proof}
+	assert.Error(t, r.Verify(challenge, data))
+}
+
+func TestProveRejectsWrongShare(t *testing.T) {
+	keys, _, err := ecdsakeygen.LoadKeygenTestFixtures(2)
+	assert.NoError(t, err, "should load keygen fixtures")
+	challenge, err := NewChallenge(rand.Reader)
+	assert.NoError(t, err)
+
+	_, err = Prove(challenge, FromECDSAKey(keys[0]), keys[0].ShareID, keys[1].Xi, rand.Reader)
+	assert.Error(t, err, "a share that does not match its public share should not be proven")
+	_, err = Prove(challenge, FromECDSAKey(keys[0]), big.NewInt(1), keys[0].Xi, rand.Reader)
+	assert.Error(t, err, "a share ID that is not one of the key should be rejected")
+}
@@ -0,0 +1,65 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package common
+
+import (
+	"crypto/sha256"
+	"fmt"
+
+	"golang.org/x/crypto/sha3"
+)
+
+// MessageHash selects how a raw message is hashed before it is signed
+type MessageHash int
+
+const (
+	// MessageHashNone signs the message bytes as given, e.g. a digest computed by the caller or a pure Ed25519 message
+	MessageHashNone MessageHash = iota
+	// MessageHashSHA256 signs SHA-256(message)
+	MessageHashSHA256
+	// MessageHashKeccak256 signs the Ethereum flavour of SHA-3, Keccak-256(message)
+	MessageHashKeccak256
+	// MessageHashDoubleSHA256 signs SHA-256(SHA-256(message)), as Bitcoin does
+	MessageHashDoubleSHA256
+)
+
+// Digest returns the bytes to be signed for the message. The result is never shorter than the hash output,
+// so leading zero bytes are preserved.
+func (h MessageHash) Digest(msg []byte) ([]byte, error) {
+	switch h {
+	case MessageHashNone:
+		return append([]byte{}, msg...), nil
+	case MessageHashSHA256:
+		digest := sha256.Sum256(msg)
+		return digest[:], nil
+	case MessageHashKeccak256:
+		state := sha3.NewLegacyKeccak256()
+		state.Write(msg)
+		return state.Sum(nil), nil
+	case MessageHashDoubleSHA256:
+		first := sha256.Sum256(msg)
+		digest := sha256.Sum256(first[:])
+		return digest[:], nil
+	default:
+		return nil, fmt.Errorf("unknown message hash %d", h)
+	}
+}
+
+func (h MessageHash) String() string {
+	switch h {
+	case MessageHashNone:
+		return "none"
+	case MessageHashSHA256:
+		return "SHA-256"
+	case MessageHashKeccak256:
+		return "Keccak-256"
+	case MessageHashDoubleSHA256:
+		return "double SHA-256"
+	default:
+		return fmt.Sprintf("MessageHash(%d)", int(h))
+	}
+}
@@ -0,0 +1,38 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package common_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bnb-chain/tss-lib/common"
+)
+
+func TestMessageHashDigest(t *testing.T) {
+	tests := []struct {
+		hash common.MessageHash
+		msg  string
+		want string
+	}{
+		{common.MessageHashNone, "0000ff", "0000ff"},
+		{common.MessageHashSHA256, hex.EncodeToString([]byte("abc")), "ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad"},
+		{common.MessageHashKeccak256, "", "c5d2460186f7233c927e7db2dcc703c0e500b653ca82273b7bfad8045d85a470"},
+		{common.MessageHashDoubleSHA256, "", "5df6e0e2761359d30a8275058e299fcc0381534545f55cf43e41983f5d4c9456"},
+	}
+	for _, test := range tests {
+		msg, err := hex.DecodeString(test.msg)
+		assert.NoError(t, err)
+		digest, err := test.hash.Digest(msg)
+		assert.NoError(t, err)
+		assert.Equal(t, test.want, hex.EncodeToString(digest), "%s digest", test.hash)
+	}
+	_, err := common.MessageHash(-1).Digest(nil)
+	assert.Error(t, err)
+}
@@ -7,9 +7,14 @@
 package common
 
 import (
-	"crypto/rand"
+	"crypto/aes"
+	"crypto/cipher"
+	crand "crypto/rand"
+	"crypto/sha256"
 	"fmt"
+	"io"
 	"math/big"
+	"sync"
 
 	"github.com/pkg/errors"
 )
@@ -18,8 +23,13 @@ const (
 	mustGetRandomIntMaxBits = 5000
 )
 
-// MustGetRandomInt panics if it is unable to gather entropy from `rand.Reader` or when `bits` is <= 0
+// MustGetRandomInt panics if it is unable to gather entropy from `crypto/rand` or when `bits` is <= 0
 func MustGetRandomInt(bits int) *big.Int {
+	return MustGetRandomIntWithRandom(crand.Reader, bits)
+}
+
+// MustGetRandomIntWithRandom panics if it is unable to gather entropy from `rand` or when `bits` is <= 0
+func MustGetRandomIntWithRandom(rand io.Reader, bits int) *big.Int {
 	if bits <= 0 || mustGetRandomIntMaxBits < bits {
 		panic(fmt.Errorf("MustGetRandomInt: bits should be positive, non-zero and less than %d", mustGetRandomIntMaxBits))
 	}
@@ -28,7 +38,7 @@ func MustGetRandomInt(bits int) *big.Int {
 	max = max.Exp(two, big.NewInt(int64(bits)), nil).Sub(max, one)
 
 	// Generate cryptographically strong pseudo-random int between 0 - max
-	n, err := rand.Int(rand.Reader, max)
+	n, err := crand.Int(rand, max)
 	if err != nil {
 		panic(errors.Wrap(err, "rand.Int failure in MustGetRandomInt!"))
 	}
@@ -36,12 +46,16 @@ func MustGetRandomInt(bits int) *big.Int {
 }
 
 func GetRandomPositiveInt(lessThan *big.Int) *big.Int {
+	return GetRandomPositiveIntWithRandom(crand.Reader, lessThan)
+}
+
+func GetRandomPositiveIntWithRandom(rand io.Reader, lessThan *big.Int) *big.Int {
 	if lessThan == nil || zero.Cmp(lessThan) != -1 {
 		return nil
 	}
 	var try *big.Int
 	for {
-		try = MustGetRandomInt(lessThan.BitLen())
+		try = MustGetRandomIntWithRandom(rand, lessThan.BitLen())
 		if try.Cmp(lessThan) < 0 && try.Cmp(zero) >= 0 {
 			break
 		}
@@ -50,18 +64,32 @@ func GetRandomPositiveInt(lessThan *big.Int) *big.Int {
 }
 
 func GetRandomPrimeInt(bits int) *big.Int {
-	if bits <= 0 {
+	return GetRandomPrimeIntWithRandom(crand.Reader, bits)
+}
+
+func GetRandomPrimeIntWithRandom(rand io.Reader, bits int) *big.Int {
+	if bits < 2 {
 		return nil
 	}
-	try, err := rand.Prime(rand.Reader, bits)
-	if err != nil ||
-		try.Cmp(zero) == 0 {
-		// fallback to older method
-		for {
-			try = MustGetRandomInt(bits)
-			if probablyPrime(try) {
-				break
-			}
+	if rand == crand.Reader {
+		try, err := crand.Prime(rand, bits)
+		if err != nil {
+			panic(errors.Wrap(err, "rand.Prime failure in GetRandomPrimeInt!"))
+		}
+		return try
+	}
+	// crypto/rand.Prime does not read from other readers than crypto/rand.Reader, so the candidates are drawn from
+	// `rand` and sieved as in safe_prime.go. As in rand.Prime, the top two bits are set so that the product of two
+	// such primes has exactly 2*bits bits. Below 8 bits the candidates may be the small primes of the sieve, so they
+	// are only tested for primality.
+	var try *big.Int
+	for {
+		try = MustGetRandomIntWithRandom(rand, bits)
+		try.SetBit(try, bits-1, 1)
+		try.SetBit(try, bits-2, 1)
+		try.SetBit(try, 0, 1)
+		if (bits < 8 || isPrimeCandidate(try)) && probablyPrime(try) {
+			break
 		}
 	}
 	return try
@@ -70,12 +98,16 @@ func GetRandomPrimeInt(bits int) *big.Int {
 // Generate a random element in the group of all the elements in Z/nZ that
 // has a multiplicative inverse.
 func GetRandomPositiveRelativelyPrimeInt(n *big.Int) *big.Int {
+	return GetRandomPositiveRelativelyPrimeIntWithRandom(crand.Reader, n)
+}
+
+func GetRandomPositiveRelativelyPrimeIntWithRandom(rand io.Reader, n *big.Int) *big.Int {
 	if n == nil || zero.Cmp(n) != -1 {
 		return nil
 	}
 	var try *big.Int
 	for {
-		try = MustGetRandomInt(n.BitLen())
+		try = MustGetRandomIntWithRandom(rand, n.BitLen())
 		if IsNumberInMultiplicativeGroup(n, try) {
 			break
 		}
@@ -92,11 +124,64 @@ func IsNumberInMultiplicativeGroup(n, v *big.Int) bool {
 		gcd.GCD(nil, nil, v, n).Cmp(one) == 0
 }
 
-//  Return a random generator of RQn with high probability.
-//  THIS METHOD ONLY WORKS IF N IS THE PRODUCT OF TWO SAFE PRIMES!
+//	Return a random generator of RQn with high probability.
+//	THIS METHOD ONLY WORKS IF N IS THE PRODUCT OF TWO SAFE PRIMES!
+//
 // https://github.com/didiercrunch/paillier/blob/d03e8850a8e4c53d04e8016a2ce8762af3278b71/utils.go#L39
 func GetRandomGeneratorOfTheQuadraticResidue(n *big.Int) *big.Int {
-	f := GetRandomPositiveRelativelyPrimeInt(n)
+	return GetRandomGeneratorOfTheQuadraticResidueWithRandom(crand.Reader, n)
+}
+
+func GetRandomGeneratorOfTheQuadraticResidueWithRandom(rand io.Reader, n *big.Int) *big.Int {
+	f := GetRandomPositiveRelativelyPrimeIntWithRandom(rand, n)
 	fSq := new(big.Int).Mul(f, f)
 	return fSq.Mod(fSq, n)
 }
+
+// ----- //
+
+type deterministicRandom struct {
+	mtx    sync.Mutex
+	stream cipher.Stream
+}
+
+// NewDeterministicRandom returns a randomness source that always produces the same stream of bytes for the same seed.
+// It is safe for concurrent use. It exists so that tests can produce known-answer vectors and replay a failed session;
+// it must never be used in production, where `crypto/rand.Reader` should be used instead.
+func NewDeterministicRandom(seed []byte) io.Reader {
+	key := sha256.Sum256(seed)
+	block, err := aes.NewCipher(key[:])
+	if err != nil {
+		panic(errors.Wrap(err, "aes.NewCipher failure in NewDeterministicRandom!"))
+	}
+	return &deterministicRandom{stream: cipher.NewCTR(block, make([]byte, aes.BlockSize))}
+}
+
+func (r *deterministicRandom) Read(p []byte) (int, error) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	for i := range p {
+		p[i] = 0
+	}
+	r.stream.XORKeyStream(p, p)
+	return len(p), nil
+}
+
+// ForkRandom returns `n` randomness sources derived from `rand` for use by `n` concurrent goroutines.
+// When goroutines share a deterministic source, the bytes each one receives depend on scheduling, so every fork is
+// instead seeded by reading from `rand` in order. `crypto/rand.Reader` is returned as-is for every fork.
+func ForkRandom(rand io.Reader, n int) ([]io.Reader, error) {
+	forks := make([]io.Reader, n)
+	for i := range forks {
+		if rand == crand.Reader {
+			forks[i] = rand
+			continue
+		}
+		seed := make([]byte, sha256.Size)
+		if _, err := io.ReadFull(rand, seed); err != nil {
+			return nil, errors.Wrap(err, "ForkRandom: unable to seed a fork")
+		}
+		forks[i] = NewDeterministicRandom(seed)
+	}
+	return forks, nil
+}
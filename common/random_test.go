@@ -7,6 +7,9 @@
 package common_test
 
 import (
+	"bytes"
+	"crypto/rand"
+	"io"
 	"math/big"
 	"testing"
 
@@ -45,3 +48,60 @@ func TestGetRandomPrimeInt(t *testing.T) {
 	assert.NotZero(t, prime, "rand prime should not be zero")
 	assert.True(t, prime.ProbablyPrime(50), "rand prime should be prime")
 }
+
+func TestGetRandomPrimeIntDeterministic(t *testing.T) {
+	prime1 := common.GetRandomPrimeIntWithRandom(common.NewDeterministicRandom([]byte("seed")), randomIntBitLen)
+	prime2 := common.GetRandomPrimeIntWithRandom(common.NewDeterministicRandom([]byte("seed")), randomIntBitLen)
+	prime3 := common.GetRandomPrimeIntWithRandom(common.NewDeterministicRandom([]byte("other")), randomIntBitLen)
+	assert.True(t, prime1.ProbablyPrime(50), "rand prime should be prime")
+	assert.Equal(t, randomIntBitLen, prime1.BitLen(), "rand prime should have the requested length")
+	assert.Equal(t, 0, prime1.Cmp(prime2), "the same seed should produce the same prime")
+	assert.NotEqual(t, 0, prime1.Cmp(prime3), "different seeds should produce different primes")
+}
+
+func TestGetRandomPrimeIntProductBitLen(t *testing.T) {
+	for _, r := range []io.Reader{rand.Reader, common.NewDeterministicRandom([]byte("seed"))} {
+		p := common.GetRandomPrimeIntWithRandom(r, randomIntBitLen/2)
+		q := common.GetRandomPrimeIntWithRandom(r, randomIntBitLen/2)
+		assert.Equal(t, randomIntBitLen, new(big.Int).Mul(p, q).BitLen(), "the product of two primes should have the sum of their lengths")
+	}
+}
+
+func TestGetRandomPrimeIntSmallSizes(t *testing.T) {
+	assert.Nil(t, common.GetRandomPrimeIntWithRandom(common.NewDeterministicRandom([]byte("seed")), 1))
+	for bits := 2; bits <= 16; bits++ {
+		for _, r := range []io.Reader{rand.Reader, common.NewDeterministicRandom([]byte("seed"))} {
+			prime := common.GetRandomPrimeIntWithRandom(r, bits)
+			assert.True(t, prime.ProbablyPrime(50), "rand prime should be prime")
+			assert.Equal(t, bits, prime.BitLen(), "rand prime should have the requested length")
+		}
+	}
+}
+
+func TestDeterministicRandom(t *testing.T) {
+	rnd1 := common.GetRandomPositiveIntWithRandom(common.NewDeterministicRandom([]byte("seed")), big.NewInt(1).Lsh(big.NewInt(1), randomIntBitLen))
+	rnd2 := common.GetRandomPositiveIntWithRandom(common.NewDeterministicRandom([]byte("seed")), big.NewInt(1).Lsh(big.NewInt(1), randomIntBitLen))
+	rnd3 := common.GetRandomPositiveIntWithRandom(common.NewDeterministicRandom([]byte("other")), big.NewInt(1).Lsh(big.NewInt(1), randomIntBitLen))
+	assert.Equal(t, 0, rnd1.Cmp(rnd2), "the same seed should produce the same stream")
+	assert.NotEqual(t, 0, rnd1.Cmp(rnd3), "different seeds should produce different streams")
+}
+
+func TestForkRandom(t *testing.T) {
+	forks1, err := common.ForkRandom(common.NewDeterministicRandom([]byte("seed")), 2)
+	assert.NoError(t, err)
+	forks2, err := common.ForkRandom(common.NewDeterministicRandom([]byte("seed")), 2)
+	assert.NoError(t, err)
+	bz := make([][]byte, 4)
+	for i, fork := range []io.Reader{forks1[1], forks1[0], forks2[0], forks2[1]} {
+		bz[i] = make([]byte, 32)
+		_, err = io.ReadFull(fork, bz[i])
+		assert.NoError(t, err)
+	}
+	assert.True(t, bytes.Equal(bz[1], bz[2]), "forks should not depend on the order they are read in")
+	assert.True(t, bytes.Equal(bz[0], bz[3]))
+	assert.False(t, bytes.Equal(bz[0], bz[1]), "forks should be independent")
+
+	forks, err := common.ForkRandom(rand.Reader, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, rand.Reader, forks[0])
+}
@@ -53,3 +53,16 @@ func TestGetRandomGermainPrimeConcurrent(t *testing.T) {
 		assert.True(t, sgp.Validate())
 	}
 }
+
+func TestGetRandomSafePrimesConcurrentDeterministic(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+	sgps1, err := GetRandomSafePrimesConcurrentWithRandom(ctx, 256, 2, 1, NewDeterministicRandom([]byte("seed")))
+	assert.NoError(t, err)
+	sgps2, err := GetRandomSafePrimesConcurrentWithRandom(ctx, 256, 2, 1, NewDeterministicRandom([]byte("seed")))
+	assert.NoError(t, err)
+	for i := range sgps1 {
+		assert.True(t, sgps1[i].Validate())
+		assert.Equal(t, 0, sgps1[i].SafePrime().Cmp(sgps2[i].SafePrime()))
+	}
+}
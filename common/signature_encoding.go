@@ -0,0 +1,134 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package common
+
+import (
+	"encoding/asn1"
+	"encoding/base64"
+	"errors"
+	"math/big"
+
+	"github.com/btcsuite/btcd/btcec"
+)
+
+// Chain-specific encodings of an ECDSA SignatureData. The Bitcoin, Ethereum and Cosmos encoders are for secp256k1
+// signatures and always output a low-S signature, as those chains require, even if signing was run with
+// tss.Parameters.SetSkipLowS. DER and JOSE output S as it was signed.
+
+const (
+	bitcoinCompactSigMagicOffset  = 27
+	bitcoinCompactSigCompPubKey   = 4
+	ethereumSigRecoveryOffset     = 27
+	ethereumEIP155RecoveryOffset  = 35
+	secp256k1SignatureScalarBytes = 32
+)
+
+// DER returns the ASN.1 DER encoding of the signature (ECDSA-Sig-Value), as used in Bitcoin scripts and X.509.
+func (x *SignatureData) DER() ([]byte, error) {
+	r, s, err := x.rs()
+	if err != nil {
+		return nil, err
+	}
+	return asn1.Marshal(struct{ R, S *big.Int }{r, s})
+}
+
+// BitcoinCompact returns the 65-byte compact signature (header || R || S) used for Bitcoin message signing,
+// from which btcec.RecoverCompact recovers the public key.
+func (x *SignatureData) BitcoinCompact(compressedPubKey bool) ([]byte, error) {
+	r, s, recid, err := x.lowS()
+	if err != nil {
+		return nil, err
+	}
+	header := byte(bitcoinCompactSigMagicOffset + recid)
+	if compressedPubKey {
+		header += bitcoinCompactSigCompPubKey
+	}
+	return append([]byte{header}, fixedLenRS(r, s)...), nil
+}
+
+// Ethereum returns the 65-byte R || S || V signature, with V = 27 + the recovery id.
+func (x *SignatureData) Ethereum() ([]byte, error) {
+	r, s, recid, err := x.lowS()
+	if err != nil {
+		return nil, err
+	}
+	if recid > 1 {
+		return nil, errors.New("the recovery id cannot be expressed in an Ethereum signature")
+	}
+	return append(fixedLenRS(r, s), byte(ethereumSigRecoveryOffset+recid)), nil
+}
+
+// EthereumEIP155V returns the V value of an EIP-155 replay-protected transaction signature, chainID * 2 + 35 + the recovery id.
+// R and S are the first 64 bytes returned by Ethereum().
+func (x *SignatureData) EthereumEIP155V(chainID *big.Int) (*big.Int, error) {
+	if chainID == nil || chainID.Sign() < 0 {
+		return nil, errors.New("the chain id must be a non-negative integer")
+	}
+	_, _, recid, err := x.lowS()
+	if err != nil {
+		return nil, err
+	}
+	if recid > 1 {
+		return nil, errors.New("the recovery id cannot be expressed in an Ethereum signature")
+	}
+	v := new(big.Int).Lsh(chainID, 1)
+	return v.Add(v, big.NewInt(int64(ethereumEIP155RecoveryOffset+recid))), nil
+}
+
+// Cosmos returns the 64-byte R || S signature with a low S, as accepted by Cosmos SDK and Tendermint secp256k1 keys.
+func (x *SignatureData) Cosmos() ([]byte, error) {
+	r, s, _, err := x.lowS()
+	if err != nil {
+		return nil, err
+	}
+	return fixedLenRS(r, s), nil
+}
+
+// JOSE returns the base64url-encoded R || S signature of a JWS with the ES256K algorithm (RFC 8812).
+func (x *SignatureData) JOSE() (string, error) {
+	r, s, err := x.rs()
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(fixedLenRS(r, s)), nil
+}
+
+// ----- //
+
+func (x *SignatureData) rs() (r, s *big.Int, err error) {
+	if len(x.GetR()) == 0 || len(x.GetS()) == 0 {
+		return nil, nil, errors.New("the signature data has no R or S")
+	}
+	return new(big.Int).SetBytes(x.GetR()), new(big.Int).SetBytes(x.GetS()), nil
+}
+
+// lowS returns R, S and the recovery id of the signature, with S normalized to the lower half of the secp256k1 order
+func (x *SignatureData) lowS() (r, s *big.Int, recid int, err error) {
+	if r, s, err = x.rs(); err != nil {
+		return
+	}
+	if len(x.GetSignatureRecovery()) == 0 {
+		return nil, nil, 0, errors.New("the signature data has no recovery id")
+	}
+	recid = int(x.GetSignatureRecovery()[0])
+	if recid > 3 {
+		return nil, nil, 0, errors.New("the signature data has an invalid recovery id")
+	}
+	N := btcec.S256().N
+	if s.Cmp(new(big.Int).Rsh(N, 1)) > 0 {
+		s = new(big.Int).Sub(N, s)
+		recid ^= 1
+	}
+	return
+}
+
+func fixedLenRS(r, s *big.Int) []byte {
+	bz := make([]byte, 2*secp256k1SignatureScalarBytes)
+	r.FillBytes(bz[:secp256k1SignatureScalarBytes])
+	s.FillBytes(bz[secp256k1SignatureScalarBytes:])
+	return bz
+}
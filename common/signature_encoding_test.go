@@ -0,0 +1,140 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package common_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"encoding/asn1"
+	"encoding/base64"
+	"math/big"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bnb-chain/tss-lib/common"
+)
+
+// signatureFixture signs a message with btcec and returns it as the threshold ECDSA protocol would output it
+func signatureFixture(t *testing.T, highS bool) (*btcec.PrivateKey, []byte, *common.SignatureData) {
+	sk, err := btcec.NewPrivateKey(btcec.S256())
+	assert.NoError(t, err)
+	hash := sha256.Sum256([]byte("tss-lib signature encoding"))
+	compact, err := btcec.SignCompact(btcec.S256(), sk, hash[:], false)
+	assert.NoError(t, err)
+	recid := compact[0] - 27
+	r, s := new(big.Int).SetBytes(compact[1:33]), new(big.Int).SetBytes(compact[33:])
+	if highS {
+		s.Sub(btcec.S256().N, s)
+		recid ^= 1
+	}
+	data := &common.SignatureData{
+		R:                 make([]byte, 32),
+		S:                 make([]byte, 32),
+		SignatureRecovery: []byte{recid},
+		M:                 hash[:],
+	}
+	r.FillBytes(data.R)
+	s.FillBytes(data.S)
+	data.Signature = append(append([]byte{}, data.R...), data.S...)
+	return sk, hash[:], data
+}
+
+func TestSignatureDataDER(t *testing.T) {
+	for _, highS := range []bool{false, true} {
+		sk, hash, data := signatureFixture(t, highS)
+		der, err := data.DER()
+		assert.NoError(t, err)
+
+		var sig struct{ R, S *big.Int }
+		_, err = asn1.Unmarshal(der, &sig)
+		assert.NoError(t, err)
+		assert.True(t, ecdsa.Verify(sk.PubKey().ToECDSA(), hash, sig.R, sig.S), "stdlib verify must pass")
+
+		btcSig, err := btcec.ParseDERSignature(der, btcec.S256())
+		assert.NoError(t, err)
+		assert.True(t, btcSig.Verify(hash, sk.PubKey()), "btcec verify must pass")
+	}
+}
+
+func TestSignatureDataBitcoinCompact(t *testing.T) {
+	for _, highS := range []bool{false, true} {
+		sk, hash, data := signatureFixture(t, highS)
+		for _, compressed := range []bool{false, true} {
+			compact, err := data.BitcoinCompact(compressed)
+			assert.NoError(t, err)
+			assert.Len(t, compact, 65)
+			assert.True(t, new(big.Int).SetBytes(compact[33:]).Cmp(new(big.Int).Rsh(btcec.S256().N, 1)) <= 0, "S must be low")
+
+			pk, wasCompressed, err := btcec.RecoverCompact(btcec.S256(), compact, hash)
+			assert.NoError(t, err)
+			assert.True(t, pk.IsEqual(sk.PubKey()), "the recovered public key must match")
+			assert.Equal(t, compressed, wasCompressed)
+		}
+	}
+}
+
+func TestSignatureDataEthereum(t *testing.T) {
+	for _, highS := range []bool{false, true} {
+		sk, hash, data := signatureFixture(t, highS)
+		eth, err := data.Ethereum()
+		assert.NoError(t, err)
+		assert.Len(t, eth, 65)
+		v := eth[64]
+		assert.True(t, v == 27 || v == 28)
+
+		// the Ethereum layout is the Bitcoin compact layout with the header moved to the end
+		pk, _, err := btcec.RecoverCompact(btcec.S256(), append([]byte{v}, eth[:64]...), hash)
+		assert.NoError(t, err)
+		assert.True(t, pk.IsEqual(sk.PubKey()), "the recovered public key must match")
+
+		eip155V, err := data.EthereumEIP155V(big.NewInt(1))
+		assert.NoError(t, err)
+		assert.Equal(t, int64(v-27)+37, eip155V.Int64())
+		_, err = data.EthereumEIP155V(big.NewInt(-1))
+		assert.Error(t, err)
+	}
+}
+
+func TestSignatureDataCosmosAndJOSE(t *testing.T) {
+	sk, hash, lowData := signatureFixture(t, false)
+	expected, err := lowData.Cosmos()
+	assert.NoError(t, err)
+	assert.Len(t, expected, 64)
+	btcSig := &btcec.Signature{R: new(big.Int).SetBytes(expected[:32]), S: new(big.Int).SetBytes(expected[32:])}
+	assert.True(t, btcSig.Verify(hash, sk.PubKey()), "btcec verify must pass")
+
+	// a high-S signature is normalized to the same Cosmos encoding
+	highS := new(big.Int).Sub(btcec.S256().N, new(big.Int).SetBytes(lowData.S))
+	highData := &common.SignatureData{
+		R:                 lowData.R,
+		S:                 highS.FillBytes(make([]byte, 32)),
+		SignatureRecovery: []byte{lowData.SignatureRecovery[0] ^ 1},
+	}
+	cosmos, err := highData.Cosmos()
+	assert.NoError(t, err)
+	assert.Equal(t, expected, cosmos)
+
+	jose, err := lowData.JOSE()
+	assert.NoError(t, err)
+	raw, err := base64.RawURLEncoding.DecodeString(jose)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, raw)
+	assert.True(t, ecdsa.Verify(sk.PubKey().ToECDSA(), hash, new(big.Int).SetBytes(raw[:32]), new(big.Int).SetBytes(raw[32:])), "stdlib verify must pass")
+}
+
+func TestSignatureDataMissingFields(t *testing.T) {
+	_, err := (&common.SignatureData{}).DER()
+	assert.Error(t, err)
+	_, _, data := signatureFixture(t, false)
+	data.SignatureRecovery = nil
+	_, err = data.Ethereum()
+	assert.Error(t, err)
+	_, err = data.BitcoinCompact(true)
+	assert.Error(t, err)
+}
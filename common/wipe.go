@@ -0,0 +1,37 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package common
+
+import (
+	"math/big"
+)
+
+// WipeInts overwrites the whole word buffer of each big.Int with zeros and sets it to 0, so that a secret does not
+// linger in memory until the buffer is reused. Any other reference to the same big.Int sees 0 afterwards, so only
+// values that are owned by the caller should be wiped. Nil values are skipped.
+func WipeInts(xs ...*big.Int) {
+	for _, x := range xs {
+		if x == nil {
+			continue
+		}
+		words := x.Bits()
+		words = words[:cap(words)]
+		for i := range words {
+			words[i] = 0
+		}
+		x.SetInt64(0)
+	}
+}
+
+// WipeBytes overwrites each byte slice with zeros.
+func WipeBytes(bzs ...[]byte) {
+	for _, bz := range bzs {
+		for i := range bz {
+			bz[i] = 0
+		}
+	}
+}
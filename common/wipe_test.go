@@ -0,0 +1,32 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package common_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	. "github.com/bnb-chain/tss-lib/common"
+)
+
+func TestWipeInts(t *testing.T) {
+	x, _ := new(big.Int).SetString("123456789abcdef0123456789abcdef0123456789abcdef", 16)
+	words := x.Bits()[:cap(x.Bits())]
+	// shrinking keeps the high words in the buffer beyond the length
+	x.Rsh(x, 128)
+	WipeInts(x, nil)
+	assert.Equal(t, 0, x.Sign())
+	for _, w := range words {
+		assert.Zero(t, w)
+	}
+
+	bz := []byte{1, 2, 3}
+	WipeBytes(bz, nil)
+	assert.Equal(t, []byte{0, 0, 0}, bz)
+}
@@ -11,8 +11,11 @@ import (
 	"crypto/sha512"
 	"encoding/binary"
 	"errors"
+	"fmt"
 	"hash"
 	"math/big"
+	"strconv"
+	"strings"
 
 	"github.com/bnb-chain/tss-lib/common"
 	"github.com/bnb-chain/tss-lib/crypto"
@@ -255,3 +258,63 @@ func DeriveChildKey(index uint32, pk *ExtendedKey, curve elliptic.Curve) (*big.I
 	}
 	return ilNum, childPk, nil
 }
+
+// ParseDerivationPath parses a BIP-32 path string such as "m/44/60/0/0/5" into its child indices.
+// Hardened segments (e.g. "44'" or "44h") cannot be derived from a public key alone, so they are rejected.
+func ParseDerivationPath(path string) ([]uint32, error) {
+	segments := strings.Split(strings.TrimSpace(path), "/")
+	if segments[0] == "m" || segments[0] == "M" {
+		segments = segments[1:]
+	}
+	if len(segments) == 1 && segments[0] == "" {
+		// the path was "m" or "", which refers to the master key itself
+		return []uint32{}, nil
+	}
+	if len(segments) > maxDepth {
+		return nil, fmt.Errorf("derivation path %q is deeper than the maximum depth of %d", path, maxDepth)
+	}
+	indices := make([]uint32, len(segments))
+	for i, segment := range segments {
+		if strings.HasSuffix(segment, "'") || strings.HasSuffix(segment, "h") || strings.HasSuffix(segment, "H") {
+			return nil, fmt.Errorf("derivation path %q has hardened segment %q at position %d; only non-hardened derivation is supported", path, segment, i+1)
+		}
+		index, err := strconv.ParseUint(segment, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("derivation path %q has invalid segment %q at position %d", path, segment, i+1)
+		}
+		if index >= HardenedKeyStart {
+			return nil, fmt.Errorf("derivation path %q has hardened index %d at position %d; only non-hardened derivation is supported", path, index, i+1)
+		}
+		indices[i] = uint32(index)
+	}
+	return indices, nil
+}
+
+// NewMasterExtendedKey returns the depth-0 extended key for a public key and chain code, e.g. those of a threshold key.
+// The version bytes select the serialization prefix used by String(), e.g. chaincfg.MainNetParams.HDPublicKeyID for "xpub".
+func NewMasterExtendedKey(pk *ecdsa.PublicKey, chainCode []byte, version []byte) (*ExtendedKey, error) {
+	if len(chainCode) != 32 {
+		return nil, errors.New("the chain code must be 32 bytes")
+	}
+	if pk == nil || pk.X == nil || pk.Y == nil || !pk.Curve.IsOnCurve(pk.X, pk.Y) {
+		return nil, errors.New("the public key must be a valid curve point")
+	}
+	return &ExtendedKey{
+		PublicKey:  *pk,
+		Depth:      0,
+		ChildIndex: 0,
+		ChainCode:  chainCode[:],
+		ParentFP:   []byte{0x00, 0x00, 0x00, 0x00},
+		Version:    version,
+	}, nil
+}
+
+// DeriveChildKeyFromPath parses the path string and derives the child key along it. It returns the summed "IL" values
+// (the key derivation delta) alongside the derived child key, like DeriveChildKeyFromHierarchy.
+func DeriveChildKeyFromPath(path string, pk *ExtendedKey, curve elliptic.Curve) (*big.Int, *ExtendedKey, error) {
+	indices, err := ParseDerivationPath(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	return DeriveChildKeyFromHierarchy(indices, pk, curve.Params().N, curve)
+}
@@ -0,0 +1,117 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package ckd
+
+import (
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/binary"
+	"errors"
+	"math/big"
+
+	"github.com/decred/dcrd/dcrec/edwards/v2"
+
+	"github.com/bnb-chain/tss-lib/common"
+	"github.com/bnb-chain/tss-lib/crypto"
+)
+
+// The functions below implement the public (non-hardened) child key derivation of BIP32-Ed25519, described in
+// "BIP32-Ed25519: Hierarchical Deterministic Keys over a Non-linear Keyspace" by Khovratovich and Law.
+// The child public key is A + [8*ZL]B, so the key derivation delta 8*ZL can be added to every Shamir share of a
+// threshold key in the same way as the secp256k1 "IL" delta above.
+
+const (
+	ed25519PubKeyPrefix       byte = 0x02
+	ed25519ChainCodePrefix    byte = 0x03
+	ed25519DerivationDeltaLen      = 28
+)
+
+// DeriveEd25519ChildKeyFromHierarchy derives the Ed25519 child key along the given non-hardened indices. The function
+// returns the sum of the deltas of each level (the key derivation delta), alongside the derived child key.
+func DeriveEd25519ChildKeyFromHierarchy(indicesHierarchy []uint32, pk *ExtendedKey) (*big.Int, *ExtendedKey, error) {
+	var k = pk
+	var err error
+	var childKey *ExtendedKey
+	mod_ := common.ModInt(edwards.Edwards().Params().N)
+	delta := big.NewInt(0)
+	for index := range indicesHierarchy {
+		deltaOld := delta
+		delta, childKey, err = DeriveEd25519ChildKey(indicesHierarchy[index], k)
+		if err != nil {
+			return nil, nil, err
+		}
+		k = childKey
+		delta = mod_.Add(delta, deltaOld)
+	}
+	return delta, k, nil
+}
+
+// DeriveEd25519ChildKey derives a non-hardened child key from the given Ed25519 parent key. The function returns the
+// key derivation delta 8*ZL, which must be added to the parent private key (or to each of its shares), and the child key.
+func DeriveEd25519ChildKey(index uint32, pk *ExtendedKey) (*big.Int, *ExtendedKey, error) {
+	if index >= HardenedKeyStart {
+		return nil, nil, errors.New("the index must be non-hardened")
+	}
+	if pk.Depth == maxDepth {
+		return nil, nil, errors.New("cannot derive key beyond max depth")
+	}
+	if len(pk.ChainCode) != 32 {
+		return nil, nil, errors.New("the chain code must be 32 bytes")
+	}
+
+	ec := edwards.Edwards()
+	cryptoPk, err := crypto.NewECPoint(ec, pk.X, pk.Y)
+	if err != nil {
+		common.Logger.Error("error getting pubkey from extendedkey")
+		return nil, nil, err
+	}
+
+	pkPublicKeyBytes := edwards.NewPublicKey(pk.X, pk.Y).Serialize()
+
+	// Z = HMAC-SHA512(Key = chainCode, Data = 0x02 || A || ser32LE(index))
+	data := make([]byte, 37)
+	data[0] = ed25519PubKeyPrefix
+	copy(data[1:], pkPublicKeyBytes)
+	binary.LittleEndian.PutUint32(data[33:], index)
+	hmac512 := hmac.New(sha512.New, pk.ChainCode)
+	hmac512.Write(data)
+	z := hmac512.Sum(nil)
+
+	// c_i = right half of HMAC-SHA512(Key = chainCode, Data = 0x03 || A || ser32LE(index))
+	data[0] = ed25519ChainCodePrefix
+	hmac512 = hmac.New(sha512.New, pk.ChainCode)
+	hmac512.Write(data)
+	childChainCode := hmac512.Sum(nil)[32:]
+
+	// delta = 8 * ZL, where ZL is the first 28 bytes of Z interpreted as a little-endian integer
+	zl := make([]byte, ed25519DerivationDeltaLen)
+	for i := range zl {
+		zl[i] = z[ed25519DerivationDeltaLen-1-i]
+	}
+	delta := new(big.Int).Lsh(new(big.Int).SetBytes(zl), 3)
+
+	deltaG := crypto.ScalarBaseMult(ec, delta)
+	childCryptoPk, err := cryptoPk.Add(deltaG)
+	if err != nil {
+		common.Logger.Error("error adding delta G to parent key")
+		return nil, nil, err
+	}
+	if childCryptoPk.X().Sign() == 0 && childCryptoPk.Y().Cmp(big.NewInt(1)) == 0 {
+		common.Logger.Error("error invalid child")
+		return nil, nil, errors.New("invalid child")
+	}
+
+	childPk := &ExtendedKey{
+		PublicKey:  *childCryptoPk.ToECDSAPubKey(),
+		Depth:      pk.Depth + 1,
+		ChildIndex: index,
+		ChainCode:  childChainCode,
+		ParentFP:   hash160(pkPublicKeyBytes)[:4],
+		Version:    pk.Version,
+	}
+	return delta, childPk, nil
+}
@@ -7,10 +7,14 @@
 package ckd_test
 
 import (
+	"reflect"
 	"testing"
 
+	"github.com/bnb-chain/tss-lib/common"
+	"github.com/bnb-chain/tss-lib/crypto"
 	. "github.com/bnb-chain/tss-lib/crypto/ckd"
 	"github.com/btcsuite/btcd/btcec"
+	"github.com/decred/dcrd/dcrec/edwards/v2"
 )
 
 func TestPublicDerivation(t *testing.T) {
@@ -130,3 +134,99 @@ tests:
 		}
 	}
 }
+
+func TestParseDerivationPath(t *testing.T) {
+	tests := []struct {
+		path    string
+		want    []uint32
+		wantErr bool
+	}{
+		{path: "m", want: []uint32{}},
+		{path: "", want: []uint32{}},
+		{path: "m/0/1/2", want: []uint32{0, 1, 2}},
+		{path: "M/44/60/0/0/5", want: []uint32{44, 60, 0, 0, 5}},
+		{path: "12/209/3", want: []uint32{12, 209, 3}},
+		{path: "m/2147483647", want: []uint32{2147483647}},
+		{path: "m/44'/60'/0'/0/5", wantErr: true},
+		{path: "m/44h/0", wantErr: true},
+		{path: "m/2147483648", wantErr: true},
+		{path: "m/0//1", wantErr: true},
+		{path: "m/-1", wantErr: true},
+		{path: "m/abc", wantErr: true},
+	}
+	for _, test := range tests {
+		got, err := ParseDerivationPath(test.path)
+		if test.wantErr {
+			if err == nil {
+				t.Errorf("ParseDerivationPath(%q): expected an error, got %v", test.path, got)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("ParseDerivationPath(%q): unexpected error: %v", test.path, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("ParseDerivationPath(%q): got %v, want %v", test.path, got, test.want)
+		}
+	}
+}
+
+func TestDeriveChildKeyFromPath(t *testing.T) {
+	master, err := NewExtendedKeyFromString("xpub661MyMwAqRbcFtXgS5sYJABqqG9YLmC4Q1Rdap9gSE8NqtwybGhePY2gZ29ESFjqJoCu1Rupje8YtGqsefD265TMg7usUDFdp6W1EGMcet8", btcec.S256())
+	if err != nil {
+		t.Fatal(err)
+	}
+	master, err = NewMasterExtendedKey(&master.PublicKey, master.ChainCode, master.Version)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, child, err := DeriveChildKeyFromPath("m/0/1/2/2/1000000000", master, btcec.S256())
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "xpub6GX3zWVgSgPc5tgjE6ogT9nfwSADD3tdsxpzd7jJoJMqSY12Be6VQEFwDCp6wAQoZsH2iq5nNocHEaVDxBcobPrkZCjYW3QUmoDYzMFBDu9"
+	if child.String() != want {
+		t.Errorf("mismatched serialized public extended key -- got: %s, want: %s", child.String(), want)
+	}
+	if _, _, err = DeriveChildKeyFromPath("m/0'/1", master, btcec.S256()); err == nil {
+		t.Error("expected an error deriving a hardened path")
+	}
+}
+
+func TestDeriveEd25519ChildKeyFromHierarchy(t *testing.T) {
+	ec := edwards.Edwards()
+	parentSk := common.GetRandomPositiveInt(ec.Params().N)
+	parentPk := crypto.ScalarBaseMult(ec, parentSk)
+	master, err := NewMasterExtendedKey(parentPk.ToECDSAPubKey(), common.SHA512_256([]byte("chain code")), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	delta, child, err := DeriveEd25519ChildKeyFromHierarchy([]uint32{44, 501, 0, 0}, master)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if child.Depth != 4 || child.ChildIndex != 0 || len(child.ChainCode) != 32 {
+		t.Errorf("unexpected child key metadata: depth %d, index %d, chain code length %d", child.Depth, child.ChildIndex, len(child.ChainCode))
+	}
+	// the child private key is the parent private key plus the delta
+	childSk := common.ModInt(ec.Params().N).Add(parentSk, delta)
+	expected := crypto.ScalarBaseMult(ec, childSk)
+	if expected.X().Cmp(child.X) != 0 || expected.Y().Cmp(child.Y) != 0 {
+		t.Error("the child public key does not match the parent private key plus the delta")
+	}
+
+	// derivation is deterministic and depends on every index
+	delta2, child2, err := DeriveEd25519ChildKeyFromHierarchy([]uint32{44, 501, 0, 0}, master)
+	if err != nil || delta2.Cmp(delta) != 0 || child2.X.Cmp(child.X) != 0 {
+		t.Error("derivation should be deterministic")
+	}
+	_, child3, err := DeriveEd25519ChildKeyFromHierarchy([]uint32{44, 501, 0, 1}, master)
+	if err != nil || child3.X.Cmp(child.X) == 0 {
+		t.Error("different paths should derive different keys")
+	}
+	if _, _, err = DeriveEd25519ChildKeyFromHierarchy([]uint32{HardenedKeyStart}, master); err == nil {
+		t.Error("expected an error deriving a hardened index")
+	}
+}
@@ -10,6 +10,8 @@
 package commitments
 
 import (
+	crand "crypto/rand"
+	"io"
 	"math/big"
 
 	"github.com/bnb-chain/tss-lib/common"
@@ -44,7 +46,11 @@ func NewHashCommitmentWithRandomness(r *big.Int, secrets ...*big.Int) *HashCommi
 }
 
 func NewHashCommitment(secrets ...*big.Int) *HashCommitDecommit {
-	r := common.MustGetRandomInt(HashLength) // r
+	return NewHashCommitmentWithRandom(crand.Reader, secrets...)
+}
+
+func NewHashCommitmentWithRandom(rand io.Reader, secrets ...*big.Int) *HashCommitDecommit {
+	r := common.MustGetRandomIntWithRandom(rand, HashLength) // r
 	return NewHashCommitmentWithRandomness(r, secrets...)
 }
 
@@ -13,10 +13,12 @@ package dlnproof
 
 import (
 	"fmt"
+	"io"
 	"math/big"
 
 	"github.com/bnb-chain/tss-lib/common"
 	cmts "github.com/bnb-chain/tss-lib/crypto/commitments"
+	"github.com/bnb-chain/tss-lib/crypto/transcript"
 )
 
 const Iterations = 128
@@ -32,17 +34,18 @@ var (
 	one = big.NewInt(1)
 )
 
-func NewDLNProof(h1, h2, x, p, q, N *big.Int) *Proof {
+// NewDLNProof proves the knowledge of x such that h2 = h1^x mod N, where N = (2p+1)(2q+1).
+// The challenge is drawn from `tr`, or derived as in earlier versions if `tr` is nil.
+func NewDLNProof(tr *transcript.Transcript, h1, h2, x, p, q, N *big.Int, rand io.Reader) *Proof {
 	pMulQ := new(big.Int).Mul(p, q)
 	modN, modPQ := common.ModInt(N), common.ModInt(pMulQ)
 	a := make([]*big.Int, Iterations)
 	alpha := [Iterations]*big.Int{}
 	for i := range alpha {
-		a[i] = common.GetRandomPositiveInt(pMulQ)
+		a[i] = common.GetRandomPositiveIntWithRandom(rand, pMulQ)
 		alpha[i] = modN.Exp(h1, a[i])
 	}
-	msg := append([]*big.Int{h1, h2, N}, alpha[:]...)
-	c := common.SHA512_256i(msg...)
+	c := challenge(tr, h1, h2, N, alpha)
 	t := [Iterations]*big.Int{}
 	cIBI := new(big.Int)
 	for i := range t {
@@ -53,7 +56,8 @@ func NewDLNProof(h1, h2, x, p, q, N *big.Int) *Proof {
 	return &Proof{alpha, t}
 }
 
-func (p *Proof) Verify(h1, h2, N *big.Int) bool {
+// Verify checks the proof. `tr` must be in the state the prover's transcript was in, or nil for a proof made without one.
+func (p *Proof) Verify(tr *transcript.Transcript, h1, h2, N *big.Int) bool {
 	if p == nil {
 		return false
 	}
@@ -84,8 +88,7 @@ func (p *Proof) Verify(h1, h2, N *big.Int) bool {
 			return false
 		}
 	}
-	msg := append([]*big.Int{h1, h2, N}, p.Alpha[:]...)
-	c := common.SHA512_256i(msg...)
+	c := challenge(tr, h1, h2, N, p.Alpha)
 	cIBI := new(big.Int)
 	for i := 0; i < Iterations; i++ {
 		if p.Alpha[i] == nil || p.T[i] == nil {
@@ -103,6 +106,20 @@ func (p *Proof) Verify(h1, h2, N *big.Int) bool {
 	return true
 }
 
+// challenge returns the challenge whose first Iterations bits are used
+func challenge(tr *transcript.Transcript, h1, h2, N *big.Int, alpha [Iterations]*big.Int) *big.Int {
+	if tr == nil {
+		msg := append([]*big.Int{h1, h2, N}, alpha[:]...)
+		return common.SHA512_256i(msg...)
+	}
+	tr.AppendMessage("proof", []byte("dln"))
+	tr.AppendInts("h1", h1)
+	tr.AppendInts("h2", h2)
+	tr.AppendInts("N", N)
+	tr.AppendInts("alpha", alpha[:]...)
+	return new(big.Int).SetBytes(tr.ChallengeBytes("c", Iterations/8))
+}
+
 func (p *Proof) Serialize() ([][]byte, error) {
 	cb := cmts.NewBuilder()
 	cb = cb.AddPart(p.Alpha[:])
@@ -16,22 +16,16 @@ import (
 	"fmt"
 	"math/big"
 
-	"github.com/decred/dcrd/dcrec/edwards/v2"
-
+	"github.com/bnb-chain/tss-lib/crypto/group"
 	"github.com/bnb-chain/tss-lib/tss"
 )
 
-// ECPoint convenience helper
+// ECPoint convenience helper. Its arithmetic is performed by the group.CurveGroup registered for its curve, if any.
 type ECPoint struct {
 	curve  elliptic.Curve
 	coords [2]*big.Int
 }
 
-var (
-	eight    = big.NewInt(8)
-	eightInv = new(big.Int).ModInverse(eight, edwards.Edwards().Params().N)
-)
-
 // Creates a new ECPoint and checks that the given coordinates are on the elliptic curve.
 func NewECPoint(curve elliptic.Curve, X, Y *big.Int) (*ECPoint, error) {
 	if !isOnCurve(curve, X, Y) {
@@ -55,16 +49,67 @@ func (p *ECPoint) Y() *big.Int {
 }
 
 func (p *ECPoint) Add(p1 *ECPoint) (*ECPoint, error) {
+	if g, ok := group.FromCurve(p.curve); ok {
+		gp, err := p.ToGroupPoint()
+		if err != nil {
+			return nil, err
+		}
+		gp1, err := p1.ToGroupPoint()
+		if err != nil {
+			return nil, err
+		}
+		x, y := g.Affine(gp.Add(gp1))
+		return NewECPoint(p.curve, x, y)
+	}
 	x, y := p.curve.Add(p.X(), p.Y(), p1.X(), p1.Y())
 	return NewECPoint(p.curve, x, y)
 }
 
 func (p *ECPoint) ScalarMult(k *big.Int) *ECPoint {
+	if g, ok := group.FromCurve(p.curve); ok {
+		gp, err := p.ToGroupPoint()
+		if err != nil {
+			return nil
+		}
+		x, y := g.Affine(gp.ScalarMult(g.ScalarFromBigInt(k)))
+		newP, _ := NewECPoint(p.curve, x, y) // nil for the identity of a short Weierstrass curve, as below
+		return newP
+	}
 	x, y := p.curve.ScalarMult(p.X(), p.Y(), k.Bytes())
 	newP, _ := NewECPoint(p.curve, x, y) // it must be on the curve, no need to check.
 	return newP
 }
 
+// ScalarMultScalar multiplies the point by a scalar of the group registered for its curve, so that a secret scalar
+// need not be converted to a big.Int. It returns nil if no group is registered or for the identity.
+func (p *ECPoint) ScalarMultScalar(k group.Scalar) *ECPoint {
+	g, ok := group.FromCurve(p.curve)
+	if !ok {
+		return nil
+	}
+	gp, err := p.ToGroupPoint()
+	if err != nil {
+		return nil
+	}
+	newP, _ := NewECPointFromGroupPoint(g, gp.ScalarMult(k))
+	return newP
+}
+
+// ToGroupPoint returns the point as an element of the group registered for its curve.
+func (p *ECPoint) ToGroupPoint() (group.Point, error) {
+	g, ok := group.FromCurve(p.curve)
+	if !ok {
+		return nil, fmt.Errorf("no group is registered for the curve %s", p.curve.Params().Name)
+	}
+	return g.PointFromAffine(p.coords[0], p.coords[1])
+}
+
+// NewECPointFromGroupPoint returns the affine point of an element of a curve group.
+func NewECPointFromGroupPoint(g group.CurveGroup, gp group.Point) (*ECPoint, error) {
+	x, y := g.Affine(gp)
+	return NewECPoint(g.Curve(), x, y)
+}
+
 func (p *ECPoint) ToECDSAPubKey() *ecdsa.PublicKey {
 	return &ecdsa.PublicKey{
 		Curve: p.curve,
@@ -97,16 +142,49 @@ func (p *ECPoint) ValidateBasic() bool {
 	return p != nil && p.coords[0] != nil && p.coords[1] != nil && p.IsOnCurve()
 }
 
+// ClearTorsion returns the component of the point in the prime-order subgroup; see group.ClearTorsion.
+// Points received from other parties on a curve with a cofactor, such as Ed25519, should be cleared before use.
+func (p *ECPoint) ClearTorsion() *ECPoint {
+	g, ok := group.FromCurve(p.curve)
+	if !ok {
+		return p
+	}
+	gp, err := p.ToGroupPoint()
+	if err != nil {
+		return nil
+	}
+	x, y := g.Affine(group.ClearTorsion(g, gp))
+	return NewECPointNoCurveCheck(p.curve, x, y)
+}
+
+// EightInvEight multiplies the point by 8 and then by the inverse of 8.
+// Deprecated: use ClearTorsion, which uses the cofactor of the curve.
 func (p *ECPoint) EightInvEight() *ECPoint {
-	return p.ScalarMult(eight).ScalarMult(eightInv)
+	return p.ClearTorsion()
 }
 
 func ScalarBaseMult(curve elliptic.Curve, k *big.Int) *ECPoint {
+	if g, ok := group.FromCurve(curve); ok {
+		x, y := g.Affine(g.Generator().ScalarMult(g.ScalarFromBigInt(k)))
+		p, _ := NewECPoint(curve, x, y) // nil for the identity of a short Weierstrass curve, as below
+		return p
+	}
 	x, y := curve.ScalarBaseMult(k.Bytes())
 	p, _ := NewECPoint(curve, x, y) // it must be on the curve, no need to check.
 	return p
 }
 
+// ScalarBaseMultScalar is ScalarBaseMult for a scalar of the group registered for the curve. It returns nil if no group
+// is registered or for the identity.
+func ScalarBaseMultScalar(curve elliptic.Curve, k group.Scalar) *ECPoint {
+	g, ok := group.FromCurve(curve)
+	if !ok {
+		return nil
+	}
+	p, _ := NewECPointFromGroupPoint(g, g.Generator().ScalarMult(k))
+	return p
+}
+
 func isOnCurve(c elliptic.Curve, x, y *big.Int) bool {
 	if x == nil || y == nil {
 		return false
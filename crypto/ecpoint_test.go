@@ -7,6 +7,7 @@
 package crypto_test
 
 import (
+	"crypto/elliptic"
 	"encoding/hex"
 	"encoding/json"
 	"math/big"
@@ -18,6 +19,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	. "github.com/bnb-chain/tss-lib/crypto"
+	"github.com/bnb-chain/tss-lib/crypto/group"
 	"github.com/bnb-chain/tss-lib/tss"
 )
 
@@ -165,3 +167,39 @@ func TestEdwardsEcpointJsonSerialization(t *testing.T) {
 	assert.True(t, point.Equals(&umpoint))
 	assert.True(t, reflect.TypeOf(point.Curve()) == reflect.TypeOf(umpoint.Curve()))
 }
+
+func TestECPointArithmeticMatchesCurve(t *testing.T) {
+	for _, ec := range []elliptic.Curve{tss.S256(), tss.P256(), tss.Edwards()} {
+		a, b := big.NewInt(12345), big.NewInt(67890)
+		A, B := ScalarBaseMult(ec, a), ScalarBaseMult(ec, b)
+		sum, err := A.Add(B)
+		assert.NoError(t, err)
+		ex, ey := ec.ScalarBaseMult(new(big.Int).Add(a, b).Bytes())
+		assert.Equal(t, 0, sum.X().Cmp(ex))
+		assert.Equal(t, 0, sum.Y().Cmp(ey))
+		assert.True(t, A.ScalarMult(b).Equals(B.ScalarMult(a)))
+
+		g, ok := group.FromCurve(ec)
+		assert.True(t, ok)
+		groupA, err := A.ToGroupPoint()
+		assert.NoError(t, err)
+		A2, err := NewECPointFromGroupPoint(g, groupA)
+		assert.NoError(t, err)
+		assert.True(t, A.Equals(A2))
+		assert.True(t, A.ClearTorsion().Equals(A), "a point of the prime-order subgroup has no torsion")
+	}
+	// on a short Weierstrass curve, the identity is not representable
+	assert.Nil(t, ScalarBaseMult(tss.S256(), tss.S256().Params().N))
+}
+
+func TestECPointClearTorsion(t *testing.T) {
+	ec := tss.Edwards()
+	P := ScalarBaseMult(ec, big.NewInt(42))
+	// (0, -1) has order 2
+	lowOrder, err := NewECPoint(ec, big.NewInt(0), new(big.Int).Sub(ec.Params().P, big.NewInt(1)))
+	assert.NoError(t, err)
+	withTorsion, err := P.Add(lowOrder)
+	assert.NoError(t, err)
+	assert.False(t, withTorsion.Equals(P))
+	assert.True(t, withTorsion.ClearTorsion().Equals(P))
+}
@@ -0,0 +1,249 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package group
+
+import (
+	"crypto/elliptic"
+	"errors"
+	"math/big"
+
+	"filippo.io/edwards25519"
+	"filippo.io/edwards25519/field"
+	"github.com/decred/dcrd/dcrec/edwards/v2"
+)
+
+// ed25519Group is the edwards25519 curve with the RFC 8032 encodings, backed by filippo.io/edwards25519.
+// Its points may have a small-order component; see ClearTorsion.
+type (
+	ed25519Group struct {
+		curve elliptic.Curve
+	}
+
+	ed25519Scalar struct {
+		s *edwards25519.Scalar
+	}
+
+	ed25519Point struct {
+		p *edwards25519.Point
+	}
+)
+
+var (
+	ed25519GroupInstance = &ed25519Group{curve: edwards.Edwards()}
+	ed25519Cofactor      = big.NewInt(8)
+)
+
+// Ed25519 returns the edwards25519 group.
+func Ed25519() CurveGroup {
+	return ed25519GroupInstance
+}
+
+func (g *ed25519Group) Name() string {
+	return "Ed25519"
+}
+
+func (g *ed25519Group) Order() *big.Int {
+	return new(big.Int).Set(g.curve.Params().N)
+}
+
+func (g *ed25519Group) Cofactor() *big.Int {
+	return new(big.Int).Set(ed25519Cofactor)
+}
+
+func (g *ed25519Group) Curve() elliptic.Curve {
+	return g.curve
+}
+
+func (g *ed25519Group) NewScalar() Scalar {
+	return &ed25519Scalar{edwards25519.NewScalar()}
+}
+
+func (g *ed25519Group) ScalarFromBigInt(k *big.Int) Scalar {
+	return &ed25519Scalar{scalarFromBigInt(k, g.curve.Params().N)}
+}
+
+func (g *ed25519Group) ScalarFromBytes(bz []byte) (Scalar, error) {
+	s, err := edwards25519.NewScalar().SetCanonicalBytes(bz)
+	if err != nil {
+		return nil, err
+	}
+	return &ed25519Scalar{s}, nil
+}
+
+func (g *ed25519Group) ScalarFromUniformBytes(bz []byte) (Scalar, error) {
+	s, err := edwards25519.NewScalar().SetUniformBytes(bz)
+	if err != nil {
+		return nil, err
+	}
+	return &ed25519Scalar{s}, nil
+}
+
+func (g *ed25519Group) NewPoint() Point {
+	return &ed25519Point{edwards25519.NewIdentityPoint()}
+}
+
+func (g *ed25519Group) Generator() Point {
+	return &ed25519Point{edwards25519.NewGeneratorPoint()}
+}
+
+func (g *ed25519Group) PointFromBytes(bz []byte) (Point, error) {
+	p, err := edwards25519.NewIdentityPoint().SetBytes(bz)
+	if err != nil {
+		return nil, err
+	}
+	return &ed25519Point{p}, nil
+}
+
+func (g *ed25519Group) PointFromAffine(x, y *big.Int) (Point, error) {
+	if x == nil || y == nil || x.Sign() < 0 || y.Sign() < 0 || x.Cmp(g.curve.Params().P) >= 0 || y.Cmp(g.curve.Params().P) >= 0 {
+		return nil, errors.New("the point is not on the curve")
+	}
+	X, err := new(field.Element).SetBytes(littleEndian(x))
+	if err != nil {
+		return nil, err
+	}
+	Y, err := new(field.Element).SetBytes(littleEndian(y))
+	if err != nil {
+		return nil, err
+	}
+	T := new(field.Element).Multiply(X, Y)
+	p, err := edwards25519.NewIdentityPoint().SetExtendedCoordinates(X, Y, new(field.Element).One(), T)
+	if err != nil {
+		return nil, errors.New("the point is not on the curve")
+	}
+	return &ed25519Point{p}, nil
+}
+
+func (g *ed25519Group) Affine(p Point) (x, y *big.Int) {
+	X, Y, Z, _ := ed25519PointOf(p).p.ExtendedCoordinates()
+	zInv := new(field.Element).Invert(Z)
+	x = bigIntFromLittleEndian(new(field.Element).Multiply(X, zInv).Bytes())
+	y = bigIntFromLittleEndian(new(field.Element).Multiply(Y, zInv).Bytes())
+	return
+}
+
+// ----- //
+
+func (s *ed25519Scalar) Add(t Scalar) Scalar {
+	return &ed25519Scalar{edwards25519.NewScalar().Add(s.s, ed25519ScalarOf(t).s)}
+}
+
+func (s *ed25519Scalar) Sub(t Scalar) Scalar {
+	return &ed25519Scalar{edwards25519.NewScalar().Subtract(s.s, ed25519ScalarOf(t).s)}
+}
+
+func (s *ed25519Scalar) Mul(t Scalar) Scalar {
+	return &ed25519Scalar{edwards25519.NewScalar().Multiply(s.s, ed25519ScalarOf(t).s)}
+}
+
+func (s *ed25519Scalar) Negate() Scalar {
+	return &ed25519Scalar{edwards25519.NewScalar().Negate(s.s)}
+}
+
+func (s *ed25519Scalar) Invert() Scalar {
+	return &ed25519Scalar{edwards25519.NewScalar().Invert(s.s)}
+}
+
+func (s *ed25519Scalar) Equal(t Scalar) bool {
+	return s.s.Equal(ed25519ScalarOf(t).s) == 1
+}
+
+func (s *ed25519Scalar) IsZero() bool {
+	return s.s.Equal(edwards25519.NewScalar()) == 1
+}
+
+func (s *ed25519Scalar) BigInt() *big.Int {
+	return bigIntFromLittleEndian(s.s.Bytes())
+}
+
+func (s *ed25519Scalar) Bytes() []byte {
+	return s.s.Bytes()
+}
+
+func (s *ed25519Scalar) wipe() {
+	if s != nil && s.s != nil {
+		s.s.Set(edwards25519.NewScalar())
+	}
+}
+
+// ----- //
+
+func (p *ed25519Point) Add(q Point) Point {
+	return &ed25519Point{edwards25519.NewIdentityPoint().Add(p.p, ed25519PointOf(q).p)}
+}
+
+func (p *ed25519Point) Sub(q Point) Point {
+	return &ed25519Point{edwards25519.NewIdentityPoint().Subtract(p.p, ed25519PointOf(q).p)}
+}
+
+func (p *ed25519Point) Negate() Point {
+	return &ed25519Point{edwards25519.NewIdentityPoint().Negate(p.p)}
+}
+
+func (p *ed25519Point) ScalarMult(s Scalar) Point {
+	if p.p.Equal(edwards25519.NewGeneratorPoint()) == 1 {
+		return &ed25519Point{edwards25519.NewIdentityPoint().ScalarBaseMult(ed25519ScalarOf(s).s)}
+	}
+	return &ed25519Point{edwards25519.NewIdentityPoint().ScalarMult(ed25519ScalarOf(s).s, p.p)}
+}
+
+func (p *ed25519Point) Equal(q Point) bool {
+	return p.p.Equal(ed25519PointOf(q).p) == 1
+}
+
+func (p *ed25519Point) IsIdentity() bool {
+	return p.p.Equal(edwards25519.NewIdentityPoint()) == 1
+}
+
+func (p *ed25519Point) Bytes() []byte {
+	return p.p.Bytes()
+}
+
+// ----- //
+
+func ed25519ScalarOf(s Scalar) *ed25519Scalar {
+	es, ok := s.(*ed25519Scalar)
+	if !ok {
+		panic(errors.New("the scalar is not of the Ed25519 group"))
+	}
+	return es
+}
+
+func ed25519PointOf(p Point) *ed25519Point {
+	ep, ok := p.(*ed25519Point)
+	if !ok {
+		panic(errors.New("the point is not of the Ed25519 group"))
+	}
+	return ep
+}
+
+// scalarFromBigInt reduces k modulo the order of the edwards25519 prime-order subgroup
+func scalarFromBigInt(k, order *big.Int) *edwards25519.Scalar {
+	s, err := edwards25519.NewScalar().SetCanonicalBytes(littleEndian(new(big.Int).Mod(k, order)))
+	if err != nil {
+		// k mod order is always canonical
+		panic(err)
+	}
+	return s
+}
+
+// littleEndian returns the 32-byte little-endian encoding of a non-negative integer below 2^256
+func littleEndian(k *big.Int) []byte {
+	bz := k.FillBytes(make([]byte, 32))
+	for i, j := 0, len(bz)-1; i < j; i, j = i+1, j-1 {
+		bz[i], bz[j] = bz[j], bz[i]
+	}
+	return bz
+}
+
+func bigIntFromLittleEndian(bz []byte) *big.Int {
+	be := make([]byte, len(bz))
+	for i := range bz {
+		be[len(bz)-1-i] = bz[i]
+	}
+	return new(big.Int).SetBytes(be)
+}
@@ -0,0 +1,197 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+// Package group abstracts the prime-order groups used by the protocols, so that curves with a cofactor (Ed25519) or
+// without affine coordinates (Ristretto255) are handled the same way as the short Weierstrass curves.
+package group
+
+import (
+	"crypto/elliptic"
+	"crypto/sha512"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math/big"
+	"sync"
+
+	"github.com/bnb-chain/tss-lib/tss"
+)
+
+type (
+	// Group is a cyclic group of prime order with canonical encodings of its scalars and elements.
+	Group interface {
+		Name() string
+		// Order is the prime order of the group generated by Generator
+		Order() *big.Int
+		// Cofactor is the index of the prime-order subgroup in the full group of points; it is 1 for prime-order curves
+		Cofactor() *big.Int
+
+		NewScalar() Scalar
+		// ScalarFromBigInt returns k mod Order
+		ScalarFromBigInt(k *big.Int) Scalar
+		// ScalarFromBytes decodes the canonical encoding output by Scalar.Bytes
+		ScalarFromBytes(bz []byte) (Scalar, error)
+		// ScalarFromUniformBytes reduces 64 uniformly random bytes modulo Order
+		ScalarFromUniformBytes(bz []byte) (Scalar, error)
+
+		// NewPoint returns the identity element
+		NewPoint() Point
+		Generator() Point
+		// PointFromBytes decodes the canonical encoding output by Point.Bytes
+		PointFromBytes(bz []byte) (Point, error)
+	}
+
+	// Scalar is an integer modulo the group order. Its methods do not modify the receiver, and the arguments must be
+	// scalars of the same group.
+	Scalar interface {
+		Add(Scalar) Scalar
+		Sub(Scalar) Scalar
+		Mul(Scalar) Scalar
+		Negate() Scalar
+		// Invert returns the multiplicative inverse, or zero if the scalar is zero
+		Invert() Scalar
+		Equal(Scalar) bool
+		IsZero() bool
+		BigInt() *big.Int
+		Bytes() []byte
+	}
+
+	// Point is a group element. Its methods do not modify the receiver, and the arguments must be of the same group.
+	Point interface {
+		Add(Point) Point
+		Sub(Point) Point
+		Negate() Point
+		ScalarMult(Scalar) Point
+		Equal(Point) bool
+		IsIdentity() bool
+		Bytes() []byte
+	}
+
+	// CurveGroup is a Group of elliptic curve points with affine coordinates, which backs crypto.ECPoint.
+	CurveGroup interface {
+		Group
+		Curve() elliptic.Curve
+		// PointFromAffine returns the point (x, y), checking that it is on the curve
+		PointFromAffine(x, y *big.Int) (Point, error)
+		// Affine returns the affine coordinates of the point; the identity of a short Weierstrass curve is (0, 0)
+		Affine(p Point) (x, y *big.Int)
+	}
+)
+
+var (
+	registryMtx sync.RWMutex
+	registry    = make(map[tss.CurveName]CurveGroup)
+)
+
+func init() {
+	Register(tss.Secp256k1, Secp256k1())
+	Register(tss.Secp256r1, P256())
+	Register(tss.Ed25519, Ed25519())
+}
+
+// Register associates a CurveGroup with a curve name of the tss curve registry, so that crypto.ECPoint operations on
+// that curve are performed by the group.
+func Register(name tss.CurveName, g CurveGroup) {
+	registryMtx.Lock()
+	defer registryMtx.Unlock()
+	registry[name] = g
+}
+
+// FromCurve returns the CurveGroup registered for the curve, if any.
+func FromCurve(ec elliptic.Curve) (CurveGroup, bool) {
+	if ec == nil {
+		return nil, false
+	}
+	name, ok := tss.GetCurveName(ec)
+	if !ok {
+		return nil, false
+	}
+	registryMtx.RLock()
+	defer registryMtx.RUnlock()
+	g, ok := registry[name]
+	return g, ok
+}
+
+// HashToScalar hashes the length-prefixed domain separation tag and messages with SHA-512 and reduces the digest
+// modulo the group order.
+func HashToScalar(g Group, dst []byte, msgs ...[]byte) Scalar {
+	h := sha512.New()
+	var lenBz [8]byte
+	for _, bz := range append([][]byte{dst}, msgs...) {
+		binary.BigEndian.PutUint64(lenBz[:], uint64(len(bz)))
+		h.Write(lenBz[:])
+		h.Write(bz)
+	}
+	s, err := g.ScalarFromUniformBytes(h.Sum(nil))
+	if err != nil {
+		// every group accepts a 64-byte input
+		panic(err)
+	}
+	return s
+}
+
+// RandomScalar reduces 64 bytes read from rand modulo the group order, which is statistically close to uniform and
+// does not need the variable-time rejection sampling of common.GetRandomPositiveInt. It panics if rand fails.
+func RandomScalar(g Group, rand io.Reader) Scalar {
+	bz := make([]byte, 64)
+	if _, err := io.ReadFull(rand, bz); err != nil {
+		panic(fmt.Errorf("RandomScalar: unable to read from rand: %v", err))
+	}
+	s, err := g.ScalarFromUniformBytes(bz)
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
+
+// WipeScalars overwrites each scalar with zero in place, so that a secret does not linger in memory until it is
+// collected. It is the only operation that modifies a Scalar, so only scalars that are owned by the caller should be
+// wiped. Nil values are skipped.
+func WipeScalars(ss ...Scalar) {
+	for _, s := range ss {
+		if w, ok := s.(interface{ wipe() }); ok {
+			w.wipe()
+		}
+	}
+}
+
+// ClearTorsion returns [h][1/h mod Order]p, the component of p in the prime-order subgroup, where h is the cofactor.
+// It returns p unchanged for prime-order groups.
+func ClearTorsion(g Group, p Point) Point {
+	h := g.Cofactor()
+	if h.Cmp(big.NewInt(1)) == 0 {
+		return p
+	}
+	hScalar := g.ScalarFromBigInt(h)
+	return p.ScalarMult(hScalar).ScalarMult(hScalar.Invert())
+}
+
+// HashToPoint hashes the domain separation tag and messages to an element of the prime-order subgroup whose discrete
+// logarithm to the generator is unknown, by try-and-increment over candidate encodings. It is meant for deriving fixed
+// generators, such as the second generator of Pedersen commitments, and is not constant-time.
+func HashToPoint(g Group, dst []byte, msgs ...[]byte) Point {
+	encLen := len(g.Generator().Bytes())
+	var ctr [4]byte
+	for i := uint32(0); ; i++ {
+		binary.BigEndian.PutUint32(ctr[:], i)
+		bz := HashToScalar(g, dst, append(msgs, ctr[:])...).Bytes()
+		candidate := make([]byte, encLen)
+		if _, ok := g.(*weierstrassGroup); ok {
+			// SEC1 compressed encoding of the x-coordinate; hashing to a scalar keeps it below the field prime
+			candidate[0] = 0x02
+			copy(candidate[1:], bz)
+		} else {
+			copy(candidate, bz)
+		}
+		p, err := g.PointFromBytes(candidate)
+		if err != nil {
+			continue
+		}
+		if p = ClearTorsion(g, p); !p.IsIdentity() {
+			return p
+		}
+	}
+}
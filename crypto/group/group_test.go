@@ -0,0 +1,157 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package group_test
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bnb-chain/tss-lib/common"
+	. "github.com/bnb-chain/tss-lib/crypto/group"
+	"github.com/bnb-chain/tss-lib/tss"
+)
+
+var groups = []Group{Secp256k1(), P256(), Ed25519(), Ristretto255()}
+
+func randomScalar(g Group) Scalar {
+	return g.ScalarFromBigInt(common.GetRandomPositiveInt(g.Order()))
+}
+
+func TestGroupLaws(t *testing.T) {
+	for _, g := range groups {
+		a, b := randomScalar(g), randomScalar(g)
+		G := g.Generator()
+
+		// (a + b)G = aG + bG and (ab)G = a(bG)
+		assert.True(t, G.ScalarMult(a.Add(b)).Equal(G.ScalarMult(a).Add(G.ScalarMult(b))), g.Name())
+		assert.True(t, G.ScalarMult(a.Mul(b)).Equal(G.ScalarMult(b).ScalarMult(a)), g.Name())
+		assert.True(t, G.ScalarMult(a.Sub(b)).Equal(G.ScalarMult(a).Sub(G.ScalarMult(b))), g.Name())
+		assert.True(t, G.ScalarMult(a.Negate()).Equal(G.ScalarMult(a).Negate()), g.Name())
+		assert.True(t, G.ScalarMult(a).Add(G.ScalarMult(a).Negate()).IsIdentity(), g.Name())
+		assert.True(t, g.NewPoint().Add(G).Equal(G), g.Name())
+		assert.True(t, G.ScalarMult(g.NewScalar()).IsIdentity(), g.Name())
+
+		// a * a^-1 = 1
+		assert.True(t, a.Mul(a.Invert()).Equal(g.ScalarFromBigInt(big.NewInt(1))), g.Name())
+		assert.True(t, g.NewScalar().Invert().IsZero(), g.Name())
+
+		// the order annihilates the generator and is reduced to zero
+		assert.True(t, g.ScalarFromBigInt(g.Order()).IsZero(), g.Name())
+		assert.Equal(t, 0, g.ScalarFromBigInt(big.NewInt(-1)).BigInt().Cmp(new(big.Int).Sub(g.Order(), big.NewInt(1))), g.Name())
+	}
+}
+
+func TestWipeScalars(t *testing.T) {
+	for _, g := range groups {
+		a, b := randomScalar(g), randomScalar(g)
+		sum := a.Add(b)
+		WipeScalars(a, nil, b)
+		assert.True(t, a.IsZero(), g.Name())
+		assert.True(t, b.IsZero(), g.Name())
+		assert.False(t, sum.IsZero(), "a scalar computed before the wipe should be left as it is")
+	}
+}
+
+func TestGroupEncodings(t *testing.T) {
+	for _, g := range groups {
+		a := randomScalar(g)
+		a2, err := g.ScalarFromBytes(a.Bytes())
+		assert.NoError(t, err, g.Name())
+		assert.True(t, a.Equal(a2), g.Name())
+		assert.Equal(t, 0, a.BigInt().Cmp(a2.BigInt()), g.Name())
+
+		P := g.Generator().ScalarMult(a)
+		P2, err := g.PointFromBytes(P.Bytes())
+		assert.NoError(t, err, g.Name())
+		assert.True(t, P.Equal(P2), g.Name())
+
+		identity, err := g.PointFromBytes(g.NewPoint().Bytes())
+		assert.NoError(t, err, g.Name())
+		assert.True(t, identity.IsIdentity(), g.Name())
+
+		_, err = g.ScalarFromBytes(g.Order().FillBytes(make([]byte, len(a.Bytes()))))
+		assert.Error(t, err, "a non-reduced scalar should be rejected by %s", g.Name())
+		_, err = g.ScalarFromUniformBytes(make([]byte, 32))
+		assert.Error(t, err, g.Name())
+
+		// domain separation
+		assert.True(t, HashToScalar(g, []byte("a"), []byte("b")).Equal(HashToScalar(g, []byte("a"), []byte("b"))), g.Name())
+		assert.False(t, HashToScalar(g, []byte("a"), []byte("b")).Equal(HashToScalar(g, []byte("ab"))), g.Name())
+	}
+}
+
+func TestGeneratorEncodings(t *testing.T) {
+	expected := map[string]string{
+		"secp256k1":    "0279be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798",
+		"P-256":        "036b17d1f2e12c4247f8bce6e563a440f277037d812deb33a0f4a13945d898c296",
+		"Ed25519":      "5866666666666666666666666666666666666666666666666666666666666666",
+		"ristretto255": "e2f2ae0a6abc4e71a884a961c500515f58e30b6aa582dd8db6a65945e08d2d76",
+	}
+	for _, g := range groups {
+		assert.Equal(t, expected[g.Name()], hex.EncodeToString(g.Generator().Bytes()))
+	}
+}
+
+func TestCurveGroupAffine(t *testing.T) {
+	for _, name := range []tss.CurveName{tss.Secp256k1, tss.Secp256r1, tss.Ed25519} {
+		curve, _ := tss.GetCurveByName(name)
+		g, ok := FromCurve(curve)
+		assert.True(t, ok, name)
+		assert.Equal(t, curve.Params().Name, g.Curve().Params().Name)
+
+		params := curve.Params()
+		G, err := g.PointFromAffine(params.Gx, params.Gy)
+		assert.NoError(t, err)
+		assert.True(t, G.Equal(g.Generator()), name)
+
+		k := common.GetRandomPositiveInt(params.N)
+		x, y := g.Affine(G.ScalarMult(g.ScalarFromBigInt(k)))
+		ex, ey := curve.ScalarBaseMult(k.Bytes())
+		assert.Equal(t, 0, x.Cmp(ex), name)
+		assert.Equal(t, 0, y.Cmp(ey), name)
+
+		_, err = g.PointFromAffine(params.Gx, new(big.Int).Add(params.Gy, big.NewInt(1)))
+		assert.Error(t, err, "a point off the curve should be rejected by %s", name)
+	}
+}
+
+func TestClearTorsion(t *testing.T) {
+	g := Ed25519()
+	// (0, -1) has order 2
+	lowOrder, err := g.PointFromBytes(append([]byte{0xec}, append(bytesOf(0xff, 30), 0x7f)...))
+	assert.NoError(t, err)
+	assert.False(t, lowOrder.IsIdentity())
+	assert.True(t, lowOrder.Add(lowOrder).IsIdentity())
+
+	P := g.Generator().ScalarMult(randomScalar(g))
+	assert.False(t, P.Add(lowOrder).Equal(P))
+	assert.True(t, ClearTorsion(g, P.Add(lowOrder)).Equal(P))
+	assert.True(t, ClearTorsion(Secp256k1(), Secp256k1().Generator()).Equal(Secp256k1().Generator()))
+}
+
+func bytesOf(b byte, n int) []byte {
+	bz := make([]byte, n)
+	for i := range bz {
+		bz[i] = b
+	}
+	return bz
+}
+
+func TestHashToPoint(t *testing.T) {
+	for _, g := range groups {
+		p := HashToPoint(g, []byte("test"), []byte("a"))
+		assert.False(t, p.IsIdentity(), g.Name())
+		assert.True(t, p.Equal(HashToPoint(g, []byte("test"), []byte("a"))), g.Name())
+		assert.False(t, p.Equal(HashToPoint(g, []byte("test"), []byte("b"))), g.Name())
+		assert.False(t, p.Equal(g.Generator()), g.Name())
+		// the point is in the prime-order subgroup
+		assert.True(t, p.ScalarMult(g.ScalarFromBigInt(g.Order())).IsIdentity(), g.Name())
+	}
+}
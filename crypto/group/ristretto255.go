@@ -0,0 +1,187 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package group
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/decred/dcrd/dcrec/edwards/v2"
+	"github.com/gtank/ristretto255"
+)
+
+// ristretto255Group is the prime-order group built from edwards25519 by the ristretto255 encoding. It has no affine
+// coordinates, so it is not a CurveGroup and cannot back crypto.ECPoint.
+type (
+	ristretto255Group struct{}
+
+	ristretto255Scalar struct {
+		s *ristretto255.Scalar
+	}
+
+	ristretto255Point struct {
+		e *ristretto255.Element
+	}
+)
+
+var (
+	ristretto255GroupInstance = &ristretto255Group{}
+	ristretto255Order         = new(big.Int).Set(edwards.Edwards().Params().N)
+)
+
+// Ristretto255 returns the ristretto255 group.
+func Ristretto255() Group {
+	return ristretto255GroupInstance
+}
+
+func (g *ristretto255Group) Name() string {
+	return "ristretto255"
+}
+
+func (g *ristretto255Group) Order() *big.Int {
+	return new(big.Int).Set(ristretto255Order)
+}
+
+func (g *ristretto255Group) Cofactor() *big.Int {
+	return big.NewInt(1)
+}
+
+func (g *ristretto255Group) NewScalar() Scalar {
+	return &ristretto255Scalar{ristretto255.NewScalar()}
+}
+
+func (g *ristretto255Group) ScalarFromBigInt(k *big.Int) Scalar {
+	s := ristretto255.NewScalar()
+	if err := s.Decode(littleEndian(new(big.Int).Mod(k, ristretto255Order))); err != nil {
+		// k mod order is always canonical
+		panic(err)
+	}
+	return &ristretto255Scalar{s}
+}
+
+func (g *ristretto255Group) ScalarFromBytes(bz []byte) (Scalar, error) {
+	s := ristretto255.NewScalar()
+	if err := s.Decode(bz); err != nil {
+		return nil, err
+	}
+	return &ristretto255Scalar{s}, nil
+}
+
+func (g *ristretto255Group) ScalarFromUniformBytes(bz []byte) (Scalar, error) {
+	if len(bz) != 64 {
+		return nil, errors.New("the uniform bytes must be 64 bytes long")
+	}
+	return &ristretto255Scalar{ristretto255.NewScalar().FromUniformBytes(bz)}, nil
+}
+
+func (g *ristretto255Group) NewPoint() Point {
+	return &ristretto255Point{ristretto255.NewElement().Zero()}
+}
+
+func (g *ristretto255Group) Generator() Point {
+	return &ristretto255Point{ristretto255.NewElement().Base()}
+}
+
+func (g *ristretto255Group) PointFromBytes(bz []byte) (Point, error) {
+	e := ristretto255.NewElement()
+	if err := e.Decode(bz); err != nil {
+		return nil, err
+	}
+	return &ristretto255Point{e}, nil
+}
+
+// ----- //
+
+func (s *ristretto255Scalar) Add(t Scalar) Scalar {
+	return &ristretto255Scalar{ristretto255.NewScalar().Add(s.s, ristretto255ScalarOf(t).s)}
+}
+
+func (s *ristretto255Scalar) Sub(t Scalar) Scalar {
+	return &ristretto255Scalar{ristretto255.NewScalar().Subtract(s.s, ristretto255ScalarOf(t).s)}
+}
+
+func (s *ristretto255Scalar) Mul(t Scalar) Scalar {
+	return &ristretto255Scalar{ristretto255.NewScalar().Multiply(s.s, ristretto255ScalarOf(t).s)}
+}
+
+func (s *ristretto255Scalar) Negate() Scalar {
+	return &ristretto255Scalar{ristretto255.NewScalar().Negate(s.s)}
+}
+
+func (s *ristretto255Scalar) Invert() Scalar {
+	return &ristretto255Scalar{ristretto255.NewScalar().Invert(s.s)}
+}
+
+func (s *ristretto255Scalar) Equal(t Scalar) bool {
+	return s.s.Equal(ristretto255ScalarOf(t).s) == 1
+}
+
+func (s *ristretto255Scalar) IsZero() bool {
+	return s.s.Equal(ristretto255.NewScalar()) == 1
+}
+
+func (s *ristretto255Scalar) BigInt() *big.Int {
+	return bigIntFromLittleEndian(s.Bytes())
+}
+
+func (s *ristretto255Scalar) Bytes() []byte {
+	return s.s.Encode(nil)
+}
+
+func (s *ristretto255Scalar) wipe() {
+	if s != nil && s.s != nil {
+		s.s.Zero()
+	}
+}
+
+// ----- //
+
+func (p *ristretto255Point) Add(q Point) Point {
+	return &ristretto255Point{ristretto255.NewElement().Add(p.e, ristretto255PointOf(q).e)}
+}
+
+func (p *ristretto255Point) Sub(q Point) Point {
+	return &ristretto255Point{ristretto255.NewElement().Subtract(p.e, ristretto255PointOf(q).e)}
+}
+
+func (p *ristretto255Point) Negate() Point {
+	return &ristretto255Point{ristretto255.NewElement().Negate(p.e)}
+}
+
+func (p *ristretto255Point) ScalarMult(s Scalar) Point {
+	return &ristretto255Point{ristretto255.NewElement().ScalarMult(ristretto255ScalarOf(s).s, p.e)}
+}
+
+func (p *ristretto255Point) Equal(q Point) bool {
+	return p.e.Equal(ristretto255PointOf(q).e) == 1
+}
+
+func (p *ristretto255Point) IsIdentity() bool {
+	return p.e.Equal(ristretto255.NewElement().Zero()) == 1
+}
+
+func (p *ristretto255Point) Bytes() []byte {
+	return p.e.Encode(nil)
+}
+
+// ----- //
+
+func ristretto255ScalarOf(s Scalar) *ristretto255Scalar {
+	rs, ok := s.(*ristretto255Scalar)
+	if !ok {
+		panic(errors.New("the scalar is not of the ristretto255 group"))
+	}
+	return rs
+}
+
+func ristretto255PointOf(p Point) *ristretto255Point {
+	rp, ok := p.(*ristretto255Point)
+	if !ok {
+		panic(errors.New("the point is not of the ristretto255 group"))
+	}
+	return rp
+}
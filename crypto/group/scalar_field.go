@@ -0,0 +1,235 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package group
+
+import (
+	"errors"
+	"math/big"
+	"math/bits"
+)
+
+// scalarField implements constant-time arithmetic modulo the 256-bit order of a short Weierstrass curve. Elements are
+// kept in the Montgomery domain as four little-endian 64-bit limbs, and no operation branches on or indexes memory by
+// the value of an element. The modulus must be odd and have its top bit set (2^255 < n < 2^256), as the orders of
+// secp256k1 and P-256 do.
+type (
+	scalarField struct {
+		n       fieldElement
+		nInv    uint64       // -n^-1 mod 2^64
+		rr      fieldElement // R^2 mod n, with R = 2^256
+		r256    fieldElement // 2^256 mod n in the Montgomery domain, to reduce wide inputs
+		nMinus2 fieldElement // the exponent of a Fermat inversion
+	}
+
+	fieldElement [4]uint64
+)
+
+func newScalarField(n *big.Int) *scalarField {
+	if n.BitLen() != 256 || n.Bit(0) != 1 {
+		panic(errors.New("the scalar field modulus must be an odd 256-bit integer"))
+	}
+	f := &scalarField{n: limbsOf(n)}
+
+	// Newton's iteration doubles the number of correct low bits of n^-1 mod 2^64 each step, starting from 1 bit
+	inv := uint64(1)
+	for i := 0; i < 6; i++ {
+		inv *= 2 - f.n[0]*inv
+	}
+	f.nInv = -inv
+
+	R := new(big.Int).Lsh(big.NewInt(1), 256)
+	f.rr = limbsOf(new(big.Int).Mod(new(big.Int).Mul(R, R), n))
+	f.r256 = f.toMontgomery(limbsOf(new(big.Int).Mod(R, n)))
+	f.nMinus2 = limbsOf(new(big.Int).Sub(n, big.NewInt(2)))
+	return f
+}
+
+// ----- //
+// Conversions; the inputs and outputs are big-endian byte strings as in big.Int.
+
+// setCanonicalBytes decodes a 32-byte big-endian integer, which must be less than n.
+func (f *scalarField) setCanonicalBytes(bz []byte) (fieldElement, error) {
+	if len(bz) != 32 {
+		return fieldElement{}, errors.New("invalid scalar encoding length")
+	}
+	a := limbsFromBytes(bz)
+	if _, borrow := sub256(&a, &f.n); borrow == 0 {
+		return fieldElement{}, errors.New("the scalar encoding is not reduced")
+	}
+	return f.toMontgomery(a), nil
+}
+
+// setBytes reduces a big-endian integer of any length modulo n. Its running time depends only on the length of bz.
+func (f *scalarField) setBytes(bz []byte) fieldElement {
+	// pad to a multiple of 32 bytes and reduce each chunk into acc = acc * 2^256 + chunk, from the most significant
+	padded := make([]byte, (len(bz)+31)/32*32)
+	copy(padded[len(padded)-len(bz):], bz)
+	var acc fieldElement
+	for i := 0; i < len(padded); i += 32 {
+		chunk := limbsFromBytes(padded[i : i+32])
+		// chunk < 2^256 < 2n, so one conditional subtraction reduces it
+		reduced, borrow := sub256(&chunk, &f.n)
+		chunk = selectLimbs(borrow, &chunk, &reduced)
+		acc = f.add(f.mul(acc, f.r256), f.toMontgomery(chunk))
+	}
+	return acc
+}
+
+// bytes returns the 32-byte big-endian encoding of the element.
+func (f *scalarField) bytes(a fieldElement) []byte {
+	plain := f.fromMontgomery(a)
+	bz := make([]byte, 32)
+	for i := 0; i < 4; i++ {
+		for j := 0; j < 8; j++ {
+			bz[31-8*i-j] = byte(plain[i] >> (8 * uint(j)))
+		}
+	}
+	return bz
+}
+
+func (f *scalarField) toMontgomery(a fieldElement) fieldElement {
+	return f.mul(a, f.rr)
+}
+
+func (f *scalarField) fromMontgomery(a fieldElement) fieldElement {
+	return f.mul(a, fieldElement{1})
+}
+
+// ----- //
+// Arithmetic; the operands must be reduced modulo n.
+
+func (f *scalarField) add(a, b fieldElement) fieldElement {
+	var sum fieldElement
+	var carry uint64
+	sum[0], carry = bits.Add64(a[0], b[0], 0)
+	sum[1], carry = bits.Add64(a[1], b[1], carry)
+	sum[2], carry = bits.Add64(a[2], b[2], carry)
+	sum[3], carry = bits.Add64(a[3], b[3], carry)
+	reduced, borrow := sub256(&sum, &f.n)
+	// keep the sum only if it did not overflow and is less than n
+	return selectLimbs(borrow&^carry, &sum, &reduced)
+}
+
+func (f *scalarField) sub(a, b fieldElement) fieldElement {
+	diff, borrow := sub256(&a, &b)
+	// add n back if the subtraction underflowed
+	mask := -borrow
+	var carry uint64
+	diff[0], carry = bits.Add64(diff[0], f.n[0]&mask, 0)
+	diff[1], carry = bits.Add64(diff[1], f.n[1]&mask, carry)
+	diff[2], carry = bits.Add64(diff[2], f.n[2]&mask, carry)
+	diff[3], _ = bits.Add64(diff[3], f.n[3]&mask, carry)
+	return diff
+}
+
+func (f *scalarField) neg(a fieldElement) fieldElement {
+	return f.sub(fieldElement{}, a)
+}
+
+// mul returns a * b / R mod n, the Montgomery product (CIOS method)
+func (f *scalarField) mul(a, b fieldElement) fieldElement {
+	var t0, t1, t2, t3, t4 uint64
+	for i := 0; i < 4; i++ {
+		var c, t5, carry uint64
+		c, t0 = madd(a[0], b[i], t0, 0)
+		c, t1 = madd(a[1], b[i], t1, c)
+		c, t2 = madd(a[2], b[i], t2, c)
+		c, t3 = madd(a[3], b[i], t3, c)
+		t4, t5 = bits.Add64(t4, c, 0)
+
+		// add m * n, which makes the lowest word zero, and shift down by one word
+		m := t0 * f.nInv
+		c, _ = madd(m, f.n[0], t0, 0)
+		c, t0 = madd(m, f.n[1], t1, c)
+		c, t1 = madd(m, f.n[2], t2, c)
+		c, t2 = madd(m, f.n[3], t3, c)
+		t3, carry = bits.Add64(t4, c, 0)
+		t4 = t5 + carry
+	}
+	res := fieldElement{t0, t1, t2, t3}
+	reduced, borrow := sub256(&res, &f.n)
+	// t < 2n: subtract n if t overflowed 256 bits or is at least n
+	return selectLimbs(borrow&^t4, &res, &reduced)
+}
+
+// inv returns a^(n-2) mod n, the inverse of a (or zero if a is zero) by Fermat's little theorem. The exponent is
+// public, so the fixed 4-bit window below indexes its table by the exponent only.
+func (f *scalarField) inv(a fieldElement) fieldElement {
+	var table [16]fieldElement // a^0 .. a^15
+	table[0] = f.toMontgomery(fieldElement{1})
+	for i := 1; i < 16; i++ {
+		table[i] = f.mul(table[i-1], a)
+	}
+	result := table[0]
+	for i := 63; i >= 0; i-- {
+		for j := 0; j < 4; j++ {
+			result = f.mul(result, result)
+		}
+		if window := (f.nMinus2[i/16] >> (4 * uint(i%16))) & 0xf; window != 0 {
+			result = f.mul(result, table[window])
+		}
+	}
+	return result
+}
+
+func (f *scalarField) equal(a, b fieldElement) bool {
+	var acc uint64
+	for i := 0; i < 4; i++ {
+		acc |= a[i] ^ b[i]
+	}
+	return acc == 0
+}
+
+func (f *scalarField) isZero(a fieldElement) bool {
+	return f.equal(a, fieldElement{})
+}
+
+// ----- //
+
+// madd returns the high and low words of a * b + c + d, which cannot overflow 128 bits
+func madd(a, b, c, d uint64) (hi, lo uint64) {
+	hi, lo = bits.Mul64(a, b)
+	var carry uint64
+	lo, carry = bits.Add64(lo, c, 0)
+	hi += carry
+	lo, carry = bits.Add64(lo, d, 0)
+	hi += carry
+	return
+}
+
+// sub256 returns a - b mod 2^256 and the borrow (1 if a < b)
+func sub256(a, b *fieldElement) (diff fieldElement, borrow uint64) {
+	diff[0], borrow = bits.Sub64(a[0], b[0], 0)
+	diff[1], borrow = bits.Sub64(a[1], b[1], borrow)
+	diff[2], borrow = bits.Sub64(a[2], b[2], borrow)
+	diff[3], borrow = bits.Sub64(a[3], b[3], borrow)
+	return
+}
+
+// selectLimbs returns a if cond is 1 and b if cond is 0, without branching
+func selectLimbs(cond uint64, a, b *fieldElement) fieldElement {
+	mask := -cond
+	var out fieldElement
+	for i := 0; i < 4; i++ {
+		out[i] = (a[i] & mask) | (b[i] &^ mask)
+	}
+	return out
+}
+
+func limbsFromBytes(bz []byte) fieldElement {
+	var a fieldElement
+	for i := 0; i < 4; i++ {
+		for j := 0; j < 8; j++ {
+			a[i] |= uint64(bz[31-8*i-j]) << (8 * uint(j))
+		}
+	}
+	return a
+}
+
+func limbsOf(k *big.Int) fieldElement {
+	return limbsFromBytes(k.FillBytes(make([]byte, 32)))
+}
@@ -0,0 +1,138 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package group
+
+import (
+	"crypto/elliptic"
+	"crypto/rand"
+	"math/big"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bnb-chain/tss-lib/common"
+)
+
+var fieldOrders = []*big.Int{btcec.S256().N, elliptic.P256().Params().N}
+
+// fieldTestValues returns random values and the edge cases 0, 1, n-1 and 2^256-1 (not reduced)
+func fieldTestValues(n *big.Int) []*big.Int {
+	values := []*big.Int{
+		big.NewInt(0),
+		big.NewInt(1),
+		new(big.Int).Sub(n, big.NewInt(1)),
+		new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1)),
+	}
+	for i := 0; i < 16; i++ {
+		values = append(values, common.GetRandomPositiveInt(n))
+	}
+	return values
+}
+
+func TestScalarFieldMatchesBigInt(t *testing.T) {
+	for _, n := range fieldOrders {
+		f := newScalarField(n)
+		modN := common.ModInt(n)
+		toBig := func(a fieldElement) *big.Int { return new(big.Int).SetBytes(f.bytes(a)) }
+		values := fieldTestValues(n)
+		for _, x := range values {
+			a := f.setBytes(x.Bytes())
+			assert.Equal(t, 0, toBig(a).Cmp(new(big.Int).Mod(x, n)))
+			assert.Equal(t, 0, toBig(f.neg(a)).Cmp(modN.Sub(big.NewInt(0), x)))
+			if new(big.Int).Mod(x, n).Sign() != 0 {
+				assert.Equal(t, 0, toBig(f.inv(a)).Cmp(modN.ModInverse(x)))
+			}
+			for _, y := range values {
+				b := f.setBytes(y.Bytes())
+				assert.Equal(t, 0, toBig(f.add(a, b)).Cmp(modN.Add(x, y)))
+				assert.Equal(t, 0, toBig(f.sub(a, b)).Cmp(modN.Sub(x, y)))
+				assert.Equal(t, 0, toBig(f.mul(a, b)).Cmp(modN.Mul(x, y)))
+			}
+		}
+		assert.True(t, f.isZero(f.inv(fieldElement{})))
+	}
+}
+
+func TestScalarFieldWideReduction(t *testing.T) {
+	for _, n := range fieldOrders {
+		f := newScalarField(n)
+		for _, size := range []int{0, 1, 31, 33, 64, 65, 200} {
+			bz := make([]byte, size)
+			_, _ = rand.Read(bz)
+			expected := new(big.Int).Mod(new(big.Int).SetBytes(bz), n)
+			assert.Equal(t, 0, new(big.Int).SetBytes(f.bytes(f.setBytes(bz))).Cmp(expected), "%d bytes", size)
+		}
+		_, err := f.setCanonicalBytes(n.Bytes())
+		assert.Error(t, err)
+		_, err = f.setCanonicalBytes(make([]byte, 31))
+		assert.Error(t, err)
+	}
+}
+
+// ----- //
+
+func BenchmarkScalarMul(b *testing.B) {
+	g := Secp256k1()
+	x := g.ScalarFromBigInt(common.GetRandomPositiveInt(g.Order()))
+	y := g.ScalarFromBigInt(common.GetRandomPositiveInt(g.Order()))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		x = x.Mul(y)
+	}
+}
+
+func BenchmarkScalarAdd(b *testing.B) {
+	g := Secp256k1()
+	x := g.ScalarFromBigInt(common.GetRandomPositiveInt(g.Order()))
+	y := g.ScalarFromBigInt(common.GetRandomPositiveInt(g.Order()))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		x = x.Add(y)
+	}
+}
+
+func BenchmarkScalarInvert(b *testing.B) {
+	g := Secp256k1()
+	x := g.ScalarFromBigInt(common.GetRandomPositiveInt(g.Order()))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		x = x.Invert()
+	}
+}
+
+// the variable-time math/big baselines
+
+func BenchmarkModIntMul(b *testing.B) {
+	n := btcec.S256().N
+	modN := common.ModInt(n)
+	x, y := common.GetRandomPositiveInt(n), common.GetRandomPositiveInt(n)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		x = modN.Mul(x, y)
+	}
+}
+
+func BenchmarkModIntAdd(b *testing.B) {
+	n := btcec.S256().N
+	modN := common.ModInt(n)
+	x, y := common.GetRandomPositiveInt(n), common.GetRandomPositiveInt(n)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		x = modN.Add(x, y)
+	}
+}
+
+func BenchmarkModIntInverse(b *testing.B) {
+	n := btcec.S256().N
+	modN := common.ModInt(n)
+	x := common.GetRandomPositiveInt(n)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		x = modN.ModInverse(x)
+	}
+}
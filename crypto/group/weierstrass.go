@@ -0,0 +1,274 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package group
+
+import (
+	"crypto/elliptic"
+	"errors"
+	"math/big"
+
+	"github.com/btcsuite/btcd/btcec"
+)
+
+// weierstrassGroup is a prime-order short Weierstrass curve backed by its elliptic.Curve implementation.
+// Points are SEC1 compressed when encoded, and the identity is encoded as the single byte 0x00.
+type (
+	weierstrassGroup struct {
+		name      string
+		curve     elliptic.Curve
+		byteLen   int
+		field     *scalarField
+		parseSEC1 func(bz []byte) (x, y *big.Int, err error)
+	}
+
+	// weierstrassScalar is constant-time; see scalarField
+	weierstrassScalar struct {
+		g *weierstrassGroup
+		v fieldElement
+	}
+
+	weierstrassPoint struct {
+		g    *weierstrassGroup
+		x, y *big.Int // (0, 0) is the identity, as in crypto/elliptic and btcec
+	}
+)
+
+var (
+	secp256k1Group = &weierstrassGroup{
+		name:    "secp256k1",
+		curve:   btcec.S256(),
+		byteLen: 32,
+		field:   newScalarField(btcec.S256().N),
+		parseSEC1: func(bz []byte) (*big.Int, *big.Int, error) {
+			pk, err := btcec.ParsePubKey(bz, btcec.S256())
+			if err != nil {
+				return nil, nil, err
+			}
+			return pk.X, pk.Y, nil
+		},
+	}
+	p256Group = &weierstrassGroup{
+		name:    "P-256",
+		curve:   elliptic.P256(),
+		byteLen: 32,
+		field:   newScalarField(elliptic.P256().Params().N),
+		parseSEC1: func(bz []byte) (x, y *big.Int, err error) {
+			if len(bz) > 0 && bz[0] == 0x04 {
+				x, y = elliptic.Unmarshal(elliptic.P256(), bz)
+			} else {
+				x, y = elliptic.UnmarshalCompressed(elliptic.P256(), bz)
+			}
+			if x == nil {
+				return nil, nil, errors.New("invalid P-256 point encoding")
+			}
+			return x, y, nil
+		},
+	}
+)
+
+// Secp256k1 returns the secp256k1 group.
+func Secp256k1() CurveGroup {
+	return secp256k1Group
+}
+
+// P256 returns the NIST P-256 group.
+func P256() CurveGroup {
+	return p256Group
+}
+
+func (g *weierstrassGroup) Name() string {
+	return g.name
+}
+
+func (g *weierstrassGroup) Order() *big.Int {
+	return new(big.Int).Set(g.curve.Params().N)
+}
+
+func (g *weierstrassGroup) Cofactor() *big.Int {
+	return big.NewInt(1)
+}
+
+func (g *weierstrassGroup) Curve() elliptic.Curve {
+	return g.curve
+}
+
+func (g *weierstrassGroup) NewScalar() Scalar {
+	return &weierstrassScalar{g: g}
+}
+
+// ScalarFromBigInt is a serialization boundary: the length and sign of k may leak, but not its value modulo the order.
+func (g *weierstrassGroup) ScalarFromBigInt(k *big.Int) Scalar {
+	v := g.field.setBytes(new(big.Int).Abs(k).Bytes())
+	if k.Sign() < 0 {
+		v = g.field.neg(v)
+	}
+	return &weierstrassScalar{g, v}
+}
+
+func (g *weierstrassGroup) ScalarFromBytes(bz []byte) (Scalar, error) {
+	v, err := g.field.setCanonicalBytes(bz)
+	if err != nil {
+		return nil, err
+	}
+	return &weierstrassScalar{g, v}, nil
+}
+
+func (g *weierstrassGroup) ScalarFromUniformBytes(bz []byte) (Scalar, error) {
+	if len(bz) != 64 {
+		return nil, errors.New("the uniform bytes must be 64 bytes long")
+	}
+	return &weierstrassScalar{g, g.field.setBytes(bz)}, nil
+}
+
+func (g *weierstrassGroup) NewPoint() Point {
+	return &weierstrassPoint{g, new(big.Int), new(big.Int)}
+}
+
+func (g *weierstrassGroup) Generator() Point {
+	params := g.curve.Params()
+	return &weierstrassPoint{g, new(big.Int).Set(params.Gx), new(big.Int).Set(params.Gy)}
+}
+
+func (g *weierstrassGroup) PointFromBytes(bz []byte) (Point, error) {
+	if len(bz) == 1 && bz[0] == 0x00 {
+		return g.NewPoint(), nil
+	}
+	if len(bz) != 1+g.byteLen {
+		return nil, errors.New("the point encoding must be SEC1 compressed")
+	}
+	x, y, err := g.parseSEC1(bz)
+	if err != nil {
+		return nil, err
+	}
+	return &weierstrassPoint{g, x, y}, nil
+}
+
+func (g *weierstrassGroup) PointFromAffine(x, y *big.Int) (Point, error) {
+	if x == nil || y == nil || !g.curve.IsOnCurve(x, y) {
+		return nil, errors.New("the point is not on the curve")
+	}
+	return &weierstrassPoint{g, new(big.Int).Set(x), new(big.Int).Set(y)}, nil
+}
+
+func (g *weierstrassGroup) Affine(p Point) (x, y *big.Int) {
+	wp := g.point(p)
+	return new(big.Int).Set(wp.x), new(big.Int).Set(wp.y)
+}
+
+func (g *weierstrassGroup) scalar(s Scalar) *weierstrassScalar {
+	ws, ok := s.(*weierstrassScalar)
+	if !ok || ws.g != g {
+		panic(errors.New("the scalar is not of the " + g.name + " group"))
+	}
+	return ws
+}
+
+func (g *weierstrassGroup) point(p Point) *weierstrassPoint {
+	wp, ok := p.(*weierstrassPoint)
+	if !ok || wp.g != g {
+		panic(errors.New("the point is not of the " + g.name + " group"))
+	}
+	return wp
+}
+
+// ----- //
+
+func (s *weierstrassScalar) Add(t Scalar) Scalar {
+	return &weierstrassScalar{s.g, s.g.field.add(s.v, s.g.scalar(t).v)}
+}
+
+func (s *weierstrassScalar) Sub(t Scalar) Scalar {
+	return &weierstrassScalar{s.g, s.g.field.sub(s.v, s.g.scalar(t).v)}
+}
+
+func (s *weierstrassScalar) Mul(t Scalar) Scalar {
+	return &weierstrassScalar{s.g, s.g.field.mul(s.v, s.g.scalar(t).v)}
+}
+
+func (s *weierstrassScalar) Negate() Scalar {
+	return &weierstrassScalar{s.g, s.g.field.neg(s.v)}
+}
+
+func (s *weierstrassScalar) Invert() Scalar {
+	return &weierstrassScalar{s.g, s.g.field.inv(s.v)}
+}
+
+func (s *weierstrassScalar) Equal(t Scalar) bool {
+	return s.g.field.equal(s.v, s.g.scalar(t).v)
+}
+
+func (s *weierstrassScalar) IsZero() bool {
+	return s.g.field.isZero(s.v)
+}
+
+func (s *weierstrassScalar) BigInt() *big.Int {
+	return new(big.Int).SetBytes(s.Bytes())
+}
+
+func (s *weierstrassScalar) Bytes() []byte {
+	return s.g.field.bytes(s.v)
+}
+
+func (s *weierstrassScalar) wipe() {
+	if s != nil {
+		s.v = fieldElement{}
+	}
+}
+
+// ----- //
+
+func (p *weierstrassPoint) Add(q Point) Point {
+	wq := p.g.point(q)
+	x, y := p.g.curve.Add(p.x, p.y, wq.x, wq.y)
+	return &weierstrassPoint{p.g, x, y}
+}
+
+func (p *weierstrassPoint) Sub(q Point) Point {
+	return p.Add(q.Negate())
+}
+
+func (p *weierstrassPoint) Negate() Point {
+	if p.IsIdentity() {
+		return p.g.NewPoint()
+	}
+	return &weierstrassPoint{p.g, new(big.Int).Set(p.x), new(big.Int).Sub(p.g.curve.Params().P, p.y)}
+}
+
+func (p *weierstrassPoint) ScalarMult(s Scalar) Point {
+	k := p.g.scalar(s).Bytes()
+	var x, y *big.Int
+	if p.g.isGenerator(p) {
+		x, y = p.g.curve.ScalarBaseMult(k)
+	} else {
+		x, y = p.g.curve.ScalarMult(p.x, p.y, k)
+	}
+	return &weierstrassPoint{p.g, x, y}
+}
+
+func (p *weierstrassPoint) Equal(q Point) bool {
+	wq := p.g.point(q)
+	return p.x.Cmp(wq.x) == 0 && p.y.Cmp(wq.y) == 0
+}
+
+func (p *weierstrassPoint) IsIdentity() bool {
+	return p.x.Sign() == 0 && p.y.Sign() == 0
+}
+
+func (p *weierstrassPoint) Bytes() []byte {
+	if p.IsIdentity() {
+		return []byte{0x00}
+	}
+	bz := make([]byte, 1+p.g.byteLen)
+	bz[0] = 0x02 | byte(p.y.Bit(0))
+	p.x.FillBytes(bz[1:])
+	return bz
+}
+
+func (g *weierstrassGroup) isGenerator(p *weierstrassPoint) bool {
+	params := g.curve.Params()
+	return p.x.Cmp(params.Gx) == 0 && p.y.Cmp(params.Gy) == 0
+}
@@ -10,11 +10,13 @@ import (
 	"crypto/elliptic"
 	"errors"
 	"fmt"
+	"io"
 	"math/big"
 
 	"github.com/bnb-chain/tss-lib/common"
 	"github.com/bnb-chain/tss-lib/crypto"
 	"github.com/bnb-chain/tss-lib/crypto/paillier"
+	"github.com/bnb-chain/tss-lib/crypto/transcript"
 )
 
 const (
@@ -35,7 +37,8 @@ type (
 
 // ProveBobWC implements Bob's proof both with or without check "ProveMtawc_Bob" and "ProveMta_Bob" used in the MtA protocol from GG18Spec (9) Figs. 10 & 11.
 // an absent `X` generates the proof without the X consistency check X = g^x
-func ProveBobWC(ec elliptic.Curve, pk *paillier.PublicKey, NTilde, h1, h2, c1, c2, x, y, r *big.Int, X *crypto.ECPoint) (*ProofBobWC, error) {
+// The challenge is drawn from `tr`, or derived as in earlier versions if `tr` is nil.
+func ProveBobWC(tr *transcript.Transcript, ec elliptic.Curve, pk *paillier.PublicKey, NTilde, h1, h2, c1, c2, x, y, r *big.Int, X *crypto.ECPoint, rand io.Reader) (*ProofBobWC, error) {
 	if pk == nil || NTilde == nil || h1 == nil || h2 == nil || c1 == nil || c2 == nil || x == nil || y == nil || r == nil {
 		return nil, errors.New("ProveBob() received a nil argument")
 	}
@@ -50,19 +53,19 @@ func ProveBobWC(ec elliptic.Curve, pk *paillier.PublicKey, NTilde, h1, h2, c1, c
 
 	// steps are numbered as shown in Fig. 10, but diverge slightly for Fig. 11
 	// 1.
-	alpha := common.GetRandomPositiveInt(q3)
+	alpha := common.GetRandomPositiveIntWithRandom(rand, q3)
 
 	// 2.
-	rho := common.GetRandomPositiveInt(qNTilde)
-	sigma := common.GetRandomPositiveInt(qNTilde)
-	tau := common.GetRandomPositiveInt(qNTilde)
+	rho := common.GetRandomPositiveIntWithRandom(rand, qNTilde)
+	sigma := common.GetRandomPositiveIntWithRandom(rand, qNTilde)
+	tau := common.GetRandomPositiveIntWithRandom(rand, qNTilde)
 
 	// 3.
-	rhoPrm := common.GetRandomPositiveInt(q3NTilde)
+	rhoPrm := common.GetRandomPositiveIntWithRandom(rand, q3NTilde)
 
 	// 4.
-	beta := common.GetRandomPositiveRelativelyPrimeInt(pk.N)
-	gamma := common.GetRandomPositiveRelativelyPrimeInt(pk.N)
+	beta, betaN := pk.RandomNthPower(rand)
+	gamma := common.GetRandomPositiveRelativelyPrimeIntWithRandom(rand, pk.N)
 
 	// 5.
 	u := crypto.NewECPointNoCurveCheck(ec, zero, zero) // initialization suppresses an IDE warning
@@ -86,25 +89,16 @@ func ProveBobWC(ec elliptic.Curve, pk *paillier.PublicKey, NTilde, h1, h2, c1, c
 	// 9.
 	modNSquared := common.ModInt(NSquared)
 	v := modNSquared.Exp(c1, alpha)
-	v = modNSquared.Mul(v, modNSquared.Exp(pk.Gamma(), gamma))
-	v = modNSquared.Mul(v, modNSquared.Exp(beta, pk.N))
+	v = modNSquared.Mul(v, pk.ExpGamma(gamma))
+	v = modNSquared.Mul(v, betaN)
 
 	// 10.
 	w := modNTilde.Exp(h1, gamma)
 	w = modNTilde.Mul(w, modNTilde.Exp(h2, tau))
 
 	// 11-12. e'
-	var e *big.Int
-	{ // must use RejectionSample
-		var eHash *big.Int
-		// X is nil if called by ProveBob (Bob's proof "without check")
-		if X == nil {
-			eHash = common.SHA512_256i(append(pk.AsInts(), c1, c2, z, zPrm, t, v, w)...)
-		} else {
-			eHash = common.SHA512_256i(append(pk.AsInts(), X.X(), X.Y(), c1, c2, u.X(), u.Y(), z, zPrm, t, v, w)...)
-		}
-		e = common.RejectionSample(q, eHash)
-	}
+	// X is nil if called by ProveBob (Bob's proof "without check")
+	e := proofBobChallenge(tr, q, pk, NTilde, h1, h2, c1, c2, X, u, z, zPrm, t, v, w)
 
 	// 13.
 	modN := common.ModInt(pk.N)
@@ -135,10 +129,10 @@ func ProveBobWC(ec elliptic.Curve, pk *paillier.PublicKey, NTilde, h1, h2, c1, c
 }
 
 // ProveBob implements Bob's proof "ProveMta_Bob" used in the MtA protocol from GG18Spec (9) Fig. 11.
-func ProveBob(ec elliptic.Curve, pk *paillier.PublicKey, NTilde, h1, h2, c1, c2, x, y, r *big.Int) (*ProofBob, error) {
+func ProveBob(tr *transcript.Transcript, ec elliptic.Curve, pk *paillier.PublicKey, NTilde, h1, h2, c1, c2, x, y, r *big.Int, rand io.Reader) (*ProofBob, error) {
 	// the Bob proof ("with check") contains the ProofBob "without check"; this method extracts and returns it
 	// X is supplied as nil to exclude it from the proof hash
-	pf, err := ProveBobWC(ec, pk, NTilde, h1, h2, c1, c2, x, y, r, nil)
+	pf, err := ProveBobWC(tr, ec, pk, NTilde, h1, h2, c1, c2, x, y, r, nil, rand)
 	if err != nil {
 		return nil, err
 	}
@@ -185,7 +179,8 @@ func ProofBobFromBytes(bzs [][]byte) (*ProofBob, error) {
 
 // ProveBobWC.Verify implements verification of Bob's proof with check "VerifyMtawc_Bob" used in the MtA protocol from GG18Spec (9) Fig. 10.
 // an absent `X` verifies a proof generated without the X consistency check X = g^x
-func (pf *ProofBobWC) Verify(ec elliptic.Curve, pk *paillier.PublicKey, NTilde, h1, h2, c1, c2 *big.Int, X *crypto.ECPoint) bool {
+// `tr` must be in the state the prover's transcript was in, or nil for a proof made without one.
+func (pf *ProofBobWC) Verify(tr *transcript.Transcript, ec elliptic.Curve, pk *paillier.PublicKey, NTilde, h1, h2, c1, c2 *big.Int, X *crypto.ECPoint) bool {
 	if pk == nil || NTilde == nil || h1 == nil || h2 == nil || c1 == nil || c2 == nil {
 		return false
 	}
@@ -214,17 +209,8 @@ func (pf *ProofBobWC) Verify(ec elliptic.Curve, pk *paillier.PublicKey, NTilde,
 	}
 
 	// 1-2. e'
-	var e *big.Int
-	{ // must use RejectionSample
-		var eHash *big.Int
-		// X is nil if called on a ProveBob (Bob's proof "without check")
-		if X == nil {
-			eHash = common.SHA512_256i(append(pk.AsInts(), c1, c2, pf.Z, pf.ZPrm, pf.T, pf.V, pf.W)...)
-		} else {
-			eHash = common.SHA512_256i(append(pk.AsInts(), X.X(), X.Y(), c1, c2, pf.U.X(), pf.U.Y(), pf.Z, pf.ZPrm, pf.T, pf.V, pf.W)...)
-		}
-		e = common.RejectionSample(q, eHash)
-	}
+	// X is nil if called on a ProveBob (Bob's proof "without check")
+	e := proofBobChallenge(tr, q, pk, NTilde, h1, h2, c1, c2, X, pf.U, pf.Z, pf.ZPrm, pf.T, pf.V, pf.W)
 
 	var left, right *big.Int // for the following conditionals
 
@@ -269,7 +255,7 @@ func (pf *ProofBobWC) Verify(ec elliptic.Curve, pk *paillier.PublicKey, NTilde,
 
 		c1ExpS1 := modNSquared.Exp(c1, pf.S1)
 		sExpN := modNSquared.Exp(pf.S, pk.N)
-		gammaExpT1 := modNSquared.Exp(pk.Gamma(), pf.T1)
+		gammaExpT1 := pk.ExpGamma(pf.T1)
 		left = modNSquared.Mul(c1ExpS1, sExpN)
 		left = modNSquared.Mul(left, gammaExpT1)
 		c2ExpE := modNSquared.Exp(c2, e)
@@ -282,12 +268,37 @@ func (pf *ProofBobWC) Verify(ec elliptic.Curve, pk *paillier.PublicKey, NTilde,
 }
 
 // ProveBob.Verify implements verification of Bob's proof without check "VerifyMta_Bob" used in the MtA protocol from GG18Spec (9) Fig. 11.
-func (pf *ProofBob) Verify(ec elliptic.Curve, pk *paillier.PublicKey, NTilde, h1, h2, c1, c2 *big.Int) bool {
+func (pf *ProofBob) Verify(tr *transcript.Transcript, ec elliptic.Curve, pk *paillier.PublicKey, NTilde, h1, h2, c1, c2 *big.Int) bool {
 	if pf == nil {
 		return false
 	}
 	pfWC := &ProofBobWC{ProofBob: pf, U: nil}
-	return pfWC.Verify(ec, pk, NTilde, h1, h2, c1, c2, nil)
+	return pfWC.Verify(tr, ec, pk, NTilde, h1, h2, c1, c2, nil)
+}
+
+// proofBobChallenge returns e for Bob's proof with check, or without it when X is nil
+func proofBobChallenge(tr *transcript.Transcript, q *big.Int, pk *paillier.PublicKey, NTilde, h1, h2, c1, c2 *big.Int, X, u *crypto.ECPoint, z, zPrm, t, v, w *big.Int) *big.Int {
+	if tr == nil { // must use RejectionSample
+		var eHash *big.Int
+		if X == nil {
+			eHash = common.SHA512_256i(append(pk.AsInts(), c1, c2, z, zPrm, t, v, w)...)
+		} else {
+			eHash = common.SHA512_256i(append(pk.AsInts(), X.X(), X.Y(), c1, c2, u.X(), u.Y(), z, zPrm, t, v, w)...)
+		}
+		return common.RejectionSample(q, eHash)
+	}
+	if X == nil {
+		tr.AppendMessage("proof", []byte("mta-bob"))
+	} else {
+		tr.AppendMessage("proof", []byte("mta-bob-wc"))
+		tr.AppendPoints("X", X)
+		tr.AppendPoints("u", u)
+	}
+	tr.AppendInts("N", pk.N)
+	tr.AppendInts("NTilde", NTilde, h1, h2)
+	tr.AppendInts("c", c1, c2)
+	tr.AppendInts("commitments", z, zPrm, t, v, w)
+	return tr.ChallengeInt("e", q)
 }
 
 func (pf *ProofBob) ValidateBasic() bool {
@@ -10,10 +10,12 @@ import (
 	"crypto/elliptic"
 	"errors"
 	"fmt"
+	"io"
 	"math/big"
 
 	"github.com/bnb-chain/tss-lib/common"
 	"github.com/bnb-chain/tss-lib/crypto/paillier"
+	"github.com/bnb-chain/tss-lib/crypto/transcript"
 )
 
 const (
@@ -32,7 +34,8 @@ type (
 )
 
 // ProveRangeAlice implements Alice's range proof used in the MtA and MtAwc protocols from GG18Spec (9) Fig. 9.
-func ProveRangeAlice(ec elliptic.Curve, pk *paillier.PublicKey, c, NTilde, h1, h2, m, r *big.Int) (*RangeProofAlice, error) {
+// The challenge is drawn from `tr`, or derived as in earlier versions if `tr` is nil.
+func ProveRangeAlice(tr *transcript.Transcript, ec elliptic.Curve, pk *paillier.PublicKey, c, NTilde, h1, h2, m, r *big.Int, rand io.Reader) (*RangeProofAlice, error) {
 	if pk == nil || NTilde == nil || h1 == nil || h2 == nil || c == nil || m == nil || r == nil {
 		return nil, errors.New("ProveRangeAlice constructor received nil value(s)")
 	}
@@ -44,15 +47,15 @@ func ProveRangeAlice(ec elliptic.Curve, pk *paillier.PublicKey, c, NTilde, h1, h
 	q3NTilde := new(big.Int).Mul(q3, NTilde)
 
 	// 1.
-	alpha := common.GetRandomPositiveInt(q3)
+	alpha := common.GetRandomPositiveIntWithRandom(rand, q3)
 	// 2.
-	beta := common.GetRandomPositiveRelativelyPrimeInt(pk.N)
+	beta, betaN := pk.RandomNthPower(rand)
 
 	// 3.
-	gamma := common.GetRandomPositiveInt(q3NTilde)
+	gamma := common.GetRandomPositiveIntWithRandom(rand, q3NTilde)
 
 	// 4.
-	rho := common.GetRandomPositiveInt(qNTilde)
+	rho := common.GetRandomPositiveIntWithRandom(rand, qNTilde)
 
 	// 5.
 	modNTilde := common.ModInt(NTilde)
@@ -61,19 +64,15 @@ func ProveRangeAlice(ec elliptic.Curve, pk *paillier.PublicKey, c, NTilde, h1, h
 
 	// 6.
 	modNSquared := common.ModInt(pk.NSquare())
-	u := modNSquared.Exp(pk.Gamma(), alpha)
-	u = modNSquared.Mul(u, modNSquared.Exp(beta, pk.N))
+	u := pk.ExpGamma(alpha)
+	u = modNSquared.Mul(u, betaN)
 
 	// 7.
 	w := modNTilde.Exp(h1, alpha)
 	w = modNTilde.Mul(w, modNTilde.Exp(h2, gamma))
 
 	// 8-9. e'
-	var e *big.Int
-	{ // must use RejectionSample
-		eHash := common.SHA512_256i(append(pk.AsInts(), c, z, u, w)...)
-		e = common.RejectionSample(q, eHash)
-	}
+	e := rangeProofAliceChallenge(tr, q, pk, NTilde, h1, h2, c, z, u, w)
 
 	modN := common.ModInt(pk.N)
 	s := modN.Exp(r, e)
@@ -104,7 +103,8 @@ func RangeProofAliceFromBytes(bzs [][]byte) (*RangeProofAlice, error) {
 	}, nil
 }
 
-func (pf *RangeProofAlice) Verify(ec elliptic.Curve, pk *paillier.PublicKey, NTilde, h1, h2, c *big.Int) bool {
+// Verify checks the proof. `tr` must be in the state the prover's transcript was in, or nil for a proof made without one.
+func (pf *RangeProofAlice) Verify(tr *transcript.Transcript, ec elliptic.Curve, pk *paillier.PublicKey, NTilde, h1, h2, c *big.Int) bool {
 	if pf == nil || !pf.ValidateBasic() || pk == nil || NTilde == nil || h1 == nil || h2 == nil || c == nil {
 		return false
 	}
@@ -119,11 +119,7 @@ func (pf *RangeProofAlice) Verify(ec elliptic.Curve, pk *paillier.PublicKey, NTi
 	}
 
 	// 1-2. e'
-	var e *big.Int
-	{ // must use RejectionSample
-		eHash := common.SHA512_256i(append(pk.AsInts(), c, pf.Z, pf.U, pf.W)...)
-		e = common.RejectionSample(q, eHash)
-	}
+	e := rangeProofAliceChallenge(tr, q, pk, NTilde, h1, h2, c, pf.Z, pf.U, pf.W)
 
 	var products *big.Int // for the following conditionals
 	minusE := new(big.Int).Sub(zero, e)
@@ -133,7 +129,7 @@ func (pf *RangeProofAlice) Verify(ec elliptic.Curve, pk *paillier.PublicKey, NTi
 
 		cExpMinusE := modNSquared.Exp(c, minusE)
 		sExpN := modNSquared.Exp(pf.S, pk.N)
-		gammaExpS1 := modNSquared.Exp(pk.Gamma(), pf.S1)
+		gammaExpS1 := pk.ExpGamma(pf.S1)
 		// u != (4)
 		products = modNSquared.Mul(gammaExpS1, sExpN)
 		products = modNSquared.Mul(products, cExpMinusE)
@@ -158,6 +154,19 @@ func (pf *RangeProofAlice) Verify(ec elliptic.Curve, pk *paillier.PublicKey, NTi
 	return true
 }
 
+func rangeProofAliceChallenge(tr *transcript.Transcript, q *big.Int, pk *paillier.PublicKey, NTilde, h1, h2, c, z, u, w *big.Int) *big.Int {
+	if tr == nil { // must use RejectionSample
+		eHash := common.SHA512_256i(append(pk.AsInts(), c, z, u, w)...)
+		return common.RejectionSample(q, eHash)
+	}
+	tr.AppendMessage("proof", []byte("mta-range-alice"))
+	tr.AppendInts("N", pk.N)
+	tr.AppendInts("NTilde", NTilde, h1, h2)
+	tr.AppendInts("c", c)
+	tr.AppendInts("commitments", z, u, w)
+	return tr.ChallengeInt("e", q)
+}
+
 func (pf *RangeProofAlice) ValidateBasic() bool {
 	return pf.Z != nil &&
 		pf.U != nil &&
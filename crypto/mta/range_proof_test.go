@@ -8,6 +8,7 @@ package mta
 
 import (
 	"context"
+	"crypto/rand"
 	"math/big"
 	"testing"
 	"time"
@@ -31,7 +32,7 @@ func TestProveRangeAlice(t *testing.T) {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Minute)
 	defer cancel()
 
-	sk, pk, err := paillier.GenerateKeyPair(ctx, testPaillierKeyLength)
+	sk, pk, err := paillier.GenerateKeyPairWithRandom(ctx, rand.Reader, testPaillierKeyLength)
 	assert.NoError(t, err)
 
 	m := common.GetRandomPositiveInt(q)
@@ -41,9 +42,9 @@ func TestProveRangeAlice(t *testing.T) {
 	primes := [2]*big.Int{common.GetRandomPrimeInt(testSafePrimeBits), common.GetRandomPrimeInt(testSafePrimeBits)}
 	NTildei, h1i, h2i, err := crypto.GenerateNTildei(primes)
 	assert.NoError(t, err)
-	proof, err := ProveRangeAlice(tss.EC(), pk, c, NTildei, h1i, h2i, m, r)
+	proof, err := ProveRangeAlice(nil, tss.EC(), pk, c, NTildei, h1i, h2i, m, r, rand.Reader)
 	assert.NoError(t, err)
 
-	ok := proof.Verify(tss.EC(), pk, NTildei, h1i, h2i, c)
+	ok := proof.Verify(nil, tss.EC(), pk, NTildei, h1i, h2i, c)
 	assert.True(t, ok, "proof must verify")
 }
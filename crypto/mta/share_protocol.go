@@ -9,39 +9,59 @@ package mta
 import (
 	"crypto/elliptic"
 	"errors"
+	"io"
 	"math/big"
 
 	"github.com/bnb-chain/tss-lib/common"
 	"github.com/bnb-chain/tss-lib/crypto"
 	"github.com/bnb-chain/tss-lib/crypto/paillier"
+	"github.com/bnb-chain/tss-lib/crypto/transcript"
 )
 
+// AliceInit encrypts `a` and proves its range, drawing the challenge from Alice's transcript `trA` (nil for legacy proofs)
 func AliceInit(
+	trA *transcript.Transcript,
 	ec elliptic.Curve,
 	pkA *paillier.PublicKey,
 	a, NTildeB, h1B, h2B *big.Int,
+	rand io.Reader,
 ) (cA *big.Int, pf *RangeProofAlice, err error) {
-	cA, rA, err := pkA.EncryptAndReturnRandomness(a)
+	cA, _, pf, err = AliceInitWithRandomness(trA, ec, pkA, a, NTildeB, h1B, h2B, rand)
+	return
+}
+
+// AliceInitWithRandomness is AliceInit that also returns the randomness rA of cA, with which cA can be opened later
+func AliceInitWithRandomness(
+	trA *transcript.Transcript,
+	ec elliptic.Curve,
+	pkA *paillier.PublicKey,
+	a, NTildeB, h1B, h2B *big.Int,
+	rand io.Reader,
+) (cA, rA *big.Int, pf *RangeProofAlice, err error) {
+	cA, rA, err = pkA.EncryptAndReturnRandomnessWithRandom(rand, a)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
-	pf, err = ProveRangeAlice(ec, pkA, cA, NTildeB, h1B, h2B, a, rA)
-	return cA, pf, err
+	pf, err = ProveRangeAlice(trA, ec, pkA, cA, NTildeB, h1B, h2B, a, rA, rand)
+	return cA, rA, pf, err
 }
 
+// BobMid verifies Alice's range proof with `trA` and proves Bob's response with Bob's transcript `trB`
 func BobMid(
+	trA, trB *transcript.Transcript,
 	ec elliptic.Curve,
 	pkA *paillier.PublicKey,
 	pf *RangeProofAlice,
 	b, cA, NTildeA, h1A, h2A, NTildeB, h1B, h2B *big.Int,
+	rand io.Reader,
 ) (beta, cB, betaPrm *big.Int, piB *ProofBob, err error) {
-	if !pf.Verify(ec, pkA, NTildeB, h1B, h2B, cA) {
+	if !pf.Verify(trA, ec, pkA, NTildeB, h1B, h2B, cA) {
 		err = errors.New("RangeProofAlice.Verify() returned false")
 		return
 	}
 	q := ec.Params().N
-	betaPrm = common.GetRandomPositiveInt(pkA.N)
-	cBetaPrm, cRand, err := pkA.EncryptAndReturnRandomness(betaPrm)
+	betaPrm = common.GetRandomPositiveIntWithRandom(rand, pkA.N)
+	cBetaPrm, cRand, err := pkA.EncryptAndReturnRandomnessWithRandom(rand, betaPrm)
 	if err != nil {
 		return
 	}
@@ -54,24 +74,27 @@ func BobMid(
 		return
 	}
 	beta = common.ModInt(q).Sub(zero, betaPrm)
-	piB, err = ProveBob(ec, pkA, NTildeA, h1A, h2A, cA, cB, b, betaPrm, cRand)
+	piB, err = ProveBob(trB, ec, pkA, NTildeA, h1A, h2A, cA, cB, b, betaPrm, cRand, rand)
 	return
 }
 
+// BobMidWC verifies Alice's range proof with `trA` and proves Bob's response with check with Bob's transcript `trB`
 func BobMidWC(
+	trA, trB *transcript.Transcript,
 	ec elliptic.Curve,
 	pkA *paillier.PublicKey,
 	pf *RangeProofAlice,
 	b, cA, NTildeA, h1A, h2A, NTildeB, h1B, h2B *big.Int,
 	B *crypto.ECPoint,
+	rand io.Reader,
 ) (beta, cB, betaPrm *big.Int, piB *ProofBobWC, err error) {
-	if !pf.Verify(ec, pkA, NTildeB, h1B, h2B, cA) {
+	if !pf.Verify(trA, ec, pkA, NTildeB, h1B, h2B, cA) {
 		err = errors.New("RangeProofAlice.Verify() returned false")
 		return
 	}
 	q := ec.Params().N
-	betaPrm = common.GetRandomPositiveInt(pkA.N)
-	cBetaPrm, cRand, err := pkA.EncryptAndReturnRandomness(betaPrm)
+	betaPrm = common.GetRandomPositiveIntWithRandom(rand, pkA.N)
+	cBetaPrm, cRand, err := pkA.EncryptAndReturnRandomnessWithRandom(rand, betaPrm)
 	if err != nil {
 		return
 	}
@@ -84,18 +107,20 @@ func BobMidWC(
 		return
 	}
 	beta = common.ModInt(q).Sub(zero, betaPrm)
-	piB, err = ProveBobWC(ec, pkA, NTildeA, h1A, h2A, cA, cB, b, betaPrm, cRand, B)
+	piB, err = ProveBobWC(trB, ec, pkA, NTildeA, h1A, h2A, cA, cB, b, betaPrm, cRand, B, rand)
 	return
 }
 
+// AliceEnd verifies Bob's proof with Bob's transcript `trB` and decrypts Alice's share
 func AliceEnd(
+	trB *transcript.Transcript,
 	ec elliptic.Curve,
 	pkA *paillier.PublicKey,
 	pf *ProofBob,
 	h1A, h2A, cA, cB, NTildeA *big.Int,
 	sk *paillier.PrivateKey,
 ) (*big.Int, error) {
-	if !pf.Verify(ec, pkA, NTildeA, h1A, h2A, cA, cB) {
+	if !pf.Verify(trB, ec, pkA, NTildeA, h1A, h2A, cA, cB) {
 		return nil, errors.New("ProofBob.Verify() returned false")
 	}
 	alphaPrm, err := sk.Decrypt(cB)
@@ -106,7 +131,9 @@ func AliceEnd(
 	return new(big.Int).Mod(alphaPrm, q), nil
 }
 
+// AliceEndWC verifies Bob's proof with check with Bob's transcript `trB` and decrypts Alice's share
 func AliceEndWC(
+	trB *transcript.Transcript,
 	ec elliptic.Curve,
 	pkA *paillier.PublicKey,
 	pf *ProofBobWC,
@@ -114,7 +141,7 @@ func AliceEndWC(
 	cA, cB, NTildeA, h1A, h2A *big.Int,
 	sk *paillier.PrivateKey,
 ) (*big.Int, error) {
-	if !pf.Verify(ec, pkA, NTildeA, h1A, h2A, cA, cB, B) {
+	if !pf.Verify(trB, ec, pkA, NTildeA, h1A, h2A, cA, cB, B) {
 		return nil, errors.New("ProofBobWC.Verify() returned false")
 	}
 	alphaPrm, err := sk.Decrypt(cB)
@@ -17,8 +17,11 @@ package paillier
 
 import (
 	"context"
+	crand "crypto/rand"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	gmath "math"
 	"math/big"
 	"runtime"
@@ -28,6 +31,7 @@ import (
 
 	"github.com/bnb-chain/tss-lib/common"
 	crypto2 "github.com/bnb-chain/tss-lib/crypto"
+	"github.com/bnb-chain/tss-lib/crypto/transcript"
 )
 
 const (
@@ -39,12 +43,27 @@ const (
 type (
 	PublicKey struct {
 		N *big.Int
+
+		pool *RandomnessPool // set on the keys returned by RandomnessPool.PublicKey()
 	}
 
 	PrivateKey struct {
 		PublicKey
 		LambdaN, // lcm(p-1, q-1)
 		PhiN *big.Int // (p-1) * (q-1)
+		// the factors of N. keys saved before they were added have them recovered from N and PhiN when unmarshalled
+		P, Q *big.Int `json:",omitempty"`
+
+		crt *crtValues // nil until precompute() is called
+	}
+
+	// crtValues are derived from P and Q to decrypt and prove modulo p and q separately
+	crtValues struct {
+		pSquare, qSquare,
+		pMinus1, qMinus1,
+		hp, hq, // L_p(Gamma^(p-1) mod p^2)^-1 mod p and the same for q
+		qInvP, // q^-1 mod p
+		nInvPMinus1, nInvQMinus1 *big.Int // N^-1 mod (p-1) and mod (q-1)
 	}
 
 	// Proof uses the new GenerateXs method in GG18Spec (6)
@@ -66,6 +85,11 @@ func init() {
 
 // len is the length of the modulus (each prime = len / 2)
 func GenerateKeyPair(ctx context.Context, modulusBitLen int, optionalConcurrency ...int) (privateKey *PrivateKey, publicKey *PublicKey, err error) {
+	return GenerateKeyPairWithRandom(ctx, crand.Reader, modulusBitLen, optionalConcurrency...)
+}
+
+// GenerateKeyPairWithRandom is GenerateKeyPair with the primes drawn from `rand`
+func GenerateKeyPairWithRandom(ctx context.Context, rand io.Reader, modulusBitLen int, optionalConcurrency ...int) (privateKey *PrivateKey, publicKey *PublicKey, err error) {
 	var concurrency int
 	if 0 < len(optionalConcurrency) {
 		if 1 < len(optionalConcurrency) {
@@ -81,7 +105,7 @@ func GenerateKeyPair(ctx context.Context, modulusBitLen int, optionalConcurrency
 	{
 		tmp := new(big.Int)
 		for {
-			sgps, err := common.GetRandomSafePrimesConcurrent(ctx, modulusBitLen/2, 2, concurrency)
+			sgps, err := common.GetRandomSafePrimesConcurrentWithRandom(ctx, modulusBitLen/2, 2, concurrency, rand)
 			if err != nil {
 				return nil, nil, err
 			}
@@ -103,29 +127,147 @@ func GenerateKeyPair(ctx context.Context, modulusBitLen int, optionalConcurrency
 	lambdaN := new(big.Int).Div(phiN, gcd)
 
 	publicKey = &PublicKey{N: N}
-	privateKey = &PrivateKey{PublicKey: *publicKey, LambdaN: lambdaN, PhiN: phiN}
+	privateKey = &PrivateKey{PublicKey: *publicKey, LambdaN: lambdaN, PhiN: phiN, P: P, Q: Q}
+	privateKey.precompute()
 	return
 }
 
+// UnmarshalJSON restores a private key and its CRT values. P and Q are recovered from N and PhiN when they are missing,
+// which is the case for keys serialized by earlier versions.
+func (privateKey *PrivateKey) UnmarshalJSON(bz []byte) error {
+	type plain PrivateKey // drops the methods to avoid recursing into UnmarshalJSON
+	if err := json.Unmarshal(bz, (*plain)(privateKey)); err != nil {
+		return err
+	}
+	privateKey.precompute()
+	return nil
+}
+
+// precompute sets the CRT values, recovering P and Q from N and PhiN when needed.
+// It must be called before the key is shared between goroutines.
+func (privateKey *PrivateKey) precompute() {
+	privateKey.crt = nil
+	if privateKey.P == nil || privateKey.Q == nil {
+		privateKey.P, privateKey.Q = factorN(privateKey.N, privateKey.PhiN)
+	}
+	privateKey.crt = newCRTValues(privateKey.N, privateKey.P, privateKey.Q)
+}
+
+// factorN recovers p and q from N = pq and phi = (p-1)(q-1), as p + q = N - phi + 1 and (p-q)^2 = (p+q)^2 - 4N
+func factorN(N, phi *big.Int) (p, q *big.Int) {
+	if N == nil || phi == nil || N.Sign() <= 0 || phi.Sign() <= 0 {
+		return nil, nil
+	}
+	sum := new(big.Int).Sub(N, phi)
+	sum.Add(sum, one)
+	disc := new(big.Int).Mul(sum, sum)
+	disc.Sub(disc, new(big.Int).Lsh(N, 2))
+	if disc.Sign() < 0 {
+		return nil, nil
+	}
+	diff := new(big.Int).Sqrt(disc)
+	p = new(big.Int).Add(sum, diff)
+	q = new(big.Int).Sub(sum, diff)
+	if p.Bit(0) != 0 || q.Bit(0) != 0 {
+		return nil, nil
+	}
+	p.Rsh(p, 1)
+	q.Rsh(q, 1)
+	if q.Cmp(one) <= 0 || new(big.Int).Mul(p, q).Cmp(N) != 0 {
+		return nil, nil
+	}
+	return p, q
+}
+
+// newCRTValues returns nil if p and q are not suitable factors of N
+func newCRTValues(N, p, q *big.Int) *crtValues {
+	if N == nil || p == nil || q == nil || p.Cmp(one) <= 0 || q.Cmp(one) <= 0 || new(big.Int).Mul(p, q).Cmp(N) != 0 {
+		return nil
+	}
+	crt := &crtValues{
+		pSquare: new(big.Int).Mul(p, p),
+		qSquare: new(big.Int).Mul(q, q),
+		pMinus1: new(big.Int).Sub(p, one),
+		qMinus1: new(big.Int).Sub(q, one),
+	}
+	// Gamma^(p-1) = 1 + (p-1)N mod p^2, so L_p(Gamma^(p-1) mod p^2) = (p-1)q = -q mod p
+	crt.hp = new(big.Int).ModInverse(new(big.Int).Sub(p, new(big.Int).Mod(q, p)), p)
+	crt.hq = new(big.Int).ModInverse(new(big.Int).Sub(q, new(big.Int).Mod(p, q)), q)
+	crt.qInvP = new(big.Int).ModInverse(q, p)
+	crt.nInvPMinus1 = new(big.Int).ModInverse(N, crt.pMinus1)
+	crt.nInvQMinus1 = new(big.Int).ModInverse(N, crt.qMinus1)
+	if crt.hp == nil || crt.hq == nil || crt.qInvP == nil || crt.nInvPMinus1 == nil || crt.nInvQMinus1 == nil {
+		return nil
+	}
+	return crt
+}
+
+// combine returns the x mod pq with x = xp mod p and x = xq mod q
+func (crt *crtValues) combine(xp, xq, p, q *big.Int) *big.Int {
+	// x = xq + q * ((xp - xq) * q^-1 mod p)
+	h := new(big.Int).Sub(xp, xq)
+	h.Mul(h, crt.qInvP)
+	h.Mod(h, p)
+	return h.Mul(h, q).Add(h, xq)
+}
+
 // ----- //
 
 func (publicKey *PublicKey) EncryptAndReturnRandomness(m *big.Int) (c *big.Int, x *big.Int, err error) {
+	return publicKey.EncryptAndReturnRandomnessWithRandom(crand.Reader, m)
+}
+
+func (publicKey *PublicKey) EncryptAndReturnRandomnessWithRandom(rand io.Reader, m *big.Int) (c *big.Int, x *big.Int, err error) {
 	if m.Cmp(zero) == -1 || m.Cmp(publicKey.N) != -1 { // m < 0 || m >= N ?
 		return nil, nil, ErrMessageTooLong
 	}
-	x = common.GetRandomPositiveRelativelyPrimeInt(publicKey.N)
 	N2 := publicKey.NSquare()
 	// 1. gamma^m mod N2
-	Gm := new(big.Int).Exp(publicKey.Gamma(), m, N2)
+	Gm := publicKey.ExpGamma(m)
 	// 2. x^N mod N2
-	xN := new(big.Int).Exp(x, publicKey.N, N2)
+	x, xN := publicKey.RandomNthPower(rand)
 	// 3. (1) * (2) mod N2
 	c = common.ModInt(N2).Mul(Gm, xN)
 	return
 }
 
+// EncryptWithRandomness returns the encryption of m with the randomness x, so that a ciphertext can be checked
+// against its revealed message and randomness
+func (publicKey *PublicKey) EncryptWithRandomness(m, x *big.Int) (c *big.Int, err error) {
+	if m == nil || m.Cmp(zero) == -1 || m.Cmp(publicKey.N) != -1 { // m < 0 || m >= N ?
+		return nil, ErrMessageTooLong
+	}
+	if !common.IsNumberInMultiplicativeGroup(publicKey.N, x) {
+		return nil, errors.New("the randomness is not in Z*_N")
+	}
+	N2 := publicKey.NSquare()
+	xN := new(big.Int).Exp(x, publicKey.N, N2)
+	return common.ModInt(N2).Mul(publicKey.ExpGamma(m), xN), nil
+}
+
 func (publicKey *PublicKey) Encrypt(m *big.Int) (c *big.Int, err error) {
-	c, _, err = publicKey.EncryptAndReturnRandomness(m)
+	return publicKey.EncryptWithRandom(crand.Reader, m)
+}
+
+func (publicKey *PublicKey) EncryptWithRandom(rand io.Reader, m *big.Int) (c *big.Int, err error) {
+	c, _, err = publicKey.EncryptAndReturnRandomnessWithRandom(rand, m)
+	return
+}
+
+// RandomNthPower returns a random r in Z*_N and r^N mod N^2. The pair is taken from the key's RandomnessPool if it
+// has one that is not empty, and computed with `rand` otherwise.
+func (publicKey *PublicKey) RandomNthPower(rand io.Reader) (r, rN *big.Int) {
+	if publicKey.pool != nil {
+		if r, rN, ok := publicKey.pool.take(); ok {
+			return r, rN
+		}
+	}
+	return publicKey.nthPower(rand)
+}
+
+func (publicKey *PublicKey) nthPower(rand io.Reader) (r, rN *big.Int) {
+	r = common.GetRandomPositiveRelativelyPrimeIntWithRandom(rand, publicKey.N)
+	rN = new(big.Int).Exp(r, publicKey.N, publicKey.NSquare())
 	return
 }
 
@@ -167,6 +309,14 @@ func (publicKey *PublicKey) Gamma() *big.Int {
 	return new(big.Int).Add(publicKey.N, one)
 }
 
+// ExpGamma returns Gamma^x mod N2 without an exponentiation, as (N+1)^x = 1 + xN mod N2
+func (publicKey *PublicKey) ExpGamma(x *big.Int) *big.Int {
+	N2 := publicKey.NSquare()
+	xN := new(big.Int).Mod(x, publicKey.N)
+	xN.Mul(xN, publicKey.N)
+	return xN.Add(xN, one).Mod(xN, N2)
+}
+
 // ----- //
 
 func (privateKey *PrivateKey) Decrypt(c *big.Int) (m *big.Int, err error) {
@@ -178,34 +328,69 @@ func (privateKey *PrivateKey) Decrypt(c *big.Int) (m *big.Int, err error) {
 	if cg.Cmp(one) == 1 {
 		return nil, ErrMessageMalFormed
 	}
+	if crt := privateKey.crt; crt != nil {
+		return privateKey.decryptCRT(crt, c), nil
+	}
 	// 1. L(u) = (c^LambdaN-1 mod N2) / N
 	Lc := L(new(big.Int).Exp(c, privateKey.LambdaN, N2), privateKey.N)
 	// 2. L(u) = (Gamma^LambdaN-1 mod N2) / N
-	Lg := L(new(big.Int).Exp(privateKey.Gamma(), privateKey.LambdaN, N2), privateKey.N)
+	Lg := L(privateKey.ExpGamma(privateKey.LambdaN), privateKey.N)
 	// 3. (1) * modInv(2) mod N
 	inv := new(big.Int).ModInverse(Lg, privateKey.N)
 	m = common.ModInt(privateKey.N).Mul(Lc, inv)
 	return
 }
 
+// decryptCRT computes m mod p and m mod q with exponents and moduli half the size of those used by Decrypt
+func (privateKey *PrivateKey) decryptCRT(crt *crtValues, c *big.Int) *big.Int {
+	p, q := privateKey.P, privateKey.Q
+	// m_p = L_p(c^(p-1) mod p^2) * hp mod p
+	mp := new(big.Int).Exp(new(big.Int).Mod(c, crt.pSquare), crt.pMinus1, crt.pSquare)
+	mp = common.ModInt(p).Mul(L(mp, p), crt.hp)
+	mq := new(big.Int).Exp(new(big.Int).Mod(c, crt.qSquare), crt.qMinus1, crt.qSquare)
+	mq = common.ModInt(q).Mul(L(mq, q), crt.hq)
+	return crt.combine(mp, mq, p, q)
+}
+
+// Wipe overwrites LambdaN, PhiN, the factors of N and the CRT values, after which the key can no longer decrypt.
+func (privateKey *PrivateKey) Wipe() {
+	common.WipeInts(privateKey.LambdaN, privateKey.PhiN, privateKey.P, privateKey.Q)
+	if crt := privateKey.crt; crt != nil {
+		common.WipeInts(crt.pSquare, crt.qSquare, crt.pMinus1, crt.qMinus1, crt.hp, crt.hq, crt.qInvP,
+			crt.nInvPMinus1, crt.nInvQMinus1)
+	}
+}
+
 // ----- //
 
 // Proof is an implementation of Gennaro, R., Micciancio, D., Rabin, T.:
 // An efficient non-interactive statistical zero-knowledge proof system for quasi-safe prime products.
 // In: In Proc. of the 5th ACM Conference on Computer and Communications Security (CCS-98. Citeseer (1998)
 
-func (privateKey *PrivateKey) Proof(k *big.Int, ecdsaPub *crypto2.ECPoint) Proof {
+// The challenges are drawn from `tr`, or derived as in earlier versions if `tr` is nil.
+func (privateKey *PrivateKey) Proof(tr *transcript.Transcript, k *big.Int, ecdsaPub *crypto2.ECPoint) Proof {
 	var pi Proof
 	iters := ProofIters
-	xs := GenerateXs(iters, k, privateKey.N, ecdsaPub)
+	xs := generateXs(tr, iters, k, privateKey.N, ecdsaPub)
+	if crt := privateKey.crt; crt != nil {
+		// x^M mod N with M = N^-1 mod phi(N), computed modulo p and q
+		p, q := privateKey.P, privateKey.Q
+		for i := 0; i < iters; i++ {
+			yp := new(big.Int).Exp(new(big.Int).Mod(xs[i], p), crt.nInvPMinus1, p)
+			yq := new(big.Int).Exp(new(big.Int).Mod(xs[i], q), crt.nInvQMinus1, q)
+			pi[i] = crt.combine(yp, yq, p, q)
+		}
+		return pi
+	}
+	M := new(big.Int).ModInverse(privateKey.N, privateKey.PhiN)
 	for i := 0; i < iters; i++ {
-		M := new(big.Int).ModInverse(privateKey.N, privateKey.PhiN)
 		pi[i] = new(big.Int).Exp(xs[i], M, privateKey.N)
 	}
 	return pi
 }
 
-func (pf Proof) Verify(pkN, k *big.Int, ecdsaPub *crypto2.ECPoint) (bool, error) {
+// Verify checks the proof. `tr` must be in the state the prover's transcript was in, or nil for a proof made without one.
+func (pf Proof) Verify(tr *transcript.Transcript, pkN, k *big.Int, ecdsaPub *crypto2.ECPoint) (bool, error) {
 	iters := ProofIters
 	pch, xch := make(chan bool, 1), make(chan []*big.Int, 1) // buffered to allow early exit
 	prms := primes.Until(verifyPrimesUntil).List()           // uses cache primed in init()
@@ -220,7 +405,7 @@ func (pf Proof) Verify(pkN, k *big.Int, ecdsaPub *crypto2.ECPoint) (bool, error)
 		ch <- true
 	}(pch)
 	go func(ch chan<- []*big.Int) {
-		ch <- GenerateXs(iters, k, pkN, ecdsaPub)
+		ch <- generateXs(tr, iters, k, pkN, ecdsaPub)
 	}(xch)
 	for j := 0; j < 2; j++ {
 		select {
@@ -251,6 +436,25 @@ func L(u, N *big.Int) *big.Int {
 	return new(big.Int).Div(t, N)
 }
 
+// generateXs draws the challenges of the Paillier key Proof from `tr`, or generates them with GenerateXs if it is nil
+func generateXs(tr *transcript.Transcript, m int, k, N *big.Int, ecdsaPub *crypto2.ECPoint) []*big.Int {
+	if tr == nil {
+		return GenerateXs(m, k, N, ecdsaPub)
+	}
+	tr.AppendMessage("proof", []byte("paillier-n"))
+	tr.AppendInts("N", N)
+	tr.AppendInts("k", k)
+	tr.AppendPoints("pub", ecdsaPub)
+	ret := make([]*big.Int, m)
+	for i := 0; i < m; {
+		ret[i] = new(big.Int).SetBytes(tr.ChallengeBytes("x", (N.BitLen()+7)/8))
+		if common.IsNumberInMultiplicativeGroup(N, ret[i]) {
+			i++
+		}
+	}
+	return ret
+}
+
 // GenerateXs generates the challenges used in Paillier key Proof
 func GenerateXs(m int, k, N *big.Int, ecdsaPub *crypto2.ECPoint) []*big.Int {
 	var i, n int
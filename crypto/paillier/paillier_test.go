@@ -8,6 +8,8 @@ package paillier_test
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/json"
 	"math/big"
 	"testing"
 	"time"
@@ -30,7 +32,7 @@ var (
 	publicKey  *PublicKey
 )
 
-func setUp(t *testing.T) {
+func setUp(t testing.TB) {
 	if privateKey != nil && publicKey != nil {
 		return
 	}
@@ -39,7 +41,7 @@ func setUp(t *testing.T) {
 	defer cancel()
 
 	var err error
-	privateKey, publicKey, err = GenerateKeyPair(ctx, testPaillierKeyLength)
+	privateKey, publicKey, err = GenerateKeyPairWithRandom(ctx, rand.Reader, testPaillierKeyLength)
 	assert.NoError(t, err)
 }
 
@@ -58,6 +60,19 @@ func TestEncrypt(t *testing.T) {
 	t.Log(cipher)
 }
 
+func TestEncryptWithRandomness(t *testing.T) {
+	setUp(t)
+	m := big.NewInt(100)
+	cypher, x, err := publicKey.EncryptAndReturnRandomness(m)
+	assert.NoError(t, err)
+	again, err := publicKey.EncryptWithRandomness(m, x)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, cypher.Cmp(again), "the same message and randomness must give the same ciphertext")
+
+	_, err = publicKey.EncryptWithRandomness(m, publicKey.N)
+	assert.Error(t, err, "randomness outside of Z*_N must be rejected")
+}
+
 func TestEncryptDecrypt(t *testing.T) {
 	setUp(t)
 	exp := big.NewInt(100)
@@ -75,6 +90,85 @@ func TestEncryptDecrypt(t *testing.T) {
 	assert.Error(t, err)
 }
 
+// withoutCRT returns a copy of the key as it was before P and Q were kept, which decrypts with LambdaN
+func withoutCRT(sk *PrivateKey) *PrivateKey {
+	return &PrivateKey{PublicKey: PublicKey{N: sk.N}, LambdaN: sk.LambdaN, PhiN: sk.PhiN}
+}
+
+func TestDecryptCRT(t *testing.T) {
+	setUp(t)
+	assert.NotNil(t, privateKey.P)
+	assert.NotNil(t, privateKey.Q)
+	for _, m := range []*big.Int{big.NewInt(0), big.NewInt(1), new(big.Int).Sub(publicKey.N, big.NewInt(1)),
+		common.GetRandomPositiveInt(publicKey.N)} {
+		c, err := publicKey.Encrypt(m)
+		assert.NoError(t, err)
+		ret, err := privateKey.Decrypt(c)
+		assert.NoError(t, err)
+		assert.Equal(t, 0, m.Cmp(ret))
+		ret, err = withoutCRT(privateKey).Decrypt(c)
+		assert.NoError(t, err)
+		assert.Equal(t, 0, m.Cmp(ret))
+	}
+}
+
+func TestPrivateKeyJSON(t *testing.T) {
+	setUp(t)
+	m := common.GetRandomPositiveInt(publicKey.N)
+	c, err := publicKey.Encrypt(m)
+	assert.NoError(t, err)
+
+	bz, err := json.Marshal(privateKey)
+	assert.NoError(t, err)
+	var sk PrivateKey
+	assert.NoError(t, json.Unmarshal(bz, &sk))
+	assert.Equal(t, 0, privateKey.P.Cmp(sk.P))
+	assert.Equal(t, 0, privateKey.Q.Cmp(sk.Q))
+
+	// keys saved without P and Q have them recovered from N and PhiN
+	bz, err = json.Marshal(withoutCRT(privateKey))
+	assert.NoError(t, err)
+	assert.NotContains(t, string(bz), `"P"`)
+	var old PrivateKey
+	assert.NoError(t, json.Unmarshal(bz, &old))
+	assert.Equal(t, 0, new(big.Int).Mul(old.P, old.Q).Cmp(publicKey.N))
+	ret, err := old.Decrypt(c)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, m.Cmp(ret))
+}
+
+func TestRandomnessPool(t *testing.T) {
+	setUp(t)
+	_, err := NewRandomnessPool(publicKey, rand.Reader, 0)
+	assert.Error(t, err)
+	pool, err := NewRandomnessPool(publicKey, rand.Reader, 4, 2)
+	assert.NoError(t, err)
+	pool.Start()
+	defer pool.Stop()
+	for pool.Len() < 4 {
+		time.Sleep(10 * time.Millisecond)
+	}
+	pk := pool.PublicKey()
+	r, rN := pk.RandomNthPower(rand.Reader)
+	assert.Equal(t, 0, rN.Cmp(new(big.Int).Exp(r, pk.N, pk.NSquare())))
+
+	m := common.GetRandomPositiveInt(publicKey.N)
+	c, err := pk.Encrypt(m)
+	assert.NoError(t, err)
+	ret, err := privateKey.Decrypt(c)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, m.Cmp(ret))
+}
+
+func TestExpGamma(t *testing.T) {
+	setUp(t)
+	N2 := publicKey.NSquare()
+	for _, x := range []*big.Int{big.NewInt(0), big.NewInt(1), publicKey.N, new(big.Int).Add(publicKey.N, big.NewInt(5)),
+		common.GetRandomPositiveInt(N2)} {
+		assert.Equal(t, 0, new(big.Int).Exp(publicKey.Gamma(), x, N2).Cmp(publicKey.ExpGamma(x)))
+	}
+}
+
 func TestHomoMul(t *testing.T) {
 	setUp(t)
 	three, err := privateKey.Encrypt(big.NewInt(3))
@@ -113,8 +207,8 @@ func TestProofVerify(t *testing.T) {
 	ki := common.MustGetRandomInt(256)                     // index
 	ui := common.GetRandomPositiveInt(tss.EC().Params().N) // ECDSA private
 	yX, yY := tss.EC().ScalarBaseMult(ui.Bytes())          // ECDSA public
-	proof := privateKey.Proof(ki, crypto.NewECPointNoCurveCheck(tss.EC(), yX, yY))
-	res, err := proof.Verify(publicKey.N, ki, crypto.NewECPointNoCurveCheck(tss.EC(), yX, yY))
+	proof := privateKey.Proof(nil, ki, crypto.NewECPointNoCurveCheck(tss.EC(), yX, yY))
+	res, err := proof.Verify(nil, publicKey.N, ki, crypto.NewECPointNoCurveCheck(tss.EC(), yX, yY))
 	assert.NoError(t, err)
 	assert.True(t, res, "proof verify result must be true")
 }
@@ -124,10 +218,10 @@ func TestProofVerifyFail(t *testing.T) {
 	ki := common.MustGetRandomInt(256)                     // index
 	ui := common.GetRandomPositiveInt(tss.EC().Params().N) // ECDSA private
 	yX, yY := tss.EC().ScalarBaseMult(ui.Bytes())          // ECDSA public
-	proof := privateKey.Proof(ki, crypto.NewECPointNoCurveCheck(tss.EC(), yX, yY))
+	proof := privateKey.Proof(nil, ki, crypto.NewECPointNoCurveCheck(tss.EC(), yX, yY))
 	last := proof[len(proof)-1]
 	last.Sub(last, big.NewInt(1))
-	res, err := proof.Verify(publicKey.N, ki, crypto.NewECPointNoCurveCheck(tss.EC(), yX, yY))
+	res, err := proof.Verify(nil, publicKey.N, ki, crypto.NewECPointNoCurveCheck(tss.EC(), yX, yY))
 	assert.NoError(t, err)
 	assert.False(t, res, "proof verify result must be true")
 }
@@ -154,3 +248,59 @@ func TestGenerateXs(t *testing.T) {
 		assert.True(t, common.IsNumberInMultiplicativeGroup(N, xi))
 	}
 }
+
+// ----- //
+
+func BenchmarkDecrypt(b *testing.B) {
+	setUp(b)
+	c, _ := publicKey.Encrypt(common.GetRandomPositiveInt(publicKey.N))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = privateKey.Decrypt(c)
+	}
+}
+
+func BenchmarkDecryptWithoutCRT(b *testing.B) {
+	setUp(b)
+	sk := withoutCRT(privateKey)
+	c, _ := publicKey.Encrypt(common.GetRandomPositiveInt(publicKey.N))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = sk.Decrypt(c)
+	}
+}
+
+func BenchmarkEncrypt(b *testing.B) {
+	setUp(b)
+	m := common.GetRandomPositiveInt(publicKey.N)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = publicKey.Encrypt(m)
+	}
+}
+
+func BenchmarkEncryptWithPool(b *testing.B) {
+	setUp(b)
+	m := common.GetRandomPositiveInt(publicKey.N)
+	pool, _ := NewRandomnessPool(publicKey, rand.Reader, b.N)
+	pool.Start()
+	for pool.Len() < b.N {
+		time.Sleep(10 * time.Millisecond)
+	}
+	pool.Stop()
+	pk := pool.PublicKey()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = pk.Encrypt(m)
+	}
+}
+
+func BenchmarkProof(b *testing.B) {
+	setUp(b)
+	ki := common.MustGetRandomInt(256)
+	yX, yY := tss.EC().ScalarBaseMult(common.GetRandomPositiveInt(tss.EC().Params().N).Bytes())
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		privateKey.Proof(nil, ki, crypto.NewECPointNoCurveCheck(tss.EC(), yX, yY))
+	}
+}
@@ -0,0 +1,135 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package paillier
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/big"
+	"runtime"
+	"sync"
+
+	"github.com/bnb-chain/tss-lib/common"
+)
+
+type (
+	// RandomnessPool precomputes pairs (r, r^N mod N^2) for a public key in the background. These exponentiations are
+	// the costly part of an encryption and of the commitments in the MtA proofs. The key returned by PublicKey() draws
+	// from the pool, and computes the pair inline when the pool is empty, so it never blocks.
+	RandomnessPool struct {
+		publicKey   *PublicKey
+		rand        io.Reader
+		concurrency int
+		pairs       chan randomnessPair
+
+		mtx    sync.Mutex
+		cancel context.CancelFunc
+		done   chan struct{}
+	}
+
+	randomnessPair struct {
+		r, rN *big.Int
+	}
+)
+
+// NewRandomnessPool creates a pool that keeps up to `size` pairs ready for `publicKey`, drawing randomness from `rand`.
+// If not specified, a concurrency value equal to the number of available CPU cores will be used for generation.
+// Call Start() to begin filling the pool in the background.
+func NewRandomnessPool(publicKey *PublicKey, rand io.Reader, size int, optionalConcurrency ...int) (*RandomnessPool, error) {
+	if publicKey == nil || publicKey.N == nil {
+		return nil, errors.New("NewRandomnessPool: expected a non-nil `publicKey`")
+	}
+	if size < 1 {
+		return nil, errors.New("NewRandomnessPool: size must be at least 1")
+	}
+	var concurrency int
+	if 0 < len(optionalConcurrency) {
+		if 1 < len(optionalConcurrency) {
+			panic(errors.New("NewRandomnessPool: expected 0 or 1 item in `optionalConcurrency`"))
+		}
+		concurrency = optionalConcurrency[0]
+	} else {
+		concurrency = runtime.NumCPU()
+	}
+	pool := &RandomnessPool{
+		publicKey:   &PublicKey{N: publicKey.N},
+		rand:        rand,
+		concurrency: concurrency,
+		pairs:       make(chan randomnessPair, size),
+	}
+	pool.publicKey.pool = pool
+	return pool, nil
+}
+
+// PublicKey returns a copy of the public key that takes its encryption randomness from the pool.
+func (pool *RandomnessPool) PublicKey() *PublicKey {
+	pk := *pool.publicKey
+	return &pk
+}
+
+// Len returns the number of pairs ready for use.
+func (pool *RandomnessPool) Len() int {
+	return len(pool.pairs)
+}
+
+// Start begins computing pairs in the background until the pool holds its target size.
+// Computation resumes whenever pairs are taken. Calling Start on a running pool has no effect.
+func (pool *RandomnessPool) Start() {
+	pool.mtx.Lock()
+	defer pool.mtx.Unlock()
+	if pool.cancel != nil {
+		return
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	pool.cancel, pool.done = cancel, make(chan struct{})
+	go pool.fill(ctx, pool.done)
+}
+
+// Stop halts background computation and waits for the workers to exit. Pairs already in the pool are kept.
+func (pool *RandomnessPool) Stop() {
+	pool.mtx.Lock()
+	cancel, done := pool.cancel, pool.done
+	pool.cancel, pool.done = nil, nil
+	pool.mtx.Unlock()
+	if cancel == nil {
+		return
+	}
+	cancel()
+	<-done
+}
+
+func (pool *RandomnessPool) fill(ctx context.Context, done chan<- struct{}) {
+	defer close(done)
+	wg := sync.WaitGroup{}
+	wg.Add(pool.concurrency)
+	for i := 0; i < pool.concurrency; i++ {
+		go func() {
+			defer wg.Done()
+			for {
+				r, rN := pool.publicKey.nthPower(pool.rand)
+				select {
+				case pool.pairs <- randomnessPair{r, rN}:
+				case <-ctx.Done():
+					common.WipeInts(r, rN)
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+// take returns a pair from the pool, or false if it is empty
+func (pool *RandomnessPool) take() (r, rN *big.Int, ok bool) {
+	select {
+	case pair := <-pool.pairs:
+		return pair.r, pair.rN, true
+	default:
+		return nil, nil, false
+	}
+}
@@ -8,10 +8,13 @@ package schnorr
 
 import (
 	"errors"
+	"io"
 	"math/big"
 
 	"github.com/bnb-chain/tss-lib/common"
 	"github.com/bnb-chain/tss-lib/crypto"
+	"github.com/bnb-chain/tss-lib/crypto/group"
+	"github.com/bnb-chain/tss-lib/crypto/transcript"
 )
 
 type (
@@ -24,54 +27,65 @@ type (
 		Alpha *crypto.ECPoint
 		T, U  *big.Int
 	}
+
+	ZKDLEQProof struct {
+		Alpha, Beta *crypto.ECPoint
+		T           *big.Int
+	}
 )
 
 // NewZKProof constructs a new Schnorr ZK proof of knowledge of the discrete logarithm (GG18Spec Fig. 16)
-func NewZKProof(x *big.Int, X *crypto.ECPoint) (*ZKProof, error) {
+// The challenge is drawn from `tr`, or derived as in earlier versions if `tr` is nil.
+func NewZKProof(tr *transcript.Transcript, x *big.Int, X *crypto.ECPoint, rand io.Reader) (*ZKProof, error) {
 	if x == nil || X == nil || !X.ValidateBasic() {
 		return nil, errors.New("ZKProof constructor received nil or invalid value(s)")
 	}
 	ec := X.Curve()
+	grp, ok := group.FromCurve(ec)
+	if !ok {
+		return nil, errors.New("ZKProof constructor received a point on an unsupported curve")
+	}
 	ecParams := ec.Params()
-	q := ecParams.N
+	q := grp.Order()
 	g := crypto.NewECPointNoCurveCheck(ec, ecParams.Gx, ecParams.Gy) // already on the curve.
 
-	a := common.GetRandomPositiveInt(q)
+	a := common.GetRandomPositiveIntWithRandom(rand, q)
 	alpha := crypto.ScalarBaseMult(ec, a)
 
-	var c *big.Int
-	{
-		cHash := common.SHA512_256i(X.X(), X.Y(), g.X(), g.Y(), alpha.X(), alpha.Y())
-		c = common.RejectionSample(q, cHash)
-	}
-	t := new(big.Int).Mul(c, x)
-	t = common.ModInt(q).Add(a, t)
+	c := zkProofChallenge(tr, q, g, X, alpha)
+	// t = a + c * x
+	t := grp.ScalarFromBigInt(a).Add(grp.ScalarFromBigInt(c).Mul(grp.ScalarFromBigInt(x)))
 
-	return &ZKProof{Alpha: alpha, T: t}, nil
+	return &ZKProof{Alpha: alpha, T: t.BigInt()}, nil
 }
 
 // NewZKProof verifies a new Schnorr ZK proof of knowledge of the discrete logarithm (GG18Spec Fig. 16)
-func (pf *ZKProof) Verify(X *crypto.ECPoint) bool {
-	if pf == nil || !pf.ValidateBasic() {
+// `tr` must be in the state the prover's transcript was in, or nil for a proof made without one.
+func (pf *ZKProof) Verify(tr *transcript.Transcript, X *crypto.ECPoint) bool {
+	if pf == nil || !pf.ValidateBasic() || X == nil {
 		return false
 	}
 	ec := X.Curve()
+	grp, ok := group.FromCurve(ec)
+	if !ok {
+		return false
+	}
 	ecParams := ec.Params()
-	q := ecParams.N
+	q := grp.Order()
 	g := crypto.NewECPointNoCurveCheck(ec, ecParams.Gx, ecParams.Gy)
 
-	var c *big.Int
-	{
-		cHash := common.SHA512_256i(X.X(), X.Y(), g.X(), g.Y(), pf.Alpha.X(), pf.Alpha.Y())
-		c = common.RejectionSample(q, cHash)
+	c := zkProofChallenge(tr, q, g, X, pf.Alpha)
+	groupX, err := X.ToGroupPoint()
+	if err != nil {
+		return false
 	}
-	tG := crypto.ScalarBaseMult(ec, pf.T)
-	Xc := X.ScalarMult(c)
-	aXc, err := pf.Alpha.Add(Xc)
+	alpha, err := pf.Alpha.ToGroupPoint()
 	if err != nil {
 		return false
 	}
-	return aXc.X().Cmp(tG.X()) == 0 && aXc.Y().Cmp(tG.Y()) == 0
+	// tG = alpha + cX
+	tG := grp.Generator().ScalarMult(grp.ScalarFromBigInt(pf.T))
+	return tG.Equal(alpha.Add(groupX.ScalarMult(grp.ScalarFromBigInt(c))))
 }
 
 func (pf *ZKProof) ValidateBasic() bool {
@@ -79,58 +93,179 @@ func (pf *ZKProof) ValidateBasic() bool {
 }
 
 // NewZKProof constructs a new Schnorr ZK proof of knowledge s_i, l_i such that V_i = R^s_i, g^l_i (GG18Spec Fig. 17)
-func NewZKVProof(V, R *crypto.ECPoint, s, l *big.Int) (*ZKVProof, error) {
+// The challenge is drawn from `tr`, or derived as in earlier versions if `tr` is nil.
+func NewZKVProof(tr *transcript.Transcript, V, R *crypto.ECPoint, s, l *big.Int, rand io.Reader) (*ZKVProof, error) {
 	if V == nil || R == nil || s == nil || l == nil || !V.ValidateBasic() || !R.ValidateBasic() {
 		return nil, errors.New("ZKVProof constructor received nil value(s)")
 	}
 	ec := V.Curve()
+	grp, ok := group.FromCurve(ec)
+	if !ok {
+		return nil, errors.New("ZKVProof constructor received a point on an unsupported curve")
+	}
 	ecParams := ec.Params()
-	q := ecParams.N
+	q := grp.Order()
 	g := crypto.NewECPointNoCurveCheck(ec, ecParams.Gx, ecParams.Gy)
 
-	a, b := common.GetRandomPositiveInt(q), common.GetRandomPositiveInt(q)
+	a, b := common.GetRandomPositiveIntWithRandom(rand, q), common.GetRandomPositiveIntWithRandom(rand, q)
 	aR := R.ScalarMult(a)
 	bG := crypto.ScalarBaseMult(ec, b)
 	alpha, _ := aR.Add(bG) // already on the curve.
 
-	var c *big.Int
-	{
-		cHash := common.SHA512_256i(V.X(), V.Y(), R.X(), R.Y(), g.X(), g.Y(), alpha.X(), alpha.Y())
-		c = common.RejectionSample(q, cHash)
-	}
-	modQ := common.ModInt(q)
-	t := modQ.Add(a, new(big.Int).Mul(c, s))
-	u := modQ.Add(b, new(big.Int).Mul(c, l))
+	c := zkvProofChallenge(tr, q, g, V, R, alpha)
+	cScalar := grp.ScalarFromBigInt(c)
+	t := grp.ScalarFromBigInt(a).Add(cScalar.Mul(grp.ScalarFromBigInt(s)))
+	u := grp.ScalarFromBigInt(b).Add(cScalar.Mul(grp.ScalarFromBigInt(l)))
 
-	return &ZKVProof{Alpha: alpha, T: t, U: u}, nil
+	return &ZKVProof{Alpha: alpha, T: t.BigInt(), U: u.BigInt()}, nil
 }
 
-func (pf *ZKVProof) Verify(V, R *crypto.ECPoint) bool {
-	if pf == nil || !pf.ValidateBasic() {
+// `tr` must be in the state the prover's transcript was in, or nil for a proof made without one.
+func (pf *ZKVProof) Verify(tr *transcript.Transcript, V, R *crypto.ECPoint) bool {
+	if pf == nil || !pf.ValidateBasic() || V == nil || R == nil {
 		return false
 	}
 	ec := V.Curve()
+	grp, ok := group.FromCurve(ec)
+	if !ok {
+		return false
+	}
 	ecParams := ec.Params()
-	q := ecParams.N
+	q := grp.Order()
 	g := crypto.NewECPointNoCurveCheck(ec, ecParams.Gx, ecParams.Gy)
 
-	var c *big.Int
-	{
-		cHash := common.SHA512_256i(V.X(), V.Y(), R.X(), R.Y(), g.X(), g.Y(), pf.Alpha.X(), pf.Alpha.Y())
-		c = common.RejectionSample(q, cHash)
+	c := zkvProofChallenge(tr, q, g, V, R, pf.Alpha)
+	groupV, err := V.ToGroupPoint()
+	if err != nil {
+		return false
 	}
-	tR := R.ScalarMult(pf.T)
-	uG := crypto.ScalarBaseMult(ec, pf.U)
-	tRuG, _ := tR.Add(uG) // already on the curve.
-
-	Vc := V.ScalarMult(c)
-	aVc, err := pf.Alpha.Add(Vc)
+	groupR, err := R.ToGroupPoint()
 	if err != nil {
 		return false
 	}
-	return tRuG.X().Cmp(aVc.X()) == 0 && tRuG.Y().Cmp(aVc.Y()) == 0
+	alpha, err := pf.Alpha.ToGroupPoint()
+	if err != nil {
+		return false
+	}
+	// tR + uG = alpha + cV
+	tRuG := groupR.ScalarMult(grp.ScalarFromBigInt(pf.T)).Add(grp.Generator().ScalarMult(grp.ScalarFromBigInt(pf.U)))
+	return tRuG.Equal(alpha.Add(groupV.ScalarMult(grp.ScalarFromBigInt(c))))
 }
 
 func (pf *ZKVProof) ValidateBasic() bool {
 	return pf.Alpha != nil && pf.T != nil && pf.U != nil && pf.Alpha.ValidateBasic()
 }
+
+// NewZKDLEQProof constructs a Chaum-Pedersen ZK proof of knowledge of x such that X = g^x and Y = R^x
+// The challenge is drawn from `tr`, or derived from the statement alone if `tr` is nil.
+func NewZKDLEQProof(tr *transcript.Transcript, x *big.Int, X, R, Y *crypto.ECPoint, rand io.Reader) (*ZKDLEQProof, error) {
+	if x == nil || X == nil || R == nil || Y == nil || !X.ValidateBasic() || !R.ValidateBasic() || !Y.ValidateBasic() {
+		return nil, errors.New("ZKDLEQProof constructor received nil or invalid value(s)")
+	}
+	ec := X.Curve()
+	grp, ok := group.FromCurve(ec)
+	if !ok {
+		return nil, errors.New("ZKDLEQProof constructor received a point on an unsupported curve")
+	}
+	ecParams := ec.Params()
+	q := grp.Order()
+	g := crypto.NewECPointNoCurveCheck(ec, ecParams.Gx, ecParams.Gy)
+
+	a := common.GetRandomPositiveIntWithRandom(rand, q)
+	defer common.WipeInts(a)
+	alpha := crypto.ScalarBaseMult(ec, a)
+	beta := R.ScalarMult(a)
+
+	c := zkdleqProofChallenge(tr, q, g, X, R, Y, alpha, beta)
+	// t = a + c * x
+	t := grp.ScalarFromBigInt(a).Add(grp.ScalarFromBigInt(c).Mul(grp.ScalarFromBigInt(x)))
+
+	return &ZKDLEQProof{Alpha: alpha, Beta: beta, T: t.BigInt()}, nil
+}
+
+// `tr` must be in the state the prover's transcript was in, or nil for a proof made without one.
+func (pf *ZKDLEQProof) Verify(tr *transcript.Transcript, X, R, Y *crypto.ECPoint) bool {
+	if pf == nil || !pf.ValidateBasic() || X == nil || R == nil || Y == nil {
+		return false
+	}
+	ec := X.Curve()
+	grp, ok := group.FromCurve(ec)
+	if !ok {
+		return false
+	}
+	ecParams := ec.Params()
+	q := grp.Order()
+	g := crypto.NewECPointNoCurveCheck(ec, ecParams.Gx, ecParams.Gy)
+
+	c := zkdleqProofChallenge(tr, q, g, X, R, Y, pf.Alpha, pf.Beta)
+	groupX, err := X.ToGroupPoint()
+	if err != nil {
+		return false
+	}
+	groupR, err := R.ToGroupPoint()
+	if err != nil {
+		return false
+	}
+	groupY, err := Y.ToGroupPoint()
+	if err != nil {
+		return false
+	}
+	alpha, err := pf.Alpha.ToGroupPoint()
+	if err != nil {
+		return false
+	}
+	beta, err := pf.Beta.ToGroupPoint()
+	if err != nil {
+		return false
+	}
+	// tG = alpha + cX and tR = beta + cY
+	t, cScalar := grp.ScalarFromBigInt(pf.T), grp.ScalarFromBigInt(c)
+	return grp.Generator().ScalarMult(t).Equal(alpha.Add(groupX.ScalarMult(cScalar))) &&
+		groupR.ScalarMult(t).Equal(beta.Add(groupY.ScalarMult(cScalar)))
+}
+
+func (pf *ZKDLEQProof) ValidateBasic() bool {
+	return pf.Alpha != nil && pf.Beta != nil && pf.T != nil && pf.Alpha.ValidateBasic() && pf.Beta.ValidateBasic()
+}
+
+// ----- //
+
+func zkProofChallenge(tr *transcript.Transcript, q *big.Int, g, X, alpha *crypto.ECPoint) *big.Int {
+	if tr == nil {
+		cHash := common.SHA512_256i(X.X(), X.Y(), g.X(), g.Y(), alpha.X(), alpha.Y())
+		return common.RejectionSample(q, cHash)
+	}
+	tr.AppendMessage("proof", []byte("schnorr-dlog"))
+	tr.AppendPoints("G", g)
+	tr.AppendPoints("X", X)
+	tr.AppendPoints("alpha", alpha)
+	return tr.ChallengeInt("c", q)
+}
+
+func zkvProofChallenge(tr *transcript.Transcript, q *big.Int, g, V, R, alpha *crypto.ECPoint) *big.Int {
+	if tr == nil {
+		cHash := common.SHA512_256i(V.X(), V.Y(), R.X(), R.Y(), g.X(), g.Y(), alpha.X(), alpha.Y())
+		return common.RejectionSample(q, cHash)
+	}
+	tr.AppendMessage("proof", []byte("schnorr-v"))
+	tr.AppendPoints("G", g)
+	tr.AppendPoints("V", V)
+	tr.AppendPoints("R", R)
+	tr.AppendPoints("alpha", alpha)
+	return tr.ChallengeInt("c", q)
+}
+
+func zkdleqProofChallenge(tr *transcript.Transcript, q *big.Int, g, X, R, Y, alpha, beta *crypto.ECPoint) *big.Int {
+	if tr == nil {
+		cHash := common.SHA512_256i(g.X(), g.Y(), X.X(), X.Y(), R.X(), R.Y(), Y.X(), Y.Y(), alpha.X(), alpha.Y(), beta.X(), beta.Y())
+		return common.RejectionSample(q, cHash)
+	}
+	tr.AppendMessage("proof", []byte("schnorr-dleq"))
+	tr.AppendPoints("G", g)
+	tr.AppendPoints("X", X)
+	tr.AppendPoints("R", R)
+	tr.AppendPoints("Y", Y)
+	tr.AppendPoints("alpha", alpha)
+	tr.AppendPoints("beta", beta)
+	return tr.ChallengeInt("c", q)
+}
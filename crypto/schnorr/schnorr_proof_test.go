@@ -7,6 +7,7 @@
 package schnorr_test
 
 import (
+	"crypto/rand"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -14,6 +15,7 @@ import (
 	"github.com/bnb-chain/tss-lib/common"
 	"github.com/bnb-chain/tss-lib/crypto"
 	. "github.com/bnb-chain/tss-lib/crypto/schnorr"
+	"github.com/bnb-chain/tss-lib/crypto/transcript"
 	"github.com/bnb-chain/tss-lib/tss"
 )
 
@@ -21,7 +23,7 @@ func TestSchnorrProof(t *testing.T) {
 	q := tss.EC().Params().N
 	u := common.GetRandomPositiveInt(q)
 	uG := crypto.ScalarBaseMult(tss.EC(), u)
-	proof, _ := NewZKProof(u, uG)
+	proof, _ := NewZKProof(nil, u, uG, rand.Reader)
 
 	assert.True(t, proof.Alpha.IsOnCurve())
 	assert.NotZero(t, proof.Alpha.X())
@@ -34,12 +36,26 @@ func TestSchnorrProofVerify(t *testing.T) {
 	u := common.GetRandomPositiveInt(q)
 	X := crypto.ScalarBaseMult(tss.EC(), u)
 
-	proof, _ := NewZKProof(u, X)
-	res := proof.Verify(X)
+	proof, _ := NewZKProof(nil, u, X, rand.Reader)
+	res := proof.Verify(nil, X)
 
 	assert.True(t, res, "verify result must be true")
 }
 
+func TestSchnorrProofVerifyTranscript(t *testing.T) {
+	q := tss.EC().Params().N
+	u := common.GetRandomPositiveInt(q)
+	X := crypto.ScalarBaseMult(tss.EC(), u)
+	pIDs := tss.GenerateTestPartyIDs(2)
+	params := tss.NewParameters(tss.EC(), tss.NewPeerContext(pIDs), pIDs[0], len(pIDs), 1)
+
+	proof, _ := NewZKProof(transcript.ForProver(params, "test", pIDs[0]), u, X, rand.Reader)
+	assert.True(t, proof.Verify(transcript.ForProver(params, "test", pIDs[0]), X))
+	assert.False(t, proof.Verify(transcript.ForProver(params, "test", pIDs[1]), X), "another prover must not verify")
+	assert.False(t, proof.Verify(transcript.ForProver(params, "other", pIDs[0]), X), "another protocol must not verify")
+	assert.False(t, proof.Verify(nil, X), "a legacy verifier must not verify")
+}
+
 func TestSchnorrProofVerifyBadX(t *testing.T) {
 	q := tss.EC().Params().N
 	u := common.GetRandomPositiveInt(q)
@@ -47,8 +63,8 @@ func TestSchnorrProofVerifyBadX(t *testing.T) {
 	X := crypto.ScalarBaseMult(tss.EC(), u)
 	X2 := crypto.ScalarBaseMult(tss.EC(), u2)
 
-	proof, _ := NewZKProof(u2, X2)
-	res := proof.Verify(X)
+	proof, _ := NewZKProof(nil, u2, X2, rand.Reader)
+	res := proof.Verify(nil, X)
 
 	assert.False(t, res, "verify result must be false")
 }
@@ -63,8 +79,8 @@ func TestSchnorrVProofVerify(t *testing.T) {
 	lG := crypto.ScalarBaseMult(tss.EC(), l)
 	V, _ := Rs.Add(lG)
 
-	proof, _ := NewZKVProof(V, R, s, l)
-	res := proof.Verify(V, R)
+	proof, _ := NewZKVProof(nil, V, R, s, l, rand.Reader)
+	res := proof.Verify(nil, V, R)
 
 	assert.True(t, res, "verify result must be true")
 }
@@ -78,8 +94,8 @@ func TestSchnorrVProofVerifyBadPartialV(t *testing.T) {
 	Rs := R.ScalarMult(s)
 	V := Rs
 
-	proof, _ := NewZKVProof(V, R, s, l)
-	res := proof.Verify(V, R)
+	proof, _ := NewZKVProof(nil, V, R, s, l, rand.Reader)
+	res := proof.Verify(nil, V, R)
 
 	assert.False(t, res, "verify result must be false")
 }
@@ -95,8 +111,38 @@ func TestSchnorrVProofVerifyBadS(t *testing.T) {
 	lG := crypto.ScalarBaseMult(tss.EC(), l)
 	V, _ := Rs.Add(lG)
 
-	proof, _ := NewZKVProof(V, R, s2, l)
-	res := proof.Verify(V, R)
+	proof, _ := NewZKVProof(nil, V, R, s2, l, rand.Reader)
+	res := proof.Verify(nil, V, R)
+
+	assert.False(t, res, "verify result must be false")
+}
+
+func TestSchnorrDLEQProofVerify(t *testing.T) {
+	q := tss.EC().Params().N
+	k := common.GetRandomPositiveInt(q)
+	x := common.GetRandomPositiveInt(q)
+	R := crypto.ScalarBaseMult(tss.EC(), k)
+	X := crypto.ScalarBaseMult(tss.EC(), x)
+	Y := R.ScalarMult(x)
+	pIDs := tss.GenerateTestPartyIDs(2)
+	params := tss.NewParameters(tss.EC(), tss.NewPeerContext(pIDs), pIDs[0], len(pIDs), 1)
+
+	proof, _ := NewZKDLEQProof(transcript.ForProver(params, "test", pIDs[0]), x, X, R, Y, rand.Reader)
+	assert.True(t, proof.Verify(transcript.ForProver(params, "test", pIDs[0]), X, R, Y), "verify result must be true")
+	assert.False(t, proof.Verify(transcript.ForProver(params, "test", pIDs[1]), X, R, Y), "another prover must not verify")
+}
+
+func TestSchnorrDLEQProofVerifyBadY(t *testing.T) {
+	q := tss.EC().Params().N
+	k := common.GetRandomPositiveInt(q)
+	x := common.GetRandomPositiveInt(q)
+	x2 := common.GetRandomPositiveInt(q)
+	R := crypto.ScalarBaseMult(tss.EC(), k)
+	X := crypto.ScalarBaseMult(tss.EC(), x)
+	Y := R.ScalarMult(x2)
+
+	proof, _ := NewZKDLEQProof(nil, x, X, R, Y, rand.Reader)
+	res := proof.Verify(nil, X, R, Y)
 
 	assert.False(t, res, "verify result must be false")
 }
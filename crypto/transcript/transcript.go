@@ -0,0 +1,197 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+// Package transcript implements Fiat-Shamir transcripts for the non-interactive proofs, in the spirit of Merlin.
+//
+// Every value appended to a Transcript is framed with a label and its length and absorbed into a running SHA-512/256
+// chaining value, so that two transcripts only agree when the same labelled values were appended in the same order.
+// Challenges are squeezed from the chaining value and absorbed back into it, so a later challenge depends on all the
+// earlier ones. Prover and verifier must therefore perform the same operations on transcripts in the same state.
+//
+// A nil *Transcript selects the legacy challenge derivation of each proof, which hashes the bare values of the
+// statement and is kept for wire compatibility with peers that do not use transcripts.
+package transcript
+
+import (
+	"crypto/sha512"
+	"encoding/binary"
+	"hash"
+	"io"
+	"math/big"
+
+	"github.com/bnb-chain/tss-lib/common"
+	"github.com/bnb-chain/tss-lib/crypto"
+	"github.com/bnb-chain/tss-lib/tss"
+)
+
+const (
+	// domain separates these transcripts from any other use of the hash function
+	domain = "tss-lib transcript v1"
+
+	// extra bits squeezed for a challenge modulo a bound, so that reducing it leaves a negligible bias
+	challengeExtraBits = 128
+
+	// bits of fresh randomness mixed into a witness-derived secret
+	witnessRandomBits = 256
+)
+
+// operations, absorbed before their label so that an append can never be mistaken for a challenge
+const (
+	opAppend byte = iota + 1
+	opChallenge
+	opSqueeze
+)
+
+type Transcript struct {
+	state [sha512.Size256]byte
+}
+
+// New returns a transcript for the protocol described by `label`.
+func New(label string) *Transcript {
+	t := new(Transcript)
+	t.AppendMessage("domain", []byte(domain))
+	t.AppendMessage("protocol", []byte(label))
+	return t
+}
+
+// ForProver returns a transcript for a proof made by `prover` during the protocol `protocol` run with `params`. It binds
+// the session ID, the curve and the prover's identity. When params.LegacyProofs() is set it returns nil, which makes
+// the proofs use their legacy challenges.
+func ForProver(params *tss.Parameters, protocol string, prover *tss.PartyID) *Transcript {
+	if params.LegacyProofs() {
+		return nil
+	}
+	t := New(protocol)
+	t.AppendMessage("session", params.SessionID())
+	curveName, _ := tss.GetCurveName(params.EC())
+	t.AppendMessage("curve", []byte(curveName))
+	t.AppendPartyID("prover", prover)
+	return t
+}
+
+// Clone returns an independent copy of the transcript, or nil if it is nil.
+func (t *Transcript) Clone() *Transcript {
+	if t == nil {
+		return nil
+	}
+	cpy := *t
+	return &cpy
+}
+
+// AppendMessage absorbs `msg` under `label`. It is a no-op on a nil transcript, as are the other Append methods.
+func (t *Transcript) AppendMessage(label string, msg []byte) {
+	if t == nil {
+		return
+	}
+	t.absorb(opAppend, label, msg)
+}
+
+// AppendInts absorbs each of `xs` under `label`, keeping the sign and whether it is nil.
+func (t *Transcript) AppendInts(label string, xs ...*big.Int) {
+	if t == nil {
+		return
+	}
+	for _, x := range xs {
+		var msg []byte
+		switch {
+		case x == nil:
+			msg = []byte{0}
+		case x.Sign() < 0:
+			msg = append([]byte{2}, x.Bytes()...)
+		default:
+			msg = append([]byte{1}, x.Bytes()...)
+		}
+		t.absorb(opAppend, label, msg)
+	}
+}
+
+// AppendPoints absorbs the coordinates of each of `ps` under `label`.
+func (t *Transcript) AppendPoints(label string, ps ...*crypto.ECPoint) {
+	if t == nil {
+		return
+	}
+	for _, p := range ps {
+		if p == nil {
+			t.AppendInts(label, nil)
+			continue
+		}
+		t.AppendInts(label, p.X(), p.Y())
+	}
+}
+
+// AppendPartyID absorbs the unique key of `id` under `label`.
+func (t *Transcript) AppendPartyID(label string, id *tss.PartyID) {
+	if t == nil {
+		return
+	}
+	if id == nil {
+		t.AppendInts(label, nil)
+		return
+	}
+	t.AppendInts(label, id.KeyInt())
+}
+
+// ChallengeBytes squeezes `n` bytes from the transcript under `label` and absorbs them.
+func (t *Transcript) ChallengeBytes(label string, n int) []byte {
+	out := make([]byte, 0, n+sha512.Size256)
+	var ctr [4]byte
+	for i := uint32(0); len(out) < n; i++ {
+		binary.BigEndian.PutUint32(ctr[:], i)
+		h := t.frame(opSqueeze, label)
+		writeLen(h, n)
+		h.Write(ctr[:])
+		out = h.Sum(out)
+	}
+	out = out[:n]
+	t.absorb(opChallenge, label, out)
+	return out
+}
+
+// ChallengeInt squeezes a challenge in [0, bound) under `label`, e.g. modulo the curve order q or a modulus N.
+func (t *Transcript) ChallengeInt(label string, bound *big.Int) *big.Int {
+	n := (bound.BitLen() + challengeExtraBits + 7) / 8
+	c := new(big.Int).SetBytes(t.ChallengeBytes(label, n))
+	return c.Mod(c, bound)
+}
+
+// WitnessInt derives a secret in [1, bound) under `label`, e.g. a nonce, from the transcript, the secret `witness` and
+// fresh randomness read from `rand`, like the transcript RNG of Merlin. It is unpredictable as long as either the
+// randomness or the witness is, so a broken or repeated RNG state alone cannot repeat it for a different transcript.
+// The witness is absorbed into a copy, so the transcript itself is left unmodified.
+func (t *Transcript) WitnessInt(label string, witness *big.Int, rand io.Reader, bound *big.Int) *big.Int {
+	rng := t.Clone()
+	rng.AppendInts("witness", witness)
+	rng.AppendInts("rng", common.MustGetRandomIntWithRandom(rand, witnessRandomBits))
+	for {
+		if x := rng.ChallengeInt(label, bound); x.Sign() > 0 {
+			return x
+		}
+	}
+}
+
+// ----- //
+
+func (t *Transcript) absorb(op byte, label string, msg []byte) {
+	h := t.frame(op, label)
+	writeLen(h, len(msg))
+	h.Write(msg)
+	h.Sum(t.state[:0])
+}
+
+func (t *Transcript) frame(op byte, label string) hash.Hash {
+	h := sha512.New512_256()
+	h.Write(t.state[:])
+	h.Write([]byte{op})
+	writeLen(h, len(label))
+	h.Write([]byte(label))
+	return h
+}
+
+func writeLen(h hash.Hash, n int) {
+	var bz [8]byte
+	binary.BigEndian.PutUint64(bz[:], uint64(n))
+	h.Write(bz[:])
+}
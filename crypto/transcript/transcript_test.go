@@ -0,0 +1,121 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package transcript_test
+
+import (
+	"crypto/rand"
+	"io"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bnb-chain/tss-lib/common"
+	. "github.com/bnb-chain/tss-lib/crypto/transcript"
+	"github.com/bnb-chain/tss-lib/tss"
+)
+
+func TestTranscriptDeterministic(t *testing.T) {
+	build := func() *Transcript {
+		tr := New("test")
+		tr.AppendMessage("msg", []byte("hello"))
+		tr.AppendInts("ints", big.NewInt(1), big.NewInt(-1), nil)
+		return tr
+	}
+	c1, c2 := build().ChallengeBytes("c", 100), build().ChallengeBytes("c", 100)
+	assert.Len(t, c1, 100)
+	assert.Equal(t, c1, c2)
+
+	// a clone continues independently from the same state
+	tr := build()
+	cpy := tr.Clone()
+	assert.Equal(t, tr.ChallengeBytes("c", 32), cpy.ChallengeBytes("c", 32))
+	// challenges are absorbed, so squeezing twice gives different values
+	assert.NotEqual(t, tr.ChallengeBytes("c", 32), build().ChallengeBytes("c", 32))
+}
+
+func TestTranscriptSeparation(t *testing.T) {
+	challenge := func(f func(tr *Transcript)) []byte {
+		tr := New("test")
+		f(tr)
+		return tr.ChallengeBytes("c", 32)
+	}
+	challenges := [][]byte{
+		challenge(func(tr *Transcript) {}),
+		challenge(func(tr *Transcript) { tr.AppendMessage("a", []byte("bc")) }),
+		challenge(func(tr *Transcript) { tr.AppendMessage("ab", []byte("c")) }),
+		challenge(func(tr *Transcript) { tr.AppendMessage("a", []byte("b")); tr.AppendMessage("a", []byte("c")) }),
+		challenge(func(tr *Transcript) { tr.AppendInts("a", big.NewInt(1)) }),
+		challenge(func(tr *Transcript) { tr.AppendInts("a", big.NewInt(-1)) }),
+		challenge(func(tr *Transcript) { tr.AppendInts("a", nil) }),
+		challenge(func(tr *Transcript) { tr.AppendInts("a", big.NewInt(0)) }),
+		New("other").ChallengeBytes("c", 32),
+		New("test").ChallengeBytes("d", 32),
+	}
+	for i := range challenges {
+		for j := i + 1; j < len(challenges); j++ {
+			assert.NotEqual(t, challenges[i], challenges[j], "%d and %d", i, j)
+		}
+	}
+}
+
+func TestChallengeInt(t *testing.T) {
+	tr := New("test")
+	q := tss.EC().Params().N
+	for i := 0; i < 100; i++ {
+		c := tr.ChallengeInt("c", q)
+		assert.True(t, c.Sign() >= 0 && c.Cmp(q) < 0)
+	}
+	small := big.NewInt(3)
+	seen := make(map[int64]bool)
+	for i := 0; i < 100; i++ {
+		seen[tr.ChallengeInt("c", small).Int64()] = true
+	}
+	assert.Len(t, seen, 3)
+}
+
+func TestForProver(t *testing.T) {
+	pIDs := tss.GenerateTestPartyIDs(2)
+	params := tss.NewParameters(tss.S256(), tss.NewPeerContext(pIDs), pIDs[0], len(pIDs), 1)
+	challenge := func(params *tss.Parameters, prover *tss.PartyID) []byte {
+		return ForProver(params, "test", prover).ChallengeBytes("c", 32)
+	}
+	c := challenge(params, pIDs[0])
+	assert.NotEqual(t, c, challenge(params, pIDs[1]), "the prover should be bound")
+
+	other := tss.NewParameters(tss.S256(), tss.NewPeerContext(pIDs), pIDs[1], len(pIDs), 1)
+	assert.Equal(t, c, challenge(other, pIDs[0]), "the verifier should get the prover's transcript")
+	other.SetSessionID([]byte("another session"))
+	assert.NotEqual(t, c, challenge(other, pIDs[0]), "the session should be bound")
+	other = tss.NewParameters(tss.P256(), tss.NewPeerContext(pIDs), pIDs[1], len(pIDs), 1)
+	assert.NotEqual(t, c, challenge(other, pIDs[0]), "the curve should be bound")
+
+	params.SetLegacyProofs(true)
+	assert.Nil(t, ForProver(params, "test", pIDs[0]))
+}
+
+func TestWitnessInt(t *testing.T) {
+	q := tss.EC().Params().N
+	witness := big.NewInt(42)
+	// a source stuck in the same state, as after a VM snapshot is restored or a broken RNG
+	stuck := func() io.Reader { return common.NewDeterministicRandom([]byte("stuck")) }
+	derive := func(tr *Transcript, witness *big.Int, rand io.Reader) *big.Int {
+		return tr.WitnessInt("nonce", witness, rand, q)
+	}
+
+	tr := New("test")
+	tr.AppendMessage("m", []byte("message 1"))
+	x := derive(tr, witness, stuck())
+	assert.True(t, x.Sign() > 0 && x.Cmp(q) < 0)
+	assert.Equal(t, x, derive(tr, witness, stuck()), "the transcript should be left unmodified")
+	assert.NotEqual(t, x, derive(tr, witness, rand.Reader), "fresh randomness should be mixed in")
+	assert.NotEqual(t, x, derive(tr, big.NewInt(43), stuck()), "the witness should be bound")
+
+	other := New("test")
+	other.AppendMessage("m", []byte("message 2"))
+	assert.NotEqual(t, x, derive(other, witness, stuck()), "a repeated RNG state should not repeat the secret for another transcript")
+}
@@ -12,12 +12,15 @@ package vss
 
 import (
 	"crypto/elliptic"
+	crand "crypto/rand"
 	"errors"
 	"fmt"
+	"io"
 	"math/big"
 
 	"github.com/bnb-chain/tss-lib/common"
 	"github.com/bnb-chain/tss-lib/crypto"
+	"github.com/bnb-chain/tss-lib/crypto/group"
 )
 
 type (
@@ -58,8 +61,12 @@ func CheckIndexes(ec elliptic.Curve, indexes []*big.Int) ([]*big.Int, error) {
 
 // Returns a new array of secret shares created by Shamir's Secret Sharing Algorithm,
 // requiring a minimum number of shares to recreate, of length shares, from the input secret
-//
 func Create(ec elliptic.Curve, threshold int, secret *big.Int, indexes []*big.Int) (Vs, Shares, error) {
+	return CreateWithRandom(ec, threshold, secret, indexes, crand.Reader)
+}
+
+// CreateWithRandom is Create with the coefficients of the polynomial drawn from `rand`
+func CreateWithRandom(ec elliptic.Curve, threshold int, secret *big.Int, indexes []*big.Int, rand io.Reader) (Vs, Shares, error) {
 	if secret == nil || indexes == nil {
 		return nil, nil, fmt.Errorf("vss secret or indexes == nil: %v %v", secret, indexes)
 	}
@@ -77,99 +84,173 @@ func Create(ec elliptic.Curve, threshold int, secret *big.Int, indexes []*big.In
 		return nil, nil, ErrNumSharesBelowThreshold
 	}
 
-	poly := samplePolynomial(ec, threshold, secret)
-	poly[0] = secret // becomes sigma*G in v
+	g, ok := group.FromCurve(ec)
+	if !ok {
+		return nil, nil, errors.New("vss: unsupported curve")
+	}
+	poly := samplePolynomial(g, threshold, g.ScalarFromBigInt(secret), rand) // poly[0] becomes sigma*G in v
 	v := make(Vs, len(poly))
 	for i, ai := range poly {
-		v[i] = crypto.ScalarBaseMult(ec, ai)
+		if v[i], err = crypto.NewECPointFromGroupPoint(g, g.Generator().ScalarMult(ai)); err != nil {
+			return nil, nil, err
+		}
 	}
 
 	shares := make(Shares, num)
 	for i := 0; i < num; i++ {
-		share := evaluatePolynomial(ec, threshold, poly, ids[i])
-		shares[i] = &Share{Threshold: threshold, ID: ids[i], Share: share}
+		share := evaluatePolynomial(poly, g.ScalarFromBigInt(ids[i]))
+		shares[i] = &Share{Threshold: threshold, ID: ids[i], Share: share.BigInt()}
 	}
 	return v, shares, nil
 }
 
 func (share *Share) Verify(ec elliptic.Curve, threshold int, vs Vs) bool {
-	if share.Threshold != threshold || vs == nil {
+	if share.Threshold != threshold || len(vs) < threshold+1 {
 		return false
 	}
-	var err error
-	modQ := common.ModInt(ec.Params().N)
-	v, t := vs[0], one // YRO : we need to have our accumulator outside of the loop
-	for j := 1; j <= threshold; j++ {
-		// t = k_i^j
-		t = modQ.Mul(t, share.ID)
-		// v = v * v_j^t
-		vjt := vs[j].SetCurve(ec).ScalarMult(t)
-		v, err = v.SetCurve(ec).Add(vjt)
+	g, ok := group.FromCurve(ec)
+	if !ok {
+		return false
+	}
+	// v = v_0 + k_i v_1 + ... + k_i^t v_t, evaluated by Horner's rule in the group
+	id := g.ScalarFromBigInt(share.ID)
+	v := g.NewPoint()
+	for j := threshold; j >= 0; j-- {
+		if vs[j] == nil {
+			return false
+		}
+		vj, err := g.PointFromAffine(vs[j].X(), vs[j].Y())
 		if err != nil {
 			return false
 		}
+		v = v.ScalarMult(id).Add(vj)
 	}
-	sigmaGi := crypto.ScalarBaseMult(ec, share.Share)
-	return sigmaGi.Equals(v)
+	sigmaGi := g.Generator().ScalarMult(g.ScalarFromBigInt(share.Share))
+	return sigmaGi.Equal(v)
 }
 
 func (shares Shares) ReConstruct(ec elliptic.Curve) (secret *big.Int, err error) {
 	if shares != nil && shares[0].Threshold > len(shares) {
 		return nil, ErrNumSharesBelowThreshold
 	}
-	modN := common.ModInt(ec.Params().N)
+	g, ok := group.FromCurve(ec)
+	if !ok {
+		return nil, errors.New("vss: unsupported curve")
+	}
 
 	// x coords
-	xs := make([]*big.Int, 0)
+	xs := make([]group.Scalar, 0)
 	for _, share := range shares {
-		xs = append(xs, share.ID)
+		xs = append(xs, g.ScalarFromBigInt(share.ID))
+	}
+
+	result := g.NewScalar()
+	for i, share := range shares {
+		// times = prod xs[j] / (xs[j] - xs[i]), with a single inversion
+		num, den := g.ScalarFromBigInt(one), g.ScalarFromBigInt(one)
+		for j := 0; j < len(xs); j++ {
+			if j == i {
+				continue
+			}
+			num = num.Mul(xs[j])
+			den = den.Mul(xs[j].Sub(xs[i]))
+		}
+		times := num.Mul(den.Invert())
+
+		fTimes := g.ScalarFromBigInt(share.Share).Mul(times)
+		result = result.Add(fTimes)
+	}
+
+	return result.BigInt(), nil
+}
+
+// ReConstructVs rebuilds the whole polynomial from the first threshold+1 shares and returns its Feldman commitments,
+// which are the Vs that an honest dealer of the shares would have published
+func (shares Shares) ReConstructVs(ec elliptic.Curve) (Vs, error) {
+	if len(shares) == 0 || shares[0].Threshold+1 > len(shares) {
+		return nil, ErrNumSharesBelowThreshold
+	}
+	shares = shares[:shares[0].Threshold+1]
+	g, ok := group.FromCurve(ec)
+	if !ok {
+		return nil, errors.New("vss: unsupported curve")
+	}
+	ids := make([]*big.Int, len(shares))
+	for i, share := range shares {
+		ids[i] = share.ID
+	}
+	if _, err := CheckIndexes(ec, ids); err != nil {
+		return nil, err
 	}
 
-	secret = zero
+	// x coords
+	xs := make([]group.Scalar, len(shares))
 	for i, share := range shares {
-		times := one
+		xs[i] = g.ScalarFromBigInt(share.ID)
+	}
+
+	poly := make([]group.Scalar, len(shares))
+	for c := range poly {
+		poly[c] = g.NewScalar()
+	}
+	for i, share := range shares {
+		// basis = prod (x - xs[j]) / (xs[i] - xs[j]), expanded into its coefficients
+		basis, den := []group.Scalar{g.ScalarFromBigInt(one)}, g.ScalarFromBigInt(one)
 		for j := 0; j < len(xs); j++ {
 			if j == i {
 				continue
 			}
-			sub := modN.Sub(xs[j], share.ID)
-			subInv := modN.ModInverse(sub)
-			div := modN.Mul(xs[j], subInv)
-			times = modN.Mul(times, div)
+			next := append(make([]group.Scalar, 0, len(basis)+1), g.NewScalar())
+			next = append(next, basis...)
+			for c := range basis {
+				next[c] = next[c].Sub(basis[c].Mul(xs[j]))
+			}
+			basis = next
+			den = den.Mul(xs[i].Sub(xs[j]))
+		}
+		times := g.ScalarFromBigInt(share.Share).Mul(den.Invert())
+		for c := range poly {
+			poly[c] = poly[c].Add(basis[c].Mul(times))
 		}
+	}
 
-		fTimes := modN.Mul(share.Share, times)
-		secret = modN.Add(secret, fTimes)
+	vs := make(Vs, len(poly))
+	for c, ac := range poly {
+		var err error
+		if vs[c], err = crypto.NewECPointFromGroupPoint(g, g.Generator().ScalarMult(ac)); err != nil {
+			return nil, err
+		}
 	}
+	return vs, nil
+}
 
-	return secret, nil
+// Wipe overwrites the secret share values; see common.WipeInts.
+func (shares Shares) Wipe() {
+	for _, share := range shares {
+		if share != nil {
+			common.WipeInts(share.Share)
+		}
+	}
 }
 
-func samplePolynomial(ec elliptic.Curve, threshold int, secret *big.Int) []*big.Int {
-	q := ec.Params().N
-	v := make([]*big.Int, threshold+1)
+func samplePolynomial(g group.Group, threshold int, secret group.Scalar, rand io.Reader) []group.Scalar {
+	v := make([]group.Scalar, threshold+1)
 	v[0] = secret
 	for i := 1; i <= threshold; i++ {
-		ai := common.GetRandomPositiveInt(q)
-		v[i] = ai
+		v[i] = group.RandomScalar(g, rand)
 	}
 	return v
 }
 
 // Evauluates a polynomial with coefficients such that:
 // evaluatePolynomial([a, b, c, d], x):
-// 		returns a + bx + cx^2 + dx^3
 //
-func evaluatePolynomial(ec elliptic.Curve, threshold int, v []*big.Int, id *big.Int) (result *big.Int) {
-	q := ec.Params().N
-	modQ := common.ModInt(q)
-	result = new(big.Int).Set(v[0])
-	X := big.NewInt(int64(1))
-	for i := 1; i <= threshold; i++ {
-		ai := v[i]
-		X = modQ.Mul(X, id)
-		aiXi := new(big.Int).Mul(ai, X)
-		result = modQ.Add(result, aiXi)
+//	returns a + bx + cx^2 + dx^3
+func evaluatePolynomial(v []group.Scalar, id group.Scalar) group.Scalar {
+	// Horner's rule: ((d x + c) x + b) x + a
+	result := v[len(v)-1]
+	for i := len(v) - 2; i >= 0; i-- {
+		result = result.Mul(id).Add(v[i])
 	}
-	return
+	return result
 }
@@ -13,6 +13,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/bnb-chain/tss-lib/common"
+	"github.com/bnb-chain/tss-lib/crypto"
 	. "github.com/bnb-chain/tss-lib/crypto/vss"
 	"github.com/bnb-chain/tss-lib/tss"
 )
@@ -114,3 +115,79 @@ func TestReconstruct(t *testing.T) {
 	assert.NoError(t, err4)
 	assert.NotZero(t, secret4)
 }
+
+func TestReconstructOnAllCurves(t *testing.T) {
+	num, threshold := 5, 2
+	for _, name := range []tss.CurveName{tss.Secp256k1, tss.Secp256r1, tss.Ed25519} {
+		ec, _ := tss.GetCurveByName(name)
+		secret := common.GetRandomPositiveInt(ec.Params().N)
+		ids := make([]*big.Int, 0)
+		for i := 0; i < num; i++ {
+			ids = append(ids, big.NewInt(int64(i+1)))
+		}
+
+		vs, shares, err := Create(ec, threshold, secret, ids)
+		assert.NoError(t, err, name)
+		assert.True(t, vs[0].Equals(crypto.ScalarBaseMult(ec, secret)), name)
+		for _, share := range shares {
+			assert.True(t, share.Verify(ec, threshold, vs), name)
+		}
+
+		secret2, err := shares[1 : threshold+2].ReConstruct(ec)
+		assert.NoError(t, err, name)
+		assert.Equal(t, 0, secret.Cmp(secret2), name)
+	}
+}
+
+func TestReconstructVsOnAllCurves(t *testing.T) {
+	num, threshold := 5, 2
+	for _, name := range []tss.CurveName{tss.Secp256k1, tss.Secp256r1, tss.Ed25519} {
+		ec, _ := tss.GetCurveByName(name)
+		secret := common.GetRandomPositiveInt(ec.Params().N)
+		ids := make([]*big.Int, 0)
+		for i := 0; i < num; i++ {
+			ids = append(ids, common.GetRandomPositiveInt(ec.Params().N))
+		}
+
+		vs, shares, err := Create(ec, threshold, secret, ids)
+		assert.NoError(t, err, name)
+
+		_, err = shares[:threshold].ReConstructVs(ec)
+		assert.Error(t, err, name) // not enough shares to rebuild the polynomial
+
+		vs2, err := shares[2:].ReConstructVs(ec)
+		assert.NoError(t, err, name)
+		if assert.Len(t, vs2, threshold+1, name) {
+			for c := range vs {
+				assert.True(t, vs[c].Equals(vs2[c]), name)
+			}
+		}
+	}
+}
+
+func BenchmarkCreate(b *testing.B) {
+	num, threshold := 20, 10
+	secret := common.GetRandomPositiveInt(tss.EC().Params().N)
+	ids := make([]*big.Int, 0)
+	for i := 0; i < num; i++ {
+		ids = append(ids, common.GetRandomPositiveInt(tss.EC().Params().N))
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _, _ = Create(tss.EC(), threshold, secret, ids)
+	}
+}
+
+func BenchmarkReConstruct(b *testing.B) {
+	num, threshold := 20, 10
+	secret := common.GetRandomPositiveInt(tss.EC().Params().N)
+	ids := make([]*big.Int, 0)
+	for i := 0; i < num; i++ {
+		ids = append(ids, common.GetRandomPositiveInt(tss.EC().Params().N))
+	}
+	_, shares, _ := Create(tss.EC(), threshold, secret, ids)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = shares.ReConstruct(tss.EC())
+	}
+}
@@ -0,0 +1,119 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+// Pedersen VSS, based on Torben Pryds Pedersen, 1991., Non-interactive and information-theoretic secure verifiable
+// secret sharing. In Advances in Cryptology — CRYPTO '91, 129–140
+//
+
+package vss
+
+import (
+	"crypto/elliptic"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+
+	"github.com/bnb-chain/tss-lib/crypto"
+	"github.com/bnb-chain/tss-lib/crypto/group"
+	"github.com/bnb-chain/tss-lib/tss"
+)
+
+const pedersenGeneratorDST = "tss-lib pedersen vss generator"
+
+// PedersenGenerator returns the second generator h of the Pedersen commitments on the curve, derived by hashing the
+// curve name to a point so that nobody knows its discrete logarithm to the base point g.
+func PedersenGenerator(ec elliptic.Curve) (*crypto.ECPoint, error) {
+	g, ok := group.FromCurve(ec)
+	if !ok {
+		return nil, errors.New("vss: unsupported curve")
+	}
+	return crypto.NewECPointFromGroupPoint(g, pedersenGenerator(g))
+}
+
+func pedersenGenerator(g group.CurveGroup) group.Point {
+	name, _ := tss.GetCurveName(g.Curve())
+	return group.HashToPoint(g, []byte(pedersenGeneratorDST), []byte(name))
+}
+
+// CreatePedersen shares the secret like Create, along with a random blinding polynomial. It returns the Feldman
+// commitments vs = a_k*G of the secret polynomial, the hiding Pedersen commitments cs = a_k*G + b_k*H to both
+// polynomials, the shares of the secret and the shares of the blinding polynomial.
+//
+// The cs are published first and do not reveal the secret; the vs are published once the shares have been checked
+// against the cs with VerifyPedersen, as in the Pedersen DKG of Gennaro, Jarecki, Krawczyk and Rabin (GJKR). The vs of
+// a dealer that does not publish valid ones are rebuilt in public from its shares with ReConstructVs.
+func CreatePedersen(ec elliptic.Curve, threshold int, secret *big.Int, indexes []*big.Int, rand io.Reader) (vs, cs Vs, shares, blindings Shares, err error) {
+	if secret == nil || indexes == nil {
+		return nil, nil, nil, nil, fmt.Errorf("vss secret or indexes == nil: %v %v", secret, indexes)
+	}
+	if threshold < 1 {
+		return nil, nil, nil, nil, errors.New("vss threshold < 1")
+	}
+
+	ids, err := CheckIndexes(ec, indexes)
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+
+	num := len(indexes)
+	if num < threshold {
+		return nil, nil, nil, nil, ErrNumSharesBelowThreshold
+	}
+
+	g, ok := group.FromCurve(ec)
+	if !ok {
+		return nil, nil, nil, nil, errors.New("vss: unsupported curve")
+	}
+	h := pedersenGenerator(g)
+	poly := samplePolynomial(g, threshold, g.ScalarFromBigInt(secret), rand)
+	blindingPoly := samplePolynomial(g, threshold, group.RandomScalar(g, rand), rand)
+	vs, cs = make(Vs, len(poly)), make(Vs, len(poly))
+	for i, ai := range poly {
+		aiG := g.Generator().ScalarMult(ai)
+		if vs[i], err = crypto.NewECPointFromGroupPoint(g, aiG); err != nil {
+			return nil, nil, nil, nil, err
+		}
+		if cs[i], err = crypto.NewECPointFromGroupPoint(g, aiG.Add(h.ScalarMult(blindingPoly[i]))); err != nil {
+			return nil, nil, nil, nil, err
+		}
+	}
+
+	shares, blindings = make(Shares, num), make(Shares, num)
+	for i := 0; i < num; i++ {
+		id := g.ScalarFromBigInt(ids[i])
+		shares[i] = &Share{Threshold: threshold, ID: ids[i], Share: evaluatePolynomial(poly, id).BigInt()}
+		blindings[i] = &Share{Threshold: threshold, ID: ids[i], Share: evaluatePolynomial(blindingPoly, id).BigInt()}
+	}
+	return vs, cs, shares, blindings, nil
+}
+
+// VerifyPedersen checks the share and its blinding share against the Pedersen commitments cs output by CreatePedersen.
+func (share *Share) VerifyPedersen(ec elliptic.Curve, threshold int, blinding *big.Int, cs Vs) bool {
+	if share.Threshold != threshold || blinding == nil || len(cs) < threshold+1 {
+		return false
+	}
+	g, ok := group.FromCurve(ec)
+	if !ok {
+		return false
+	}
+	h := pedersenGenerator(g)
+	// c = c_0 + k_i c_1 + ... + k_i^t c_t, evaluated by Horner's rule in the group
+	id := g.ScalarFromBigInt(share.ID)
+	c := g.NewPoint()
+	for j := threshold; j >= 0; j-- {
+		if cs[j] == nil {
+			return false
+		}
+		cj, err := g.PointFromAffine(cs[j].X(), cs[j].Y())
+		if err != nil {
+			return false
+		}
+		c = c.ScalarMult(id).Add(cj)
+	}
+	sigmaGi := g.Generator().ScalarMult(g.ScalarFromBigInt(share.Share)).Add(h.ScalarMult(g.ScalarFromBigInt(blinding)))
+	return sigmaGi.Equal(c)
+}
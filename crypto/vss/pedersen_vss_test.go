@@ -0,0 +1,83 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package vss_test
+
+import (
+	"crypto/rand"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bnb-chain/tss-lib/common"
+	"github.com/bnb-chain/tss-lib/crypto"
+	. "github.com/bnb-chain/tss-lib/crypto/vss"
+	"github.com/bnb-chain/tss-lib/tss"
+)
+
+func TestPedersenGenerator(t *testing.T) {
+	H, err := PedersenGenerator(tss.EC())
+	assert.NoError(t, err)
+	assert.True(t, H.IsOnCurve())
+	assert.False(t, H.Equals(crypto.ScalarBaseMult(tss.EC(), big.NewInt(1))))
+
+	H2, err := PedersenGenerator(tss.Edwards())
+	assert.NoError(t, err)
+	assert.True(t, H2.IsOnCurve())
+}
+
+func TestCreatePedersen(t *testing.T) {
+	num, threshold := 5, 3
+
+	secret := common.GetRandomPositiveInt(tss.EC().Params().N)
+
+	ids := make([]*big.Int, 0)
+	for i := 0; i < num; i++ {
+		ids = append(ids, common.GetRandomPositiveInt(tss.EC().Params().N))
+	}
+
+	vs, cs, shares, blindings, err := CreatePedersen(tss.EC(), threshold, secret, ids, rand.Reader)
+	assert.NoError(t, err)
+	assert.Equal(t, threshold+1, len(vs))
+	assert.Equal(t, threshold+1, len(cs))
+	assert.Equal(t, num, len(shares))
+	assert.Equal(t, num, len(blindings))
+
+	// the Feldman commitment to the secret is revealed by vs but not by cs
+	assert.True(t, vs[0].Equals(crypto.ScalarBaseMult(tss.EC(), secret)))
+	assert.False(t, cs[0].Equals(vs[0]))
+
+	for i, share := range shares {
+		assert.True(t, share.VerifyPedersen(tss.EC(), threshold, blindings[i].Share, cs))
+		assert.True(t, share.Verify(tss.EC(), threshold, vs))
+		assert.False(t, share.VerifyPedersen(tss.EC(), threshold, blindings[(i+1)%num].Share, cs))
+	}
+
+	secret2, err := shares[:threshold+1].ReConstruct(tss.EC())
+	assert.NoError(t, err)
+	assert.Equal(t, secret, secret2)
+}
+
+func TestCreatePedersenEdwards(t *testing.T) {
+	num, threshold := 5, 2
+	ec := tss.Edwards()
+
+	secret := common.GetRandomPositiveInt(ec.Params().N)
+	ids := make([]*big.Int, 0)
+	for i := 0; i < num; i++ {
+		ids = append(ids, common.GetRandomPositiveInt(ec.Params().N))
+	}
+
+	vs, cs, shares, blindings, err := CreatePedersen(ec, threshold, secret, ids, rand.Reader)
+	assert.NoError(t, err)
+	for i, share := range shares {
+		assert.True(t, share.VerifyPedersen(ec, threshold, blindings[i].Share, cs))
+		assert.True(t, share.Verify(ec, threshold, vs))
+	}
+	badShare := &Share{Threshold: threshold, ID: shares[0].ID, Share: new(big.Int).Add(shares[0].Share, big.NewInt(1))}
+	assert.False(t, badShare.VerifyPedersen(ec, threshold, blindings[0].Share, cs))
+}
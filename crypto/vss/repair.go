@@ -0,0 +1,90 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+// Share repair, based on Thalia M. Laing and Douglas R. Stinson, 2017., A survey and refinement of repairable threshold
+// schemes. In Journal of Mathematical Cryptology 12 (1), 57–81
+//
+
+package vss
+
+import (
+	"crypto/elliptic"
+	"errors"
+	"io"
+	"math/big"
+
+	"github.com/bnb-chain/tss-lib/crypto/group"
+)
+
+// CreateRepairParts returns the contribution of this share to the repair of the share of `lostID`, split into random
+// parts that add up to it, one for each of the helpers `helperIDs` in order. The contribution is the share weighted by
+// its Lagrange coefficient at `lostID` over the helpers, so the contributions of all the helpers add up to the lost
+// share while every part on its own is uniformly random.
+func (share *Share) CreateRepairParts(ec elliptic.Curve, helperIDs []*big.Int, lostID *big.Int, rand io.Reader) ([]*big.Int, error) {
+	if share == nil || share.ID == nil || share.Share == nil || lostID == nil {
+		return nil, errors.New("vss: the share or the lost share ID is nil")
+	}
+	if len(helperIDs) < share.Threshold+1 {
+		return nil, ErrNumSharesBelowThreshold
+	}
+	ids, err := CheckIndexes(ec, append(append([]*big.Int{}, helperIDs...), lostID))
+	if err != nil {
+		return nil, err
+	}
+	g, ok := group.FromCurve(ec)
+	if !ok {
+		return nil, errors.New("vss: unsupported curve")
+	}
+	xs, x, xi := make([]group.Scalar, len(helperIDs)), g.ScalarFromBigInt(ids[len(helperIDs)]), g.ScalarFromBigInt(share.ID)
+	self := -1
+	for j, id := range ids[:len(helperIDs)] {
+		xs[j] = g.ScalarFromBigInt(id)
+		if xs[j].Equal(xi) {
+			self = j
+		}
+	}
+	if self < 0 {
+		return nil, errors.New("vss: the share is not one of the helpers")
+	}
+
+	// lambda = prod (x - xs[j]) / (xs[self] - xs[j]), with a single inversion
+	num, den := g.ScalarFromBigInt(one), g.ScalarFromBigInt(one)
+	for j, xj := range xs {
+		if j == self {
+			continue
+		}
+		num = num.Mul(x.Sub(xj))
+		den = den.Mul(xs[self].Sub(xj))
+	}
+	contribution := g.ScalarFromBigInt(share.Share).Mul(num.Mul(den.Invert()))
+
+	parts := make([]*big.Int, len(xs))
+	last := contribution
+	for j := 0; j < len(parts)-1; j++ {
+		part := group.RandomScalar(g, rand)
+		parts[j] = part.BigInt()
+		last = last.Sub(part)
+	}
+	parts[len(parts)-1] = last.BigInt()
+	return parts, nil
+}
+
+// SumRepairParts adds up repair parts modulo the group order: a helper adds up the parts it received from every
+// helper, and the party being repaired adds up these sums from every helper to recover its share.
+func SumRepairParts(ec elliptic.Curve, parts []*big.Int) (*big.Int, error) {
+	g, ok := group.FromCurve(ec)
+	if !ok {
+		return nil, errors.New("vss: unsupported curve")
+	}
+	sum := g.NewScalar()
+	for _, part := range parts {
+		if part == nil {
+			return nil, errors.New("vss: nil repair part")
+		}
+		sum = sum.Add(g.ScalarFromBigInt(part))
+	}
+	return sum.BigInt(), nil
+}
@@ -0,0 +1,65 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package vss_test
+
+import (
+	"crypto/elliptic"
+	"crypto/rand"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bnb-chain/tss-lib/common"
+	. "github.com/bnb-chain/tss-lib/crypto/vss"
+	"github.com/bnb-chain/tss-lib/tss"
+)
+
+func TestRepairShare(t *testing.T) {
+	num, threshold := 6, 2
+	for _, ec := range []elliptic.Curve{tss.S256(), tss.Edwards()} {
+		secret := common.GetRandomPositiveInt(ec.Params().N)
+		ids := make([]*big.Int, 0)
+		for i := 0; i < num; i++ {
+			ids = append(ids, common.GetRandomPositiveInt(ec.Params().N))
+		}
+		_, shares, err := Create(ec, threshold, secret, ids)
+		assert.NoError(t, err)
+
+		// shares 1..4 help to repair share 0; helper j sends its part j to helper j
+		lost, helpers := shares[0], shares[1:threshold+3]
+		helperIDs := make([]*big.Int, len(helpers))
+		for j, helper := range helpers {
+			helperIDs[j] = helper.ID
+		}
+		received := make([][]*big.Int, len(helpers))
+		for _, helper := range helpers {
+			parts, err := helper.CreateRepairParts(ec, helperIDs, lost.ID, rand.Reader)
+			assert.NoError(t, err)
+			assert.Equal(t, len(helpers), len(parts))
+			for j, part := range parts {
+				received[j] = append(received[j], part)
+			}
+		}
+		sums := make([]*big.Int, len(helpers))
+		for j := range helpers {
+			sums[j], err = SumRepairParts(ec, received[j])
+			assert.NoError(t, err)
+		}
+		repaired, err := SumRepairParts(ec, sums)
+		assert.NoError(t, err)
+		assert.Equal(t, lost.Share, repaired)
+
+		// too few helpers, a helper that is not listed and a lost share among the helpers are rejected
+		_, err = helpers[0].CreateRepairParts(ec, helperIDs[:threshold], lost.ID, rand.Reader)
+		assert.Error(t, err)
+		_, err = shares[5].CreateRepairParts(ec, helperIDs, lost.ID, rand.Reader)
+		assert.Error(t, err)
+		_, err = helpers[0].CreateRepairParts(ec, helperIDs, helperIDs[1], rand.Reader)
+		assert.Error(t, err)
+	}
+}
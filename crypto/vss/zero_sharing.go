@@ -0,0 +1,89 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+// Zero sharings, based on Amir Herzberg, Stanisław Jarecki, Hugo Krawczyk and Moti Yung, 1995., Proactive secret
+// sharing or: how to cope with perpetual leakage. In Advances in Cryptology — CRYPTO '95, 339–352
+//
+
+package vss
+
+import (
+	"crypto/elliptic"
+	"errors"
+	"io"
+	"math/big"
+
+	"github.com/bnb-chain/tss-lib/crypto"
+	"github.com/bnb-chain/tss-lib/crypto/group"
+)
+
+// CreateZeroSharing returns Feldman shares of zero for the given indexes, which the holders of a sharing add to their
+// shares to re-randomize them without changing the secret. The commitments are those of the coefficients a_1..a_t
+// only: a_0 is zero and its commitment, the identity, cannot be represented by an ECPoint.
+func CreateZeroSharing(ec elliptic.Curve, threshold int, indexes []*big.Int, rand io.Reader) (Vs, Shares, error) {
+	if threshold < 1 {
+		return nil, nil, errors.New("vss threshold < 1")
+	}
+	ids, err := CheckIndexes(ec, indexes)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(ids) < threshold {
+		return nil, nil, ErrNumSharesBelowThreshold
+	}
+	g, ok := group.FromCurve(ec)
+	if !ok {
+		return nil, nil, errors.New("vss: unsupported curve")
+	}
+	poly := samplePolynomial(g, threshold, g.NewScalar(), rand)
+	v := make(Vs, threshold)
+	for i, ai := range poly[1:] {
+		if v[i], err = crypto.NewECPointFromGroupPoint(g, g.Generator().ScalarMult(ai)); err != nil {
+			return nil, nil, err
+		}
+	}
+	shares := make(Shares, len(ids))
+	for i, id := range ids {
+		share := evaluatePolynomial(poly, g.ScalarFromBigInt(id))
+		shares[i] = &Share{Threshold: threshold, ID: id, Share: share.BigInt()}
+	}
+	return v, shares, nil
+}
+
+// VerifyZero checks the share against the commitments `vs` of a zero sharing made with CreateZeroSharing.
+func (share *Share) VerifyZero(ec elliptic.Curve, threshold int, vs Vs) bool {
+	if share.Threshold != threshold || len(vs) != threshold {
+		return false
+	}
+	g, ok := group.FromCurve(ec)
+	if !ok {
+		return false
+	}
+	v, err := EvaluateZeroCommitments(g, vs, share.ID)
+	if err != nil {
+		return false
+	}
+	return g.Generator().ScalarMult(g.ScalarFromBigInt(share.Share)).Equal(v)
+}
+
+// EvaluateZeroCommitments returns s·G for the share s of `id` under the zero sharing committed to by `vs`. Every
+// party can compute it to update the public share of `id`; it may be the identity, hence the group.Point.
+func EvaluateZeroCommitments(g group.CurveGroup, vs Vs, id *big.Int) (group.Point, error) {
+	// v = k v_1 + ... + k^t v_t = k (v_1 + k (v_2 + ...)), evaluated by Horner's rule in the group
+	k := g.ScalarFromBigInt(id)
+	v := g.NewPoint()
+	for j := len(vs) - 1; j >= 0; j-- {
+		if vs[j] == nil {
+			return nil, errors.New("vss: nil commitment")
+		}
+		vj, err := g.PointFromAffine(vs[j].X(), vs[j].Y())
+		if err != nil {
+			return nil, err
+		}
+		v = v.Add(vj).ScalarMult(k)
+	}
+	return v, nil
+}
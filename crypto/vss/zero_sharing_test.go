@@ -0,0 +1,61 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package vss_test
+
+import (
+	"crypto/elliptic"
+	"crypto/rand"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bnb-chain/tss-lib/common"
+	"github.com/bnb-chain/tss-lib/crypto/group"
+	. "github.com/bnb-chain/tss-lib/crypto/vss"
+	"github.com/bnb-chain/tss-lib/tss"
+)
+
+func TestCreateZeroSharing(t *testing.T) {
+	num, threshold := 5, 2
+	for _, ec := range []elliptic.Curve{tss.S256(), tss.Edwards()} {
+		g, _ := group.FromCurve(ec)
+		secret := common.GetRandomPositiveInt(ec.Params().N)
+		ids := make([]*big.Int, 0)
+		for i := 0; i < num; i++ {
+			ids = append(ids, common.GetRandomPositiveInt(ec.Params().N))
+		}
+
+		_, shares, err := Create(ec, threshold, secret, ids)
+		assert.NoError(t, err)
+		zs, zeros, err := CreateZeroSharing(ec, threshold, ids, rand.Reader)
+		assert.NoError(t, err)
+		assert.Equal(t, threshold, len(zs))
+
+		refreshed := make(Shares, num)
+		for i, zero := range zeros {
+			assert.True(t, zero.VerifyZero(ec, threshold, zs))
+			v, err := EvaluateZeroCommitments(g, zs, zero.ID)
+			assert.NoError(t, err)
+			assert.True(t, g.Generator().ScalarMult(g.ScalarFromBigInt(zero.Share)).Equal(v))
+
+			sum := new(big.Int).Add(shares[i].Share, zero.Share)
+			refreshed[i] = &Share{Threshold: threshold, ID: ids[i], Share: sum.Mod(sum, ec.Params().N)}
+			assert.NotEqual(t, shares[i].Share, refreshed[i].Share)
+		}
+		secret2, err := refreshed[:threshold+1].ReConstruct(ec)
+		assert.NoError(t, err)
+		assert.Equal(t, secret, secret2)
+		zero, err := zeros[1:].ReConstruct(ec)
+		assert.NoError(t, err)
+		assert.Zero(t, zero.Sign())
+
+		badShare := &Share{Threshold: threshold, ID: zeros[0].ID, Share: new(big.Int).Add(zeros[0].Share, big.NewInt(1))}
+		assert.False(t, badShare.VerifyZero(ec, threshold, zs))
+		assert.False(t, zeros[0].VerifyZero(ec, threshold, zs[:threshold-1]))
+	}
+}
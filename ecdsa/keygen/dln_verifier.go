@@ -11,6 +11,7 @@ import (
 	"math/big"
 
 	"github.com/bnb-chain/tss-lib/crypto/dlnproof"
+	"github.com/bnb-chain/tss-lib/crypto/transcript"
 )
 
 type DlnProofVerifier struct {
@@ -35,6 +36,7 @@ func NewDlnProofVerifier(concurrency int) *DlnProofVerifier {
 }
 
 func (dpv *DlnProofVerifier) VerifyDLNProof1(
+	tr *transcript.Transcript,
 	m message,
 	h1, h2, n *big.Int,
 	onDone func(bool),
@@ -49,11 +51,12 @@ func (dpv *DlnProofVerifier) VerifyDLNProof1(
 			return
 		}
 
-		onDone(dlnProof.Verify(h1, h2, n))
+		onDone(dlnProof.Verify(tr, h1, h2, n))
 	}()
 }
 
 func (dpv *DlnProofVerifier) VerifyDLNProof2(
+	tr *transcript.Transcript,
 	m message,
 	h1, h2, n *big.Int,
 	onDone func(bool),
@@ -68,6 +71,6 @@ func (dpv *DlnProofVerifier) VerifyDLNProof2(
 			return
 		}
 
-		onDone(dlnProof.Verify(h1, h2, n))
+		onDone(dlnProof.Verify(tr, h1, h2, n))
 	}()
 }
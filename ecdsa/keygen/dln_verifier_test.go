@@ -7,6 +7,7 @@
 package keygen
 
 import (
+	"crypto/rand"
 	"math/big"
 	"runtime"
 	"testing"
@@ -23,17 +24,19 @@ func BenchmarkDlnProof_Verify(b *testing.B) {
 	params := localPartySaveData[0].LocalPreParams
 
 	proof := dlnproof.NewDLNProof(
+		nil,
 		params.H1i,
 		params.H2i,
 		params.Alpha,
 		params.P,
 		params.Q,
 		params.NTildei,
+		rand.Reader,
 	)
 
 	b.ResetTimer()
 	for n := 0; n < b.N; n++ {
-		proof.Verify(params.H1i, params.H2i, params.NTildei)
+		proof.Verify(nil, params.H1i, params.H2i, params.NTildei)
 	}
 }
 
@@ -48,7 +51,7 @@ func BenchmarkDlnVerifier_VerifyProof1(b *testing.B) {
 	b.ResetTimer()
 	for n := 0; n < b.N; n++ {
 		resultChan := make(chan bool)
-		verifier.VerifyDLNProof1(message, preParams.H1i, preParams.H2i, preParams.NTildei, func(result bool) {
+		verifier.VerifyDLNProof1(nil, message, preParams.H1i, preParams.H2i, preParams.NTildei, func(result bool) {
 			resultChan <- result
 		})
 		<-resultChan
@@ -66,7 +69,7 @@ func BenchmarkDlnVerifier_VerifyProof2(b *testing.B) {
 	b.ResetTimer()
 	for n := 0; n < b.N; n++ {
 		resultChan := make(chan bool)
-		verifier.VerifyDLNProof2(message, preParams.H1i, preParams.H2i, preParams.NTildei, func(result bool) {
+		verifier.VerifyDLNProof2(nil, message, preParams.H1i, preParams.H2i, preParams.NTildei, func(result bool) {
 			resultChan <- result
 		})
 		<-resultChan
@@ -83,7 +86,7 @@ func TestVerifyDLNProof1_Success(t *testing.T) {
 
 	resultChan := make(chan bool)
 
-	verifier.VerifyDLNProof1(message, preParams.H1i, preParams.H2i, preParams.NTildei, func(result bool) {
+	verifier.VerifyDLNProof1(nil, message, preParams.H1i, preParams.H2i, preParams.NTildei, func(result bool) {
 		resultChan <- result
 	})
 
@@ -103,7 +106,7 @@ func TestVerifyDLNProof1_MalformedMessage(t *testing.T) {
 
 	resultChan := make(chan bool)
 
-	verifier.VerifyDLNProof1(message, preParams.H1i, preParams.H2i, preParams.NTildei, func(result bool) {
+	verifier.VerifyDLNProof1(nil, message, preParams.H1i, preParams.H2i, preParams.NTildei, func(result bool) {
 		resultChan <- result
 	})
 
@@ -124,7 +127,7 @@ func TestVerifyDLNProof1_IncorrectProof(t *testing.T) {
 	resultChan := make(chan bool)
 
 	wrongH1i := preParams.H1i.Sub(preParams.H1i, big.NewInt(1))
-	verifier.VerifyDLNProof1(message, wrongH1i, preParams.H2i, preParams.NTildei, func(result bool) {
+	verifier.VerifyDLNProof1(nil, message, wrongH1i, preParams.H2i, preParams.NTildei, func(result bool) {
 		resultChan <- result
 	})
 
@@ -144,7 +147,7 @@ func TestVerifyDLNProof2_Success(t *testing.T) {
 
 	resultChan := make(chan bool)
 
-	verifier.VerifyDLNProof2(message, preParams.H1i, preParams.H2i, preParams.NTildei, func(result bool) {
+	verifier.VerifyDLNProof2(nil, message, preParams.H1i, preParams.H2i, preParams.NTildei, func(result bool) {
 		resultChan <- result
 	})
 
@@ -164,7 +167,7 @@ func TestVerifyDLNProof2_MalformedMessage(t *testing.T) {
 
 	resultChan := make(chan bool)
 
-	verifier.VerifyDLNProof2(message, preParams.H1i, preParams.H2i, preParams.NTildei, func(result bool) {
+	verifier.VerifyDLNProof2(nil, message, preParams.H1i, preParams.H2i, preParams.NTildei, func(result bool) {
 		resultChan <- result
 	})
 
@@ -185,7 +188,7 @@ func TestVerifyDLNProof2_IncorrectProof(t *testing.T) {
 	resultChan := make(chan bool)
 
 	wrongH2i := preParams.H2i.Add(preParams.H2i, big.NewInt(1))
-	verifier.VerifyDLNProof2(message, preParams.H1i, wrongH2i, preParams.NTildei, func(result bool) {
+	verifier.VerifyDLNProof2(nil, message, preParams.H1i, wrongH2i, preParams.NTildei, func(result bool) {
 		resultChan <- result
 	})
 
@@ -222,12 +225,14 @@ func prepareProof() (*LocalPreParams, [][]byte, error) {
 	preParams := localPartySaveData[0].LocalPreParams
 
 	proof := dlnproof.NewDLNProof(
+		nil,
 		preParams.H1i,
 		preParams.H2i,
 		preParams.Alpha,
 		preParams.P,
 		preParams.Q,
 		preParams.NTildei,
+		rand.Reader,
 	)
 
 	serialized, err := proof.Serialize()
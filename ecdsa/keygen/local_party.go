@@ -39,7 +39,12 @@ type (
 		kgRound1Messages,
 		kgRound2Message1s,
 		kgRound2Message2s,
-		kgRound3Messages []tss.ParsedMessage
+		kgRound3Messages,
+		kgComplaintMessages,
+		kgRevealMessages,
+		kgFeldmanMessages,
+		kgFeldmanComplaintMessages,
+		kgReconstructMessages []tss.ParsedMessage
 	}
 
 	localTempData struct {
@@ -51,6 +56,22 @@ type (
 		vs            vss.Vs
 		shares        vss.Shares
 		deCommitPolyG cmt.HashDeCommitment
+		// with the Pedersen DKG, the shares of our blinding polynomial and every party's Pedersen VSS commitments
+		blindingShares vss.Shares
+		pedersenCmts   []vss.Vs
+		// chain code contributions are committed to in round 1 and revealed in round 2
+		chainCodeCmts     []cmt.HashCommitment
+		deCommitChainCode cmt.HashDeCommitment
+		// every dealer's Feldman commitments, the dealers left out of the key and, in robust mode, the shares that were
+		// revealed to us after our complaints
+		dealerVs       []vss.Vs
+		disqualified   []bool
+		revealedShares []*big.Int
+		// with the Pedersen DKG, the qualified dealers whose polynomials are rebuilt in public from their shares
+		exposed []bool
+
+		// pre-params are taken from this pool in round 1 when it is set and none were provided
+		preParamsPool *PreParamsPool
 	}
 )
 
@@ -86,8 +107,35 @@ func NewLocalParty(
 	p.temp.kgRound2Message1s = make([]tss.ParsedMessage, partyCount)
 	p.temp.kgRound2Message2s = make([]tss.ParsedMessage, partyCount)
 	p.temp.kgRound3Messages = make([]tss.ParsedMessage, partyCount)
+	p.temp.kgComplaintMessages = make([]tss.ParsedMessage, partyCount)
+	p.temp.kgRevealMessages = make([]tss.ParsedMessage, partyCount)
+	p.temp.kgFeldmanMessages = make([]tss.ParsedMessage, partyCount)
+	p.temp.kgFeldmanComplaintMessages = make([]tss.ParsedMessage, partyCount)
+	p.temp.kgReconstructMessages = make([]tss.ParsedMessage, partyCount)
 	// temp data init
 	p.temp.KGCs = make([]cmt.HashCommitment, partyCount)
+	p.temp.chainCodeCmts = make([]cmt.HashCommitment, partyCount)
+	p.temp.pedersenCmts = make([]vss.Vs, partyCount)
+	p.temp.dealerVs = make([]vss.Vs, partyCount)
+	p.temp.disqualified = make([]bool, partyCount)
+	p.temp.revealedShares = make([]*big.Int, partyCount)
+	p.temp.exposed = make([]bool, partyCount)
+	return p
+}
+
+// NewLocalPartyWithPreParamsPool returns a party that takes its pre-params from `pool` in round 1 instead of
+// generating them inline. The entry is consumed exactly once, when the party is started.
+func NewLocalPartyWithPreParamsPool(
+	params *tss.Parameters,
+	pool *PreParamsPool,
+	out chan<- tss.Message,
+	end chan<- LocalPartySaveData,
+) tss.Party {
+	if pool == nil {
+		panic(errors.New("keygen.NewLocalPartyWithPreParamsPool expected a non-nil `pool`"))
+	}
+	p := NewLocalParty(params, out, end).(*LocalParty)
+	p.temp.preParamsPool = pool
 	return p
 }
 
@@ -141,6 +189,16 @@ func (p *LocalParty) StoreMessage(msg tss.ParsedMessage) (bool, *tss.Error) {
 		p.temp.kgRound2Message2s[fromPIdx] = msg
 	case *KGRound3Message:
 		p.temp.kgRound3Messages[fromPIdx] = msg
+	case *KGComplaintMessage:
+		p.temp.kgComplaintMessages[fromPIdx] = msg
+	case *KGRevealMessage:
+		p.temp.kgRevealMessages[fromPIdx] = msg
+	case *KGFeldmanMessage:
+		p.temp.kgFeldmanMessages[fromPIdx] = msg
+	case *KGFeldmanComplaintMessage:
+		p.temp.kgFeldmanComplaintMessages[fromPIdx] = msg
+	case *KGReconstructMessage:
+		p.temp.kgReconstructMessages[fromPIdx] = msg
 	default: // unrecognised message, just ignore!
 		common.Logger.Warningf("unrecognised message ignored: %v", msg)
 		return false, nil
@@ -165,6 +223,21 @@ func (save LocalPartySaveData) OriginalIndex() (int, error) {
 	return index, nil
 }
 
+// WipeTempData overwrites this party's secret polynomial constant ui, the shares it dealt and the shares it received,
+// along with their blinding shares when the Pedersen DKG is used.
+func (p *LocalParty) WipeTempData() {
+	common.WipeInts(p.temp.ui)
+	p.temp.shares.Wipe()
+	p.temp.blindingShares.Wipe()
+	for _, msg := range p.temp.kgRound2Message1s {
+		if msg != nil {
+			r2msg1 := msg.Content().(*KGRound2Message1)
+			common.WipeBytes(r2msg1.Share)
+			common.WipeBytes(r2msg1.BlindingShare)
+		}
+	}
+}
+
 func (p *LocalParty) PartyID() *tss.PartyID {
 	return p.params.PartyID()
 }
@@ -128,6 +128,38 @@ func TestFinishAndSaveH1H2(t *testing.T) {
 	assert.NotZero(t, lp.data.NTildei, "n-tilde should be non-zero")
 }
 
+func TestAbortWipesTempData(t *testing.T) {
+	setUp("info")
+
+	fixtures, _, err := LoadKeygenTestFixtures(1)
+	if err != nil {
+		t.Skip("the test fixtures are needed to start a party quickly")
+	}
+	pIDs := tss.GenerateTestPartyIDs(3)
+	params := tss.NewParameters(tss.EC(), tss.NewPeerContext(pIDs), pIDs[0], len(pIDs), 1)
+	out := make(chan tss.Message, len(pIDs))
+	lp := NewLocalParty(params, out, nil, fixtures[0].LocalPreParams).(*LocalParty)
+	if err := lp.Start(); err != nil {
+		assert.FailNow(t, err.Error())
+	}
+	<-out
+	ui, shares := lp.temp.ui, lp.temp.shares
+	assert.NotZero(t, ui.Sign())
+
+	tss.Abort(lp)
+	assert.False(t, lp.Running())
+	assert.Zero(t, ui.Sign())
+	for _, share := range shares {
+		assert.Zero(t, share.Share.Sign())
+	}
+	// the pre-params belong to the caller and are kept
+	assert.NotZero(t, fixtures[0].PaillierSK.LambdaN.Sign())
+
+	// an aborted party cannot be restarted on its wiped values
+	assert.NotNil(t, lp.Start(), "an aborted party should not start again")
+	assert.False(t, lp.Running())
+}
+
 func TestBadMessageCulprits(t *testing.T) {
 	setUp("debug")
 
@@ -152,7 +184,7 @@ func TestBadMessageCulprits(t *testing.T) {
 		assert.FailNow(t, err.Error())
 	}
 
-	badMsg, _ := NewKGRound1Message(pIDs[1], zero, &paillier.PublicKey{N: zero}, zero, zero, zero, new(dlnproof.Proof), new(dlnproof.Proof))
+	badMsg, _ := NewKGRound1Message(pIDs[1], zero, &paillier.PublicKey{N: zero}, zero, zero, zero, new(dlnproof.Proof), new(dlnproof.Proof), zero, nil)
 	ok, err2 := lp.Update(badMsg)
 	t.Log(err2)
 	assert.False(t, ok)
@@ -206,6 +238,12 @@ func TestE2EConcurrentAndSaveFixtures(t *testing.T) {
 		}(P)
 	}
 
+	// dealtShares[j][i] is the share of Pj's polynomial sent to Pi
+	dealtShares := make([][]*big.Int, len(pIDs))
+	for j := range dealtShares {
+		dealtShares[j] = make([]*big.Int, len(pIDs))
+	}
+
 	// PHASE: keygen
 	var ended int32
 keygen:
@@ -231,6 +269,10 @@ keygen:
 					t.Fatalf("party %d tried to send a message to itself (%d)", dest[0].Index, msg.GetFrom().Index)
 					return
 				}
+				// the parties wipe the shares they received once they finish, so keep a copy for the checks below
+				if r2msg1, ok := msg.(tss.ParsedMessage).Content().(*KGRound2Message1); ok {
+					dealtShares[msg.GetFrom().Index][dest[0].Index] = new(big.Int).SetBytes(r2msg1.Share)
+				}
 				go updater(parties[dest[0].Index], msg, errCh)
 			}
 
@@ -249,13 +291,14 @@ keygen:
 				u := new(big.Int)
 				for j, Pj := range parties {
 					pShares := make(vss.Shares, 0)
-					for _, P := range parties {
-						vssMsgs := P.temp.kgRound2Message1s
-						share := vssMsgs[j].Content().(*KGRound2Message1).Share
+					for j2, P := range parties {
+						if j2 == j {
+							continue
+						}
 						shareStruct := &vss.Share{
 							Threshold: threshold,
 							ID:        P.PartyID().KeyInt(),
-							Share:     new(big.Int).SetBytes(share),
+							Share:     dealtShares[j][j2],
 						}
 						pShares = append(pShares, shareStruct)
 					}
@@ -263,7 +306,6 @@ keygen:
 					assert.NoError(t, err, "vss.ReConstruct should not throw error")
 
 					// uG test: u*G[j] == V[0]
-					assert.Equal(t, uj, Pj.temp.ui)
 					uG := crypto.ScalarBaseMult(tss.EC(), uj)
 					assert.True(t, uG.Equals(Pj.temp.vs[0]), "ensure u*G[j] == V_0")
 
@@ -277,10 +319,10 @@ keygen:
 					{
 						badShares := pShares[:threshold]
 						badShares[len(badShares)-1].Share.Set(big.NewInt(0))
-						uj, err := pShares[:threshold].ReConstruct(tss.S256())
+						badUj, err := pShares[:threshold].ReConstruct(tss.S256())
 						assert.NoError(t, err)
-						assert.NotEqual(t, parties[j].temp.ui, uj)
-						BigXjX, BigXjY := tss.EC().ScalarBaseMult(uj.Bytes())
+						assert.NotEqual(t, uj, badUj)
+						BigXjX, BigXjY := tss.EC().ScalarBaseMult(badUj.Bytes())
 						assert.NotEqual(t, BigXjX, Pj.temp.vs[0].X())
 						assert.NotEqual(t, BigXjY, Pj.temp.vs[0].Y())
 					}
@@ -308,10 +350,12 @@ keygen:
 				assert.Equal(t, pkY, ourPkY, "pkY should match expected pk derived from u")
 				t.Log("Public key tests done.")
 
-				// make sure everyone has the same ECDSA public key
+				// make sure everyone has the same ECDSA public key and chain code
 				for _, Pj := range parties {
 					assert.Equal(t, pkX, Pj.data.ECDSAPub.X())
 					assert.Equal(t, pkY, Pj.data.ECDSAPub.Y())
+					assert.Len(t, Pj.data.ChainCode, ChainCodeLen)
+					assert.Equal(t, save.ChainCode, Pj.data.ChainCode)
 				}
 				t.Log("Public key distribution test done.")
 
@@ -359,3 +403,432 @@ func tryWriteTestFixtureFile(t *testing.T, index int, data LocalPartySaveData) {
 	}
 	//
 }
+
+func TestE2ESeededRunIsReproducible(t *testing.T) {
+	setUp("info")
+	fixtures, pIDs, err := LoadKeygenTestFixtures(3)
+	if err != nil {
+		t.Skip("no test fixtures were found; run TestE2EConcurrentAndSaveFixtures first")
+	}
+	run := func() [][]byte {
+		p2pCtx := tss.NewPeerContext(pIDs)
+		parties := make([]*LocalParty, 0, len(pIDs))
+		errCh := make(chan *tss.Error, len(pIDs))
+		outCh := make(chan tss.Message, len(pIDs))
+		endCh := make(chan LocalPartySaveData, len(pIDs))
+		for i := 0; i < len(pIDs); i++ {
+			params := tss.NewParameters(tss.S256(), p2pCtx, pIDs[i], len(pIDs), 1)
+			params.SetRand(common.NewDeterministicRandom([]byte(fmt.Sprintf("keygen-%d", i))))
+			P := NewLocalParty(params, outCh, endCh, fixtures[i].LocalPreParams).(*LocalParty)
+			parties = append(parties, P)
+			go func(P *LocalParty) {
+				if err := P.Start(); err != nil {
+					errCh <- err
+				}
+			}(P)
+		}
+		saves := make([][]byte, len(pIDs))
+		for ended := 0; ended < len(pIDs); {
+			select {
+			case err := <-errCh:
+				assert.FailNow(t, err.Error())
+			case msg := <-outCh:
+				dest := msg.GetTo()
+				if dest == nil {
+					for _, P := range parties {
+						if P.PartyID().Index != msg.GetFrom().Index {
+							go test.SharedPartyUpdater(P, msg, errCh)
+						}
+					}
+				} else {
+					go test.SharedPartyUpdater(parties[dest[0].Index], msg, errCh)
+				}
+			case save := <-endCh:
+				index, err := save.OriginalIndex()
+				assert.NoError(t, err)
+				saves[index], err = json.Marshal(&save)
+				assert.NoError(t, err)
+				ended++
+			}
+		}
+		return saves
+	}
+	saves1, saves2 := run(), run()
+	var saved [2]LocalPartySaveData
+	assert.NoError(t, json.Unmarshal(saves1[0], &saved[0]))
+	assert.NoError(t, json.Unmarshal(saves1[1], &saved[1]))
+	assert.Len(t, saved[0].ChainCode, ChainCodeLen)
+	assert.Equal(t, saved[0].ChainCode, saved[1].ChainCode, "every party should have the same chain code")
+	for i := range saves1 {
+		assert.Equal(t, saves1[i], saves2[i], "the save data of party %d should be identical across seeded runs", i)
+	}
+}
+
+func TestE2EP256(t *testing.T) {
+	setUp("info")
+	ec := tss.P256()
+	fixtures, pIDs, err := LoadKeygenTestFixtures(3)
+	if !assert.NoError(t, err, "should load keygen fixtures") {
+		t.FailNow()
+	}
+	threshold := 1
+	p2pCtx := tss.NewPeerContext(pIDs)
+	parties := make([]*LocalParty, 0, len(pIDs))
+	errCh := make(chan *tss.Error, len(pIDs))
+	outCh := make(chan tss.Message, len(pIDs))
+	endCh := make(chan LocalPartySaveData, len(pIDs))
+	for i := 0; i < len(pIDs); i++ {
+		// the pre-params do not depend on the curve, so those of the fixtures are re-used for speed
+		params := tss.NewParameters(ec, p2pCtx, pIDs[i], len(pIDs), threshold)
+		P := NewLocalParty(params, outCh, endCh, fixtures[i].LocalPreParams).(*LocalParty)
+		parties = append(parties, P)
+		go func(P *LocalParty) {
+			if err := P.Start(); err != nil {
+				errCh <- err
+			}
+		}(P)
+	}
+	saves := make([]LocalPartySaveData, len(pIDs))
+	for ended := 0; ended < len(pIDs); {
+		select {
+		case err := <-errCh:
+			assert.FailNow(t, err.Error())
+		case msg := <-outCh:
+			dest := msg.GetTo()
+			if dest == nil {
+				for _, P := range parties {
+					if P.PartyID().Index != msg.GetFrom().Index {
+						go test.SharedPartyUpdater(P, msg, errCh)
+					}
+				}
+			} else {
+				go test.SharedPartyUpdater(parties[dest[0].Index], msg, errCh)
+			}
+		case save := <-endCh:
+			index, err := save.OriginalIndex()
+			assert.NoError(t, err)
+			saves[index] = save
+			ended++
+		}
+	}
+	pub := saves[0].ECDSAPub
+	assert.Equal(t, ec, pub.Curve(), "the public key should be on P-256")
+
+	// the save data should round-trip through JSON with its curve
+	bz, err := json.Marshal(&saves[0])
+	assert.NoError(t, err)
+	var decoded LocalPartySaveData
+	assert.NoError(t, json.Unmarshal(bz, &decoded))
+	assert.True(t, pub.Equals(decoded.ECDSAPub))
+	assert.Equal(t, ec, decoded.ECDSAPub.Curve())
+
+	// the shares x_i reconstruct the secret key, which signs for the public key with crypto/ecdsa
+	shares := make(vss.Shares, len(saves))
+	for i, save := range saves {
+		assert.True(t, pub.Equals(save.ECDSAPub), "every party should have the same public key")
+		assert.True(t, save.BigXj[i].Equals(crypto.ScalarBaseMult(ec, save.Xi)), "ensure BigX_j == g^x_j")
+		shares[i] = &vss.Share{Threshold: threshold, ID: save.ShareID, Share: save.Xi}
+	}
+	x, err := shares[:threshold+1].ReConstruct(ec)
+	assert.NoError(t, err)
+	sk := &ecdsa.PrivateKey{PublicKey: *pub.ToECDSAPubKey(), D: x}
+	digest := make([]byte, 32)
+	r, s, err := ecdsa.Sign(rand.Reader, sk, digest)
+	assert.NoError(t, err)
+	assert.True(t, ecdsa.Verify(pub.ToECDSAPubKey(), digest, r, s), "ecdsa verify with crypto/ecdsa on P-256 must pass")
+}
+
+func TestE2EPedersenDKG(t *testing.T) {
+	setUp("info")
+	fixtures, pIDs, err := LoadKeygenTestFixtures(3)
+	if err != nil {
+		t.Skip("no test fixtures were found; run TestE2EConcurrentAndSaveFixtures first")
+	}
+	threshold := 1
+	p2pCtx := tss.NewPeerContext(pIDs)
+	parties := make([]*LocalParty, 0, len(pIDs))
+	errCh := make(chan *tss.Error, len(pIDs))
+	outCh := make(chan tss.Message, len(pIDs))
+	endCh := make(chan LocalPartySaveData, len(pIDs))
+	for i := 0; i < len(pIDs); i++ {
+		params := tss.NewParameters(tss.S256(), p2pCtx, pIDs[i], len(pIDs), threshold)
+		params.SetPedersenDKG(true)
+		P := NewLocalParty(params, outCh, endCh, fixtures[i].LocalPreParams).(*LocalParty)
+		parties = append(parties, P)
+		go func(P *LocalParty) {
+			if err := P.Start(); err != nil {
+				errCh <- err
+			}
+		}(P)
+	}
+	saves := make([]LocalPartySaveData, len(pIDs))
+	for ended := 0; ended < len(pIDs); {
+		select {
+		case err := <-errCh:
+			assert.FailNow(t, err.Error())
+		case msg := <-outCh:
+			if r1msg, ok := msg.(tss.ParsedMessage).Content().(*KGRound1Message); ok {
+				assert.Len(t, r1msg.GetPedersenCommitments(), 2*(threshold+1), "round 1 should carry the Pedersen commitments")
+			}
+			if r2msg2, ok := msg.(tss.ParsedMessage).Content().(*KGRound2Message2); ok {
+				assert.Empty(t, r2msg2.GetDeCommitment(), "the Feldman commitments should be opened after the dealers are qualified")
+			}
+			dest := msg.GetTo()
+			if dest == nil {
+				for _, P := range parties {
+					if P.PartyID().Index != msg.GetFrom().Index {
+						go test.SharedPartyUpdater(P, msg, errCh)
+					}
+				}
+			} else {
+				go test.SharedPartyUpdater(parties[dest[0].Index], msg, errCh)
+			}
+		case save := <-endCh:
+			index, err := save.OriginalIndex()
+			assert.NoError(t, err)
+			saves[index] = save
+			ended++
+		}
+	}
+
+	// the shares x_i reconstruct the secret key of the common public key
+	shares := make(vss.Shares, len(saves))
+	for i, save := range saves {
+		assert.True(t, save.ECDSAPub.Equals(saves[0].ECDSAPub), "every party should have the same public key")
+		shares[i] = &vss.Share{Threshold: threshold, ID: save.ShareID, Share: save.Xi}
+	}
+	x, err := shares[:threshold+1].ReConstruct(tss.S256())
+	assert.NoError(t, err)
+	assert.True(t, crypto.ScalarBaseMult(tss.S256(), x).Equals(saves[0].ECDSAPub))
+}
+
+func TestE2EPedersenDKGWithheldFeldmanReveal(t *testing.T) {
+	setUp("info")
+	fixtures, pIDs, err := LoadKeygenTestFixtures(3)
+	if err != nil {
+		t.Skip("no test fixtures were found; run TestE2EConcurrentAndSaveFixtures first")
+	}
+	threshold := 1
+	p2pCtx := tss.NewPeerContext(pIDs)
+	parties := make([]*LocalParty, 0, len(pIDs))
+	errCh := make(chan *tss.Error, len(pIDs))
+	outCh := make(chan tss.Message, len(pIDs))
+	endCh := make(chan LocalPartySaveData, len(pIDs))
+	for i := 0; i < len(pIDs); i++ {
+		params := tss.NewParameters(tss.S256(), p2pCtx, pIDs[i], len(pIDs), threshold)
+		params.SetPedersenDKG(true)
+		P := NewLocalParty(params, outCh, endCh, fixtures[i].LocalPreParams).(*LocalParty)
+		parties = append(parties, P)
+		go func(P *LocalParty) {
+			if err := P.Start(); err != nil {
+				errCh <- err
+			}
+		}(P)
+	}
+
+	// P0 deals good shares but withholds the opening of its Feldman commitments once it is qualified, so its polynomial
+	// is rebuilt in public from the shares of P1 and P2
+	saves := make([]LocalPartySaveData, len(pIDs))
+	for ended := 0; ended < len(pIDs); {
+		select {
+		case err := <-errCh:
+			assert.FailNow(t, err.Error())
+		case msg := <-outCh:
+			from, dest := msg.GetFrom(), msg.GetTo()
+			if fmsg, ok := msg.(tss.ParsedMessage).Content().(*KGFeldmanMessage); ok && from.Index == 0 {
+				salt := fmsg.UnmarshalDeCommitment()[:1]
+				msg = NewKGFeldmanMessage(from, salt)
+			}
+			if dest == nil {
+				for _, P := range parties {
+					if P.PartyID().Index != from.Index {
+						go test.SharedPartyUpdater(P, msg, errCh)
+					}
+				}
+			} else {
+				go test.SharedPartyUpdater(parties[dest[0].Index], msg, errCh)
+			}
+		case save := <-endCh:
+			index, err := save.OriginalIndex()
+			assert.NoError(t, err)
+			saves[index] = save
+			ended++
+		}
+	}
+
+	// the key still includes the contribution of P0
+	pub := parties[0].temp.vs[0]
+	for _, P := range parties[1:] {
+		pub, err = pub.Add(P.temp.vs[0])
+		assert.NoError(t, err)
+	}
+	for _, save := range saves {
+		assert.True(t, save.ECDSAPub.Equals(pub), "the key should include the contribution of P0")
+		assert.Empty(t, save.DisqualifiedKs)
+		assert.Equal(t, pIDs.Keys(), save.Ks)
+	}
+}
+
+func TestE2ERobustKeygen(t *testing.T) {
+	setUp("info")
+	fixtures, pIDs, err := LoadKeygenTestFixtures(4)
+	if err != nil {
+		t.Skip("no test fixtures were found; run TestE2EConcurrentAndSaveFixtures first")
+	}
+	threshold := 1
+	p2pCtx := tss.NewPeerContext(pIDs)
+	parties := make([]*LocalParty, 0, len(pIDs))
+	errCh := make(chan *tss.Error, len(pIDs))
+	outCh := make(chan tss.Message, len(pIDs))
+	endCh := make(chan LocalPartySaveData, len(pIDs))
+	for i := 0; i < len(pIDs); i++ {
+		params := tss.NewParameters(tss.S256(), p2pCtx, pIDs[i], len(pIDs), threshold)
+		params.SetRobustKeygen(true)
+		P := NewLocalParty(params, outCh, endCh, fixtures[i].LocalPreParams).(*LocalParty)
+		parties = append(parties, P)
+		go func(P *LocalParty) {
+			if err := P.Start(); err != nil {
+				errCh <- err
+			}
+		}(P)
+	}
+	badShare := &vss.Share{Share: big.NewInt(42)}
+
+	// P0 deals a bad share to P1 and reveals it again after P1's complaint, so it is disqualified;
+	// P2's share to P3 is corrupted in transit, so P2 reveals the good share and stays in
+	saves := make([]LocalPartySaveData, len(pIDs))
+	for ended := 0; ended < len(pIDs)-1; {
+		select {
+		case err := <-errCh:
+			// the cheater's own view of the key differs from everyone else's
+			if err.Victim().Index == 0 {
+				continue
+			}
+			assert.FailNow(t, err.Error())
+		case msg := <-outCh:
+			from, dest := msg.GetFrom(), msg.GetTo()
+			switch content := msg.(tss.ParsedMessage).Content().(type) {
+			case *KGRound2Message1:
+				if (from.Index == 0 && dest[0].Index == 1) || (from.Index == 2 && dest[0].Index == 3) {
+					msg = NewKGRound2Message1(dest[0], from, badShare, nil)
+				}
+			case *KGRevealMessage:
+				if from.Index == 0 {
+					assert.Equal(t, []uint32{1}, content.GetComplainants())
+					msg = NewKGRevealMessage(from, []int{1}, vss.Shares{nil, badShare}, nil)
+				}
+				if from.Index == 2 {
+					assert.Equal(t, []uint32{3}, content.GetComplainants())
+				}
+			}
+			if dest == nil {
+				for _, P := range parties {
+					if P.PartyID().Index != from.Index {
+						go test.SharedPartyUpdater(P, msg, errCh)
+					}
+				}
+			} else {
+				go test.SharedPartyUpdater(parties[dest[0].Index], msg, errCh)
+			}
+		case save := <-endCh:
+			// the indexes into Ks no longer match the party indexes once the cheater is taken out
+			assert.NotEqual(t, 0, pIDs[0].KeyInt().Cmp(save.ShareID), "the cheater should not finish")
+			for j, Pj := range pIDs {
+				if Pj.KeyInt().Cmp(save.ShareID) == 0 {
+					saves[j] = save
+				}
+			}
+			ended++
+		}
+	}
+
+	// the key is shared by P1..P3 and made of their polynomials only
+	honest := saves[1:]
+	pub := parties[1].temp.vs[0]
+	for _, P := range parties[2:] {
+		pub, err = pub.Add(P.temp.vs[0])
+		assert.NoError(t, err)
+	}
+	for _, save := range honest {
+		assert.True(t, save.ECDSAPub.Equals(pub), "the key should leave out the disqualified party")
+		assert.Equal(t, []*big.Int{pIDs[0].KeyInt()}, save.DisqualifiedKs)
+		assert.Equal(t, pIDs[1:].Keys(), save.Ks, "the disqualified party should have no data in the key")
+		assert.Len(t, save.BigXj, len(pIDs)-1)
+		assert.Len(t, save.PaillierPKs, len(pIDs)-1)
+		assert.Len(t, save.NTildej, len(pIDs)-1)
+		assert.Panics(t, func() { BuildLocalSaveDataSubset(save, pIDs[:2]) }, "the disqualified party should not sign")
+	}
+	for _, pair := range [][]LocalPartySaveData{honest[:2], honest[1:]} {
+		shares := make(vss.Shares, len(pair))
+		for i, save := range pair {
+			shares[i] = &vss.Share{Threshold: threshold, ID: save.ShareID, Share: save.Xi}
+		}
+		x, err := shares.ReConstruct(tss.S256())
+		assert.NoError(t, err)
+		assert.True(t, crypto.ScalarBaseMult(tss.S256(), x).Equals(pub))
+	}
+}
+
+func TestE2ERobustKeygenTooFewLeft(t *testing.T) {
+	setUp("info")
+	threshold := 2
+	fixtures, pIDs, err := LoadKeygenTestFixtures(3)
+	if err != nil {
+		t.Skip("no test fixtures were found; run TestE2EConcurrentAndSaveFixtures first")
+	}
+	p2pCtx := tss.NewPeerContext(pIDs)
+	parties := make([]*LocalParty, 0, len(pIDs))
+	errCh := make(chan *tss.Error, len(pIDs))
+	outCh := make(chan tss.Message, len(pIDs))
+	endCh := make(chan LocalPartySaveData, len(pIDs))
+	for i := 0; i < len(pIDs); i++ {
+		params := tss.NewParameters(tss.S256(), p2pCtx, pIDs[i], len(pIDs), threshold)
+		params.SetRobustKeygen(true)
+		P := NewLocalParty(params, outCh, endCh, fixtures[i].LocalPreParams).(*LocalParty)
+		parties = append(parties, P)
+		go func(P *LocalParty) {
+			if err := P.Start(); err != nil {
+				errCh <- err
+			}
+		}(P)
+	}
+	badShare := &vss.Share{Share: big.NewInt(42)}
+
+	// P0 is disqualified, which leaves two parties to hold a key that needs three to sign
+	for failed := 0; failed < len(pIDs)-1; {
+		select {
+		case err := <-errCh:
+			if err.Victim().Index == 0 {
+				continue
+			}
+			assert.Equal(t, []*tss.PartyID{pIDs[0]}, err.Culprits())
+			failed++
+		case msg := <-outCh:
+			from, dest := msg.GetFrom(), msg.GetTo()
+			switch msg.(tss.ParsedMessage).Content().(type) {
+			case *KGRound2Message1:
+				if from.Index == 0 && dest[0].Index == 1 {
+					msg = NewKGRound2Message1(dest[0], from, badShare, nil)
+				}
+			case *KGRevealMessage:
+				if from.Index == 0 {
+					msg = NewKGRevealMessage(from, []int{1}, vss.Shares{nil, badShare}, nil)
+				}
+			}
+			if dest == nil {
+				for _, P := range parties {
+					if P.PartyID().Index != from.Index {
+						go test.SharedPartyUpdater(P, msg, errCh)
+					}
+				}
+			} else {
+				go test.SharedPartyUpdater(parties[dest[0].Index], msg, errCh)
+			}
+		case save := <-endCh:
+			// the cheater's own view of the key differs from everyone else's
+			if pIDs[0].KeyInt().Cmp(save.ShareID) != 0 {
+				assert.FailNow(t, "only the cheater should end")
+			}
+		}
+	}
+}
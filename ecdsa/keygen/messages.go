@@ -7,9 +7,11 @@
 package keygen
 
 import (
+	"crypto/elliptic"
 	"math/big"
 
 	"github.com/bnb-chain/tss-lib/common"
+	"github.com/bnb-chain/tss-lib/crypto"
 	cmt "github.com/bnb-chain/tss-lib/crypto/commitments"
 	"github.com/bnb-chain/tss-lib/crypto/dlnproof"
 	"github.com/bnb-chain/tss-lib/crypto/paillier"
@@ -27,6 +29,11 @@ var (
 		(*KGRound2Message1)(nil),
 		(*KGRound2Message2)(nil),
 		(*KGRound3Message)(nil),
+		(*KGComplaintMessage)(nil),
+		(*KGRevealMessage)(nil),
+		(*KGFeldmanMessage)(nil),
+		(*KGFeldmanComplaintMessage)(nil),
+		(*KGReconstructMessage)(nil),
 	}
 )
 
@@ -38,6 +45,8 @@ func NewKGRound1Message(
 	paillierPK *paillier.PublicKey,
 	nTildeI, h1I, h2I *big.Int,
 	dlnProof1, dlnProof2 *dlnproof.Proof,
+	chainCodeCt cmt.HashCommitment,
+	pedersenCmts vss.Vs,
 ) (tss.ParsedMessage, error) {
 	meta := tss.MessageRouting{
 		From:        from,
@@ -51,14 +60,24 @@ func NewKGRound1Message(
 	if err != nil {
 		return nil, err
 	}
+	var pedersenCmtsBzs [][]byte
+	if pedersenCmts != nil {
+		flat, err := crypto.FlattenECPoints(pedersenCmts)
+		if err != nil {
+			return nil, err
+		}
+		pedersenCmtsBzs = common.BigIntsToBytes(flat)
+	}
 	content := &KGRound1Message{
-		Commitment: ct.Bytes(),
-		PaillierN:  paillierPK.N.Bytes(),
-		NTilde:     nTildeI.Bytes(),
-		H1:         h1I.Bytes(),
-		H2:         h2I.Bytes(),
-		Dlnproof_1: dlnProof1Bz,
-		Dlnproof_2: dlnProof2Bz,
+		Commitment:          ct.Bytes(),
+		PaillierN:           paillierPK.N.Bytes(),
+		NTilde:              nTildeI.Bytes(),
+		H1:                  h1I.Bytes(),
+		H2:                  h2I.Bytes(),
+		Dlnproof_1:          dlnProof1Bz,
+		Dlnproof_2:          dlnProof2Bz,
+		ChainCodeCommitment: chainCodeCt.Bytes(),
+		PedersenCommitments: pedersenCmtsBzs,
 	}
 	msg := tss.NewMessageWrapper(meta, content)
 	return tss.NewMessage(meta, content, msg), nil
@@ -73,13 +92,23 @@ func (m *KGRound1Message) ValidateBasic() bool {
 		common.NonEmptyBytes(m.GetH2()) &&
 		// expected len of dln proof = sizeof(int64) + len(alpha) + len(t)
 		common.NonEmptyMultiBytes(m.GetDlnproof_1(), 2+(dlnproof.Iterations*2)) &&
-		common.NonEmptyMultiBytes(m.GetDlnproof_2(), 2+(dlnproof.Iterations*2))
+		common.NonEmptyMultiBytes(m.GetDlnproof_2(), 2+(dlnproof.Iterations*2)) &&
+		common.NonEmptyBytes(m.GetChainCodeCommitment())
 }
 
 func (m *KGRound1Message) UnmarshalCommitment() *big.Int {
 	return new(big.Int).SetBytes(m.GetCommitment())
 }
 
+func (m *KGRound1Message) UnmarshalChainCodeCommitment() *big.Int {
+	return new(big.Int).SetBytes(m.GetChainCodeCommitment())
+}
+
+// UnmarshalPedersenCommitments returns the Pedersen VSS commitments sent when the Pedersen DKG is used
+func (m *KGRound1Message) UnmarshalPedersenCommitments(ec elliptic.Curve) (vss.Vs, error) {
+	return crypto.UnFlattenECPoints(ec, common.MultiBytesToBigInts(m.GetPedersenCommitments()))
+}
+
 func (m *KGRound1Message) UnmarshalPaillierPK() *paillier.PublicKey {
 	return &paillier.PublicKey{N: new(big.Int).SetBytes(m.GetPaillierN())}
 }
@@ -108,7 +137,7 @@ func (m *KGRound1Message) UnmarshalDLNProof2() (*dlnproof.Proof, error) {
 
 func NewKGRound2Message1(
 	to, from *tss.PartyID,
-	share *vss.Share,
+	share, blinding *vss.Share,
 ) tss.ParsedMessage {
 	meta := tss.MessageRouting{
 		From:        from,
@@ -118,6 +147,9 @@ func NewKGRound2Message1(
 	content := &KGRound2Message1{
 		Share: share.Share.Bytes(),
 	}
+	if blinding != nil {
+		content.BlindingShare = blinding.Share.Bytes()
+	}
 	msg := tss.NewMessageWrapper(meta, content)
 	return tss.NewMessage(meta, content, msg)
 }
@@ -131,11 +163,16 @@ func (m *KGRound2Message1) UnmarshalShare() *big.Int {
 	return new(big.Int).SetBytes(m.Share)
 }
 
+func (m *KGRound2Message1) UnmarshalBlindingShare() *big.Int {
+	return new(big.Int).SetBytes(m.GetBlindingShare())
+}
+
 // ----- //
 
 func NewKGRound2Message2(
 	from *tss.PartyID,
 	deCommitment cmt.HashDeCommitment,
+	chainCodeDeCommitment cmt.HashDeCommitment,
 ) tss.ParsedMessage {
 	meta := tss.MessageRouting{
 		From:        from,
@@ -143,15 +180,18 @@ func NewKGRound2Message2(
 	}
 	dcBzs := common.BigIntsToBytes(deCommitment)
 	content := &KGRound2Message2{
-		DeCommitment: dcBzs,
+		DeCommitment:          dcBzs,
+		ChainCodeDeCommitment: common.BigIntsToBytes(chainCodeDeCommitment),
 	}
 	msg := tss.NewMessageWrapper(meta, content)
 	return tss.NewMessage(meta, content, msg)
 }
 
 func (m *KGRound2Message2) ValidateBasic() bool {
+	// with the Pedersen DKG, the Feldman commitments are opened later in a KGFeldmanMessage
 	return m != nil &&
-		common.NonEmptyMultiBytes(m.GetDeCommitment())
+		(len(m.GetDeCommitment()) == 0 || common.NonEmptyMultiBytes(m.GetDeCommitment())) &&
+		common.NonEmptyMultiBytes(m.GetChainCodeDeCommitment(), 2)
 }
 
 func (m *KGRound2Message2) UnmarshalDeCommitment() []*big.Int {
@@ -159,6 +199,10 @@ func (m *KGRound2Message2) UnmarshalDeCommitment() []*big.Int {
 	return cmt.NewHashDeCommitmentFromBytes(deComBzs)
 }
 
+func (m *KGRound2Message2) UnmarshalChainCodeDeCommitment() []*big.Int {
+	return cmt.NewHashDeCommitmentFromBytes(m.GetChainCodeDeCommitment())
+}
+
 // ----- //
 
 func NewKGRound3Message(
@@ -196,3 +240,196 @@ func (m *KGRound3Message) UnmarshalProofInts() paillier.Proof {
 	}
 	return pf
 }
+
+// ----- //
+
+func NewKGComplaintMessage(
+	from *tss.PartyID,
+	accused []int,
+) tss.ParsedMessage {
+	meta := tss.MessageRouting{
+		From:        from,
+		IsBroadcast: true,
+	}
+	content := &KGComplaintMessage{
+		Accused: make([]uint32, len(accused)),
+	}
+	for i, j := range accused {
+		content.Accused[i] = uint32(j)
+	}
+	msg := tss.NewMessageWrapper(meta, content)
+	return tss.NewMessage(meta, content, msg)
+}
+
+func (m *KGComplaintMessage) ValidateBasic() bool {
+	// a party without complaints sends an empty list
+	return m != nil
+}
+
+// UnmarshalAccused returns the indexes of the dealers accused by the sender
+func (m *KGComplaintMessage) UnmarshalAccused() []int {
+	accused := make([]int, len(m.GetAccused()))
+	for i, j := range m.GetAccused() {
+		accused[i] = int(j)
+	}
+	return accused
+}
+
+// ----- //
+
+// NewKGRevealMessage reveals shares[k] (and blindings[k] when the Pedersen DKG is used) for every complainant k
+func NewKGRevealMessage(
+	from *tss.PartyID,
+	complainants []int,
+	shares, blindings vss.Shares,
+) tss.ParsedMessage {
+	meta := tss.MessageRouting{
+		From:        from,
+		IsBroadcast: true,
+	}
+	content := &KGRevealMessage{
+		Complainants: make([]uint32, len(complainants)),
+		Shares:       make([][]byte, len(complainants)),
+	}
+	if blindings != nil {
+		content.BlindingShares = make([][]byte, len(complainants))
+	}
+	for i, k := range complainants {
+		content.Complainants[i] = uint32(k)
+		content.Shares[i] = shares[k].Share.Bytes()
+		if blindings != nil {
+			content.BlindingShares[i] = blindings[k].Share.Bytes()
+		}
+	}
+	msg := tss.NewMessageWrapper(meta, content)
+	return tss.NewMessage(meta, content, msg)
+}
+
+func (m *KGRevealMessage) ValidateBasic() bool {
+	return m != nil &&
+		len(m.GetShares()) == len(m.GetComplainants()) &&
+		(len(m.GetBlindingShares()) == 0 || len(m.GetBlindingShares()) == len(m.GetComplainants()))
+}
+
+// UnmarshalRevealedShare returns the share that the sender revealed for complainant k and its blinding share, which is
+// nil unless the Pedersen DKG is used. The share is nil if none was revealed for k.
+func (m *KGRevealMessage) UnmarshalRevealedShare(k int) (share, blinding *big.Int) {
+	for i, c := range m.GetComplainants() {
+		if int(c) != k {
+			continue
+		}
+		share = new(big.Int).SetBytes(m.GetShares()[i])
+		if bzs := m.GetBlindingShares(); len(bzs) > 0 {
+			blinding = new(big.Int).SetBytes(bzs[i])
+		}
+		return
+	}
+	return nil, nil
+}
+
+// ----- //
+
+// NewKGFeldmanMessage opens the Feldman commitments of the sender once the dealers qualified by the Pedersen DKG are known
+func NewKGFeldmanMessage(
+	from *tss.PartyID,
+	deCommitment cmt.HashDeCommitment,
+) tss.ParsedMessage {
+	meta := tss.MessageRouting{
+		From:        from,
+		IsBroadcast: true,
+	}
+	content := &KGFeldmanMessage{
+		DeCommitment: common.BigIntsToBytes(deCommitment),
+	}
+	msg := tss.NewMessageWrapper(meta, content)
+	return tss.NewMessage(meta, content, msg)
+}
+
+func (m *KGFeldmanMessage) ValidateBasic() bool {
+	// a dealer that does not open its Feldman commitments has its polynomial rebuilt from its shares instead
+	return m != nil
+}
+
+func (m *KGFeldmanMessage) UnmarshalDeCommitment() []*big.Int {
+	return cmt.NewHashDeCommitmentFromBytes(m.GetDeCommitment())
+}
+
+// ----- //
+
+// NewKGFeldmanComplaintMessage reveals shares[k] and blindings[k], dealt to the sender by dealers[k], for every k
+func NewKGFeldmanComplaintMessage(
+	from *tss.PartyID,
+	dealers []int,
+	shares, blindings []*big.Int,
+) tss.ParsedMessage {
+	meta := tss.MessageRouting{
+		From:        from,
+		IsBroadcast: true,
+	}
+	content := &KGFeldmanComplaintMessage{}
+	content.Dealers, content.Shares, content.BlindingShares = marshalDealtShares(dealers, shares, blindings)
+	msg := tss.NewMessageWrapper(meta, content)
+	return tss.NewMessage(meta, content, msg)
+}
+
+func (m *KGFeldmanComplaintMessage) ValidateBasic() bool {
+	return m != nil &&
+		len(m.GetShares()) == len(m.GetDealers()) &&
+		len(m.GetBlindingShares()) == len(m.GetDealers())
+}
+
+// UnmarshalDealtShare returns the share that dealer j dealt to the sender and its blinding share, which are nil if the
+// sender did not reveal them
+func (m *KGFeldmanComplaintMessage) UnmarshalDealtShare(j int) (share, blinding *big.Int) {
+	return unmarshalDealtShare(m.GetDealers(), m.GetShares(), m.GetBlindingShares(), j)
+}
+
+// ----- //
+
+// NewKGReconstructMessage reveals shares[k] and blindings[k], dealt to the sender by dealers[k], for every k
+func NewKGReconstructMessage(
+	from *tss.PartyID,
+	dealers []int,
+	shares, blindings []*big.Int,
+) tss.ParsedMessage {
+	meta := tss.MessageRouting{
+		From:        from,
+		IsBroadcast: true,
+	}
+	content := &KGReconstructMessage{}
+	content.Dealers, content.Shares, content.BlindingShares = marshalDealtShares(dealers, shares, blindings)
+	msg := tss.NewMessageWrapper(meta, content)
+	return tss.NewMessage(meta, content, msg)
+}
+
+func (m *KGReconstructMessage) ValidateBasic() bool {
+	return m != nil &&
+		len(m.GetShares()) == len(m.GetDealers()) &&
+		len(m.GetBlindingShares()) == len(m.GetDealers())
+}
+
+// UnmarshalDealtShare returns the share that dealer j dealt to the sender and its blinding share, which are nil if the
+// sender did not reveal them
+func (m *KGReconstructMessage) UnmarshalDealtShare(j int) (share, blinding *big.Int) {
+	return unmarshalDealtShare(m.GetDealers(), m.GetShares(), m.GetBlindingShares(), j)
+}
+
+// ----- //
+
+func marshalDealtShares(dealers []int, shares, blindings []*big.Int) ([]uint32, [][]byte, [][]byte) {
+	js := make([]uint32, len(dealers))
+	for k, j := range dealers {
+		js[k] = uint32(j)
+	}
+	return js, common.BigIntsToBytes(shares), common.BigIntsToBytes(blindings)
+}
+
+func unmarshalDealtShare(dealers []uint32, shares, blindings [][]byte, j int) (share, blinding *big.Int) {
+	for k, dealer := range dealers {
+		if int(dealer) != j {
+			continue
+		}
+		return new(big.Int).SetBytes(shares[k]), new(big.Int).SetBytes(blindings[k])
+	}
+	return nil, nil
+}
@@ -0,0 +1,334 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package keygen
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	errors2 "github.com/pkg/errors"
+
+	"github.com/bnb-chain/tss-lib/common"
+)
+
+const (
+	// PreParamsPoolKeyLen is the length of the AES-256 key used to encrypt pool entries at rest
+	PreParamsPoolKeyLen = 32
+
+	preParamsPoolFileExt = ".preparams"
+	// timeout applied to each background generation attempt unless changed with SetGenerationTimeout
+	defaultPreParamsPoolGenTimeout = 10 * time.Minute
+	// back-off applied after a failed background generation attempt
+	preParamsPoolRetryInterval = 10 * time.Second
+)
+
+type (
+	// PreParamsPool generates LocalPreParams in the background up to a target size and hands out each entry exactly once.
+	// When a directory is given, entries are persisted there encrypted with AES-256-GCM so that they survive restarts.
+	// An entry is deleted from disk before it is handed out, so it can never be used by two ceremonies.
+	PreParamsPool struct {
+		dir         string
+		aead        cipher.AEAD
+		size        int
+		concurrency int
+		timeout     time.Duration
+		generate    func(ctx context.Context, concurrency int) (*LocalPreParams, error)
+
+		mtx     sync.Mutex
+		entries []*preParamsPoolEntry
+		changed chan struct{} // closed and replaced whenever entries are added or removed
+		cancel  context.CancelFunc
+		done    chan struct{}
+	}
+
+	preParamsPoolEntry struct {
+		path      string // empty when the pool is not persistent
+		preParams LocalPreParams
+	}
+)
+
+// NewPreParamsPool creates a pool that keeps up to `size` pre-params ready for use.
+// If `dir` is non-empty, entries are persisted there encrypted with `key`, which must be 32 bytes long, and any entries
+// left in `dir` by a previous pool are loaded. If `dir` is empty the pool lives in memory only and `key` is ignored.
+// If not specified, a concurrency value equal to the number of available CPU cores will be used for generation.
+// Call Start() to begin filling the pool in the background.
+func NewPreParamsPool(dir string, key []byte, size int, optionalConcurrency ...int) (*PreParamsPool, error) {
+	if size < 1 {
+		return nil, errors.New("NewPreParamsPool: size must be at least 1")
+	}
+	var concurrency int
+	if 0 < len(optionalConcurrency) {
+		if 1 < len(optionalConcurrency) {
+			panic(errors.New("NewPreParamsPool: expected 0 or 1 item in `optionalConcurrency`"))
+		}
+		concurrency = optionalConcurrency[0]
+	} else {
+		concurrency = runtime.NumCPU()
+	}
+	pool := &PreParamsPool{
+		dir:         dir,
+		size:        size,
+		concurrency: concurrency,
+		timeout:     defaultPreParamsPoolGenTimeout,
+		generate: func(ctx context.Context, concurrency int) (*LocalPreParams, error) {
+			return GeneratePreParamsWithContext(ctx, concurrency)
+		},
+		changed: make(chan struct{}),
+	}
+	if dir == "" {
+		return pool, nil
+	}
+	if len(key) != PreParamsPoolKeyLen {
+		return nil, fmt.Errorf("NewPreParamsPool: the encryption key must be %d bytes", PreParamsPoolKeyLen)
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	if pool.aead, err = cipher.NewGCM(block); err != nil {
+		return nil, err
+	}
+	if err = os.MkdirAll(dir, 0700); err != nil {
+		return nil, errors2.Wrapf(err, "NewPreParamsPool: unable to create the pool directory %s", dir)
+	}
+	if err = pool.load(); err != nil {
+		return nil, err
+	}
+	return pool, nil
+}
+
+// SetGenerationTimeout sets the timeout applied to each background generation attempt.
+func (pool *PreParamsPool) SetGenerationTimeout(timeout time.Duration) {
+	pool.mtx.Lock()
+	defer pool.mtx.Unlock()
+	pool.timeout = timeout
+}
+
+// Start begins generating pre-params in the background until the pool holds its target size.
+// Generation resumes whenever entries are taken. Calling Start on a running pool has no effect.
+func (pool *PreParamsPool) Start() {
+	pool.mtx.Lock()
+	defer pool.mtx.Unlock()
+	if pool.cancel != nil {
+		return
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	pool.cancel, pool.done = cancel, make(chan struct{})
+	go pool.fill(ctx, pool.done)
+}
+
+// Stop halts background generation and waits for the generator to exit. Entries already in the pool are kept.
+func (pool *PreParamsPool) Stop() {
+	pool.mtx.Lock()
+	cancel, done := pool.cancel, pool.done
+	pool.cancel, pool.done = nil, nil
+	pool.mtx.Unlock()
+	if cancel == nil {
+		return
+	}
+	cancel()
+	<-done
+}
+
+// Len returns the number of entries ready to be taken.
+func (pool *PreParamsPool) Len() int {
+	pool.mtx.Lock()
+	defer pool.mtx.Unlock()
+	return len(pool.entries)
+}
+
+// Size returns the target number of entries the pool keeps ready.
+func (pool *PreParamsPool) Size() int {
+	return pool.size
+}
+
+// Put adds externally generated pre-params to the pool, persisting them if the pool is persistent.
+func (pool *PreParamsPool) Put(preParams LocalPreParams) error {
+	if !preParams.ValidateWithProof() {
+		return errors.New("PreParamsPool.Put: the pre-params failed to validate")
+	}
+	entry := &preParamsPoolEntry{preParams: preParams}
+	if pool.dir != "" {
+		path, err := pool.persist(preParams)
+		if err != nil {
+			return err
+		}
+		entry.path = path
+	}
+	pool.mtx.Lock()
+	defer pool.mtx.Unlock()
+	pool.entries = append(pool.entries, entry)
+	pool.notifyLocked()
+	return nil
+}
+
+// TryTake removes and returns an entry if one is ready, without blocking.
+func (pool *PreParamsPool) TryTake() (*LocalPreParams, bool, error) {
+	pool.mtx.Lock()
+	defer pool.mtx.Unlock()
+	if len(pool.entries) == 0 {
+		return nil, false, nil
+	}
+	preParams, err := pool.popLocked()
+	if err != nil {
+		return nil, false, err
+	}
+	return preParams, true, nil
+}
+
+// Take removes and returns an entry, waiting for one to be generated if the pool is empty.
+// An error is returned if the context is done before an entry becomes available.
+func (pool *PreParamsPool) Take(ctx context.Context) (*LocalPreParams, error) {
+	for {
+		pool.mtx.Lock()
+		if 0 < len(pool.entries) {
+			preParams, err := pool.popLocked()
+			pool.mtx.Unlock()
+			return preParams, err
+		}
+		changed := pool.changed
+		pool.mtx.Unlock()
+		select {
+		case <-ctx.Done():
+			return nil, errors2.Wrap(ctx.Err(), "PreParamsPool.Take: no pre-params became available")
+		case <-changed:
+		}
+	}
+}
+
+// ----- //
+
+// fill is the background generator loop; it sleeps while the pool is full
+func (pool *PreParamsPool) fill(ctx context.Context, done chan<- struct{}) {
+	defer close(done)
+	for {
+		pool.mtx.Lock()
+		full, changed, timeout := pool.size <= len(pool.entries), pool.changed, pool.timeout
+		pool.mtx.Unlock()
+		if full {
+			select {
+			case <-ctx.Done():
+				return
+			case <-changed:
+				continue
+			}
+		}
+		genCtx, cancel := context.WithTimeout(ctx, timeout)
+		preParams, err := pool.generate(genCtx, pool.concurrency)
+		cancel()
+		if err == nil {
+			err = pool.Put(*preParams)
+		}
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			common.Logger.Errorf("pre-params pool: background generation failed: %v", err)
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(preParamsPoolRetryInterval):
+			}
+		}
+	}
+}
+
+// popLocked removes the oldest entry. The file is deleted first so that an entry is never handed out twice.
+func (pool *PreParamsPool) popLocked() (*LocalPreParams, error) {
+	entry := pool.entries[0]
+	if entry.path != "" {
+		if err := os.Remove(entry.path); err != nil && !os.IsNotExist(err) {
+			return nil, errors2.Wrapf(err, "PreParamsPool: unable to remove the pool entry %s", entry.path)
+		}
+	}
+	pool.entries[0] = nil
+	pool.entries = pool.entries[1:]
+	pool.notifyLocked()
+	return &entry.preParams, nil
+}
+
+func (pool *PreParamsPool) notifyLocked() {
+	close(pool.changed)
+	pool.changed = make(chan struct{})
+}
+
+func (pool *PreParamsPool) persist(preParams LocalPreParams) (string, error) {
+	plain, err := json.Marshal(&preParams)
+	if err != nil {
+		return "", err
+	}
+	nonce := make([]byte, pool.aead.NonceSize())
+	if _, err = io.ReadFull(rand.Reader, nonce); err != nil {
+		return "", err
+	}
+	sealed := pool.aead.Seal(nonce, nonce, plain, nil)
+
+	// the name sorts by creation time so that entries are handed out oldest first after a reload
+	name := fmt.Sprintf("%020d-%s%s", time.Now().UnixNano(), hex.EncodeToString(nonce[:4]), preParamsPoolFileExt)
+	path := filepath.Join(pool.dir, name)
+	tmp := path + ".tmp"
+	if err = ioutil.WriteFile(tmp, sealed, 0600); err != nil {
+		return "", errors2.Wrapf(err, "PreParamsPool: unable to write the pool entry %s", tmp)
+	}
+	if err = os.Rename(tmp, path); err != nil {
+		return "", errors2.Wrapf(err, "PreParamsPool: unable to write the pool entry %s", path)
+	}
+	return path, nil
+}
+
+func (pool *PreParamsPool) load() error {
+	files, err := ioutil.ReadDir(pool.dir)
+	if err != nil {
+		return errors2.Wrapf(err, "PreParamsPool: unable to read the pool directory %s", pool.dir)
+	}
+	names := make([]string, 0, len(files))
+	for _, fi := range files {
+		if !fi.IsDir() && strings.HasSuffix(fi.Name(), preParamsPoolFileExt) {
+			names = append(names, fi.Name())
+		}
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		path := filepath.Join(pool.dir, name)
+		sealed, err := ioutil.ReadFile(path)
+		if err != nil {
+			return errors2.Wrapf(err, "PreParamsPool: unable to read the pool entry %s", path)
+		}
+		nonceSize := pool.aead.NonceSize()
+		if len(sealed) < nonceSize {
+			return fmt.Errorf("PreParamsPool: the pool entry %s is truncated", path)
+		}
+		plain, err := pool.aead.Open(nil, sealed[:nonceSize], sealed[nonceSize:], nil)
+		if err != nil {
+			return errors2.Wrapf(err, "PreParamsPool: unable to decrypt the pool entry %s; is the key correct?", path)
+		}
+		var preParams LocalPreParams
+		if err = json.Unmarshal(plain, &preParams); err != nil {
+			return errors2.Wrapf(err, "PreParamsPool: unable to unmarshal the pool entry %s", path)
+		}
+		if !preParams.ValidateWithProof() {
+			return fmt.Errorf("PreParamsPool: the pool entry %s failed to validate", path)
+		}
+		pool.entries = append(pool.entries, &preParamsPoolEntry{path: path, preParams: preParams})
+	}
+	return nil
+}
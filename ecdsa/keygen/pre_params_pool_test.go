@@ -0,0 +1,147 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package keygen
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bnb-chain/tss-lib/tss"
+)
+
+func loadTestPreParams(t *testing.T, qty int) []LocalPreParams {
+	fixtures, _, err := LoadKeygenTestFixtures(qty)
+	if err != nil {
+		t.Skip("no test fixtures were found; run the keygen tests first")
+	}
+	preParams := make([]LocalPreParams, 0, qty)
+	for _, fixture := range fixtures {
+		preParams = append(preParams, fixture.LocalPreParams)
+	}
+	return preParams
+}
+
+func testPoolKey(b byte) []byte {
+	key := make([]byte, PreParamsPoolKeyLen)
+	for i := range key {
+		key[i] = b
+	}
+	return key
+}
+
+func TestPreParamsPoolPersistence(t *testing.T) {
+	preParams := loadTestPreParams(t, 2)
+	dir, err := ioutil.TempDir("", "tss-preparams-pool")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	pool, err := NewPreParamsPool(dir, testPoolKey(1), 2)
+	assert.NoError(t, err)
+	for _, pp := range preParams {
+		assert.NoError(t, pool.Put(pp))
+	}
+	files, _ := ioutil.ReadDir(dir)
+	assert.Equal(t, 2, len(files))
+
+	// entries are encrypted at rest
+	bz, err := ioutil.ReadFile(dir + "/" + files[0].Name())
+	assert.NoError(t, err)
+	assert.NotContains(t, string(bz), preParams[0].NTildei.String())
+
+	// a wrong key must not be able to load the pool
+	_, err = NewPreParamsPool(dir, testPoolKey(2), 2)
+	assert.Error(t, err)
+
+	// a new pool over the same directory picks up the entries oldest first
+	reloaded, err := NewPreParamsPool(dir, testPoolKey(1), 2)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, reloaded.Len())
+	taken, ok, err := reloaded.TryTake()
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, 0, preParams[0].NTildei.Cmp(taken.NTildei))
+	assert.True(t, taken.ValidateWithProof())
+
+	// each entry is handed out exactly once, including across reloads
+	files, _ = ioutil.ReadDir(dir)
+	assert.Equal(t, 1, len(files))
+	reloaded, err = NewPreParamsPool(dir, testPoolKey(1), 2)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, reloaded.Len())
+	taken, err = reloaded.Take(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 0, preParams[1].NTildei.Cmp(taken.NTildei))
+	_, ok, err = reloaded.TryTake()
+	assert.NoError(t, err)
+	assert.False(t, ok)
+}
+
+func TestPreParamsPoolBackgroundFill(t *testing.T) {
+	preParams := loadTestPreParams(t, 4)
+	pool, err := NewPreParamsPool("", nil, 2)
+	assert.NoError(t, err)
+	next := make(chan LocalPreParams, len(preParams))
+	for _, pp := range preParams {
+		next <- pp
+	}
+	pool.generate = func(ctx context.Context, _ int) (*LocalPreParams, error) {
+		select {
+		case pp := <-next:
+			return &pp, nil
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	pool.Start()
+	defer pool.Stop()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	for i := 0; i < len(preParams); i++ {
+		taken, err := pool.Take(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, 0, preParams[i].NTildei.Cmp(taken.NTildei))
+	}
+	// the generator stops at the target size
+	assert.True(t, pool.Len() <= pool.Size())
+}
+
+func TestPreParamsPoolTakeTimeout(t *testing.T) {
+	pool, err := NewPreParamsPool("", nil, 1)
+	assert.NoError(t, err)
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err = pool.Take(ctx)
+	assert.Error(t, err)
+}
+
+func TestStartRound1WithPreParamsPool(t *testing.T) {
+	setUp("info")
+	preParams := loadTestPreParams(t, 1)
+	pool, err := NewPreParamsPool("", nil, 1)
+	assert.NoError(t, err)
+	assert.NoError(t, pool.Put(preParams[0]))
+
+	pIDs := tss.GenerateTestPartyIDs(1)
+	p2pCtx := tss.NewPeerContext(pIDs)
+	params := tss.NewParameters(tss.EC(), p2pCtx, pIDs[0], len(pIDs), 1)
+	out := make(chan tss.Message, len(pIDs))
+	lp := NewLocalPartyWithPreParamsPool(params, pool, out, nil).(*LocalParty)
+	if err := lp.Start(); err != nil {
+		assert.FailNow(t, err.Error())
+	}
+	<-out
+
+	assert.Equal(t, 0, pool.Len(), "the pre-params should have been taken from the pool")
+	assert.Equal(t, 0, preParams[0].NTildei.Cmp(lp.data.NTildej[0]))
+	assert.Equal(t, 0, preParams[0].PaillierSK.N.Cmp(lp.data.PaillierPKs[0].N))
+}
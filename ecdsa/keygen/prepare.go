@@ -8,7 +8,9 @@ package keygen
 
 import (
 	"context"
+	"crypto/rand"
 	"errors"
+	"io"
 	"math/big"
 	"runtime"
 	"time"
@@ -41,6 +43,13 @@ func GeneratePreParams(timeout time.Duration, optionalConcurrency ...int) (*Loca
 // If not specified, a concurrency value equal to the number of available CPU cores will be used.
 // If pre-parameters could not be generated before the context is done, an error is returned.
 func GeneratePreParamsWithContext(ctx context.Context, optionalConcurrency ...int) (*LocalPreParams, error) {
+	return GeneratePreParamsWithContextAndRandom(ctx, rand.Reader, optionalConcurrency...)
+}
+
+// GeneratePreParamsWithContextAndRandom is like GeneratePreParamsWithContext but draws its randomness from `rand`.
+// With a deterministic `rand` the result is reproducible only with a concurrency of 1, which runs each prime search on
+// a single goroutine.
+func GeneratePreParamsWithContextAndRandom(ctx context.Context, rand io.Reader, optionalConcurrency ...int) (*LocalPreParams, error) {
 	var concurrency int
 	if 0 < len(optionalConcurrency) {
 		if 1 < len(optionalConcurrency) {
@@ -50,8 +59,19 @@ func GeneratePreParamsWithContext(ctx context.Context, optionalConcurrency ...in
 	} else {
 		concurrency = runtime.NumCPU()
 	}
-	if concurrency /= 3; concurrency < 1 {
-		concurrency = 1
+	// more concurrency weight is assigned to the Paillier modulus because its primes have a requirement of having "large" P-Q
+	paiConcurrency := 1
+	if 1 < concurrency {
+		if concurrency /= 3; concurrency < 1 {
+			concurrency = 1
+		}
+		paiConcurrency = concurrency * 2
+	}
+
+	// the Paillier and safe prime generators run concurrently, so each gets its own fork of `rand`
+	forks, err := common.ForkRandom(rand, 2)
+	if err != nil {
+		return nil, err
 	}
 
 	// prepare for concurrent Paillier and safe prime generation
@@ -62,8 +82,7 @@ func GeneratePreParamsWithContext(ctx context.Context, optionalConcurrency ...in
 	go func(ch chan<- *paillier.PrivateKey) {
 		common.Logger.Info("generating the Paillier modulus, please wait...")
 		start := time.Now()
-		// more concurrency weight is assigned here because the paillier primes have a requirement of having "large" P-Q
-		PiPaillierSk, _, err := paillier.GenerateKeyPair(ctx, paillierModulusLen, concurrency*2)
+		PiPaillierSk, _, err := paillier.GenerateKeyPairWithRandom(ctx, forks[0], paillierModulusLen, paiConcurrency)
 		if err != nil {
 			ch <- nil
 			return
@@ -77,7 +96,7 @@ func GeneratePreParamsWithContext(ctx context.Context, optionalConcurrency ...in
 		var err error
 		common.Logger.Info("generating the safe primes for the signing proofs, please wait...")
 		start := time.Now()
-		sgps, err := common.GetRandomSafePrimesConcurrent(ctx, safePrimeBitLen, 2, concurrency)
+		sgps, err := common.GetRandomSafePrimesConcurrentWithRandom(ctx, safePrimeBitLen, 2, concurrency, forks[1])
 		if err != nil {
 			ch <- nil
 			return
@@ -124,8 +143,8 @@ consumer:
 
 	p, q := sgps[0].Prime(), sgps[1].Prime()
 	modPQ := common.ModInt(new(big.Int).Mul(p, q))
-	f1 := common.GetRandomPositiveRelativelyPrimeInt(NTildei)
-	alpha := common.GetRandomPositiveRelativelyPrimeInt(NTildei)
+	f1 := common.GetRandomPositiveRelativelyPrimeIntWithRandom(rand, NTildei)
+	alpha := common.GetRandomPositiveRelativelyPrimeIntWithRandom(rand, NTildei)
 	beta := modPQ.ModInverse(alpha)
 	h1i := modNTildeI.Mul(f1, f1)
 	h2i := modNTildeI.Exp(h1i, alpha)
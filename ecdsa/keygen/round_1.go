@@ -7,6 +7,7 @@
 package keygen
 
 import (
+	"context"
 	"errors"
 	"math/big"
 
@@ -40,13 +41,20 @@ func (round *round1) Start() *tss.Error {
 	i := Pi.Index
 
 	// 1. calculate "partial" key share ui
-	ui := common.GetRandomPositiveInt(round.Params().EC().Params().N)
+	ui := common.GetRandomPositiveIntWithRandom(round.Rand(), round.Params().EC().Params().N)
 
 	round.temp.ui = ui
 
-	// 2. compute the vss shares
+	// 2. compute the vss shares; the Pedersen DKG also commits to them with hiding Pedersen commitments
 	ids := round.Parties().IDs().Keys()
-	vs, shares, err := vss.Create(round.Params().EC(), round.Threshold(), ui, ids)
+	var vs, pedersenCmts vss.Vs
+	var shares, blindingShares vss.Shares
+	var err error
+	if round.PedersenDKG() {
+		vs, pedersenCmts, shares, blindingShares, err = vss.CreatePedersen(round.Params().EC(), round.Threshold(), ui, ids, round.Rand())
+	} else {
+		vs, shares, err = vss.CreateWithRandom(round.Params().EC(), round.Threshold(), ui, ids, round.Rand())
+	}
 	if err != nil {
 		return round.WrapError(err, Pi)
 	}
@@ -61,7 +69,11 @@ func (round *round1) Start() *tss.Error {
 	if err != nil {
 		return round.WrapError(err, Pi)
 	}
-	cmt := cmts.NewHashCommitment(pGFlat...)
+	cmt := cmts.NewHashCommitmentWithRandom(round.Rand(), pGFlat...)
+
+	// commit to our contribution to the joint chain code; it is revealed in round 2 once all commitments are in
+	ci := common.MustGetRandomIntWithRandom(round.Rand(), ChainCodeLen*8)
+	chainCodeCmt := cmts.NewHashCommitmentWithRandom(round.Rand(), ci)
 
 	// 4. generate Paillier public key E_i, private key and proof
 	// 5-7. generate safe primes for ZKPs used later on
@@ -73,8 +85,17 @@ func (round *round1) Start() *tss.Error {
 			errors.New("`optionalPreParams` failed to validate; it might have been generated with an older version of tss-lib"))
 	} else if round.save.LocalPreParams.ValidateWithProof() {
 		preParams = &round.save.LocalPreParams
+	} else if round.temp.preParamsPool != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), round.SafePrimeGenTimeout())
+		preParams, err = round.temp.preParamsPool.Take(ctx)
+		cancel()
+		if err != nil {
+			return round.WrapError(err, Pi)
+		}
 	} else {
-		preParams, err = GeneratePreParams(round.SafePrimeGenTimeout(), round.Concurrency())
+		ctx, cancel := context.WithTimeout(context.Background(), round.SafePrimeGenTimeout())
+		preParams, err = GeneratePreParamsWithContextAndRandom(ctx, round.Rand(), round.Concurrency())
+		cancel()
 		if err != nil {
 			return round.WrapError(errors.New("pre-params generation failed"), Pi)
 		}
@@ -92,8 +113,8 @@ func (round *round1) Start() *tss.Error {
 		preParams.P,
 		preParams.Q,
 		preParams.NTildei
-	dlnProof1 := dlnproof.NewDLNProof(h1i, h2i, alpha, p, q, NTildei)
-	dlnProof2 := dlnproof.NewDLNProof(h2i, h1i, beta, p, q, NTildei)
+	dlnProof1 := dlnproof.NewDLNProof(round.transcript(round.PartyID()), h1i, h2i, alpha, p, q, NTildei, round.Rand())
+	dlnProof2 := dlnproof.NewDLNProof(round.transcript(round.PartyID()), h2i, h1i, beta, p, q, NTildei, round.Rand())
 
 	// for this P: SAVE
 	// - shareID
@@ -103,16 +124,19 @@ func (round *round1) Start() *tss.Error {
 	round.save.ShareID = ids[i]
 	round.temp.vs = vs
 	round.temp.shares = shares
+	round.temp.blindingShares = blindingShares
+	round.temp.pedersenCmts[i] = pedersenCmts
 
 	// for this P: SAVE de-commitments, paillier keys for round 2
 	round.save.PaillierSK = preParams.PaillierSK
 	round.save.PaillierPKs[i] = &preParams.PaillierSK.PublicKey
 	round.temp.deCommitPolyG = cmt.D
+	round.temp.deCommitChainCode = chainCodeCmt.D
 
 	// BROADCAST commitments, paillier pk + proof; round 1 message
 	{
 		msg, err := NewKGRound1Message(
-			round.PartyID(), cmt.C, &preParams.PaillierSK.PublicKey, preParams.NTildei, preParams.H1i, preParams.H2i, dlnProof1, dlnProof2)
+			round.PartyID(), cmt.C, &preParams.PaillierSK.PublicKey, preParams.NTildei, preParams.H1i, preParams.H2i, dlnProof1, dlnProof2, chainCodeCmt.C, pedersenCmts)
 		if err != nil {
 			return round.WrapError(err, Pi)
 		}
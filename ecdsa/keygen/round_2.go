@@ -12,6 +12,7 @@ import (
 	"sync"
 
 	"github.com/bnb-chain/tss-lib/common"
+	"github.com/bnb-chain/tss-lib/crypto/vss"
 	"github.com/bnb-chain/tss-lib/tss"
 )
 
@@ -70,13 +71,13 @@ func (round *round2) Start() *tss.Error {
 		_j := j
 		_msg := msg
 
-		dlnVerifier.VerifyDLNProof1(r1msg, H1j, H2j, NTildej, func(isValid bool) {
+		dlnVerifier.VerifyDLNProof1(round.transcript(msg.GetFrom()), r1msg, H1j, H2j, NTildej, func(isValid bool) {
 			if !isValid {
 				dlnProof1FailCulprits[_j] = _msg.GetFrom()
 			}
 			wg.Done()
 		})
-		dlnVerifier.VerifyDLNProof2(r1msg, H2j, H1j, NTildej, func(isValid bool) {
+		dlnVerifier.VerifyDLNProof2(round.transcript(msg.GetFrom()), r1msg, H2j, H1j, NTildej, func(isValid bool) {
 			if !isValid {
 				dlnProof2FailCulprits[_j] = _msg.GetFrom()
 			}
@@ -105,12 +106,24 @@ func (round *round2) Start() *tss.Error {
 		round.save.NTildej[j] = NTildej
 		round.save.H1j[j], round.save.H2j[j] = H1j, H2j
 		round.temp.KGCs[j] = KGC
+		round.temp.chainCodeCmts[j] = r1msg.UnmarshalChainCodeCommitment()
+		if round.PedersenDKG() {
+			pedersenCmts, err := r1msg.UnmarshalPedersenCommitments(round.Params().EC())
+			if err != nil || len(pedersenCmts) != round.Threshold()+1 {
+				return round.WrapError(errors.New("got invalid Pedersen VSS commitments from this party"), msg.GetFrom())
+			}
+			round.temp.pedersenCmts[j] = pedersenCmts
+		}
 	}
 
 	// 5. p2p send share ij to Pj
-	shares := round.temp.shares
+	shares, blindingShares := round.temp.shares, round.temp.blindingShares
 	for j, Pj := range round.Parties().IDs() {
-		r2msg1 := NewKGRound2Message1(Pj, round.PartyID(), shares[j])
+		var blinding *vss.Share
+		if blindingShares != nil {
+			blinding = blindingShares[j]
+		}
+		r2msg1 := NewKGRound2Message1(Pj, round.PartyID(), shares[j], blinding)
 		// do not send to this Pj, but store for round 3
 		if j == i {
 			round.temp.kgRound2Message1s[j] = r2msg1
@@ -119,8 +132,13 @@ func (round *round2) Start() *tss.Error {
 		round.out <- r2msg1
 	}
 
-	// 7. BROADCAST de-commitments of Shamir poly*G
-	r2msg2 := NewKGRound2Message2(round.PartyID(), round.temp.deCommitPolyG)
+	// 7. BROADCAST de-commitments of Shamir poly*G; with the Pedersen DKG they are only opened once the qualified dealers
+	// are known, in the Feldman rounds
+	deCommitPolyG := round.temp.deCommitPolyG
+	if round.PedersenDKG() {
+		deCommitPolyG = nil
+	}
+	r2msg2 := NewKGRound2Message2(round.PartyID(), deCommitPolyG, round.temp.deCommitChainCode)
 	round.temp.kgRound2Message2s[i] = r2msg2
 	round.out <- r2msg2
 
@@ -157,5 +175,11 @@ func (round *round2) Update() (bool, *tss.Error) {
 
 func (round *round2) NextRound() tss.Round {
 	round.started = false
+	if round.RobustKeygen() {
+		return &roundComplaints{round}
+	}
+	if round.PedersenDKG() {
+		return &roundFeldman{round}
+	}
 	return &round3{round}
 }
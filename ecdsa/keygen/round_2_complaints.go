@@ -0,0 +1,201 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package keygen
+
+import (
+	"errors"
+
+	"github.com/bnb-chain/tss-lib/common"
+	"github.com/bnb-chain/tss-lib/tss"
+)
+
+// In robust mode a share that fails to verify does not abort keygen. Its receiver broadcasts a complaint against the
+// dealer, which must then reveal the disputed share to everyone. A dealer whose revealed share is invalid, or whose
+// commitments revealed in round 2 are invalid, is left out of the key by every party.
+
+func (round *roundComplaints) Start() *tss.Error {
+	if round.started {
+		return round.WrapError(errors.New("round already started"))
+	}
+	round.number = 3
+	round.started = true
+	round.resetOK()
+
+	Ps := round.Parties().IDs()
+	i := round.PartyID().Index
+
+	dealings := round.verifyDealings()
+	accused := make([]int, 0, len(Ps))
+	for j, Pj := range Ps {
+		if j == i {
+			continue
+		}
+		if _, ok := round.deCommitChainCode(j); !ok && dealings[j].cmtErr == nil {
+			dealings[j].cmtErr = errors.New("chain code de-commitment verify failed")
+		}
+		switch {
+		case dealings[j].cmtErr != nil:
+			// every party sees the same broadcast, so no complaint is needed
+			common.Logger.Warningf("party %s is disqualified: %v", Pj, dealings[j].cmtErr)
+			round.temp.disqualified[j] = true
+		case dealings[j].shareErr != nil:
+			common.Logger.Warningf("complaining about party %s: %v", Pj, dealings[j].shareErr)
+			accused = append(accused, j)
+		}
+		round.temp.dealerVs[j] = dealings[j].vs
+	}
+
+	// BROADCAST the complaints, even if there are none
+	msg := NewKGComplaintMessage(round.PartyID(), accused)
+	round.temp.kgComplaintMessages[i] = msg
+	round.out <- msg
+	return nil
+}
+
+func (round *roundComplaints) CanAccept(msg tss.ParsedMessage) bool {
+	if _, ok := msg.Content().(*KGComplaintMessage); ok {
+		return msg.IsBroadcast()
+	}
+	return false
+}
+
+func (round *roundComplaints) Update() (bool, *tss.Error) {
+	for j, msg := range round.temp.kgComplaintMessages {
+		if round.ok[j] {
+			continue
+		}
+		if msg == nil || !round.CanAccept(msg) {
+			return false, nil
+		}
+		round.ok[j] = true
+	}
+	return true, nil
+}
+
+func (round *roundComplaints) NextRound() tss.Round {
+	round.started = false
+	return &roundReveals{round}
+}
+
+// ----- //
+
+func (round *roundReveals) Start() *tss.Error {
+	if round.started {
+		return round.WrapError(errors.New("round already started"))
+	}
+	round.number = 4
+	round.started = true
+	round.resetOK()
+
+	i := round.PartyID().Index
+
+	// reveal our shares to the parties that complained about us
+	complainants := make([]int, 0, len(round.temp.kgComplaintMessages))
+	for k, msg := range round.temp.kgComplaintMessages {
+		for _, j := range msg.Content().(*KGComplaintMessage).UnmarshalAccused() {
+			if j == i && k != i {
+				complainants = append(complainants, k)
+				break
+			}
+		}
+	}
+
+	// BROADCAST the revealed shares, even if there are none
+	msg := NewKGRevealMessage(round.PartyID(), complainants, round.temp.shares, round.temp.blindingShares)
+	round.temp.kgRevealMessages[i] = msg
+	round.out <- msg
+	return nil
+}
+
+func (round *roundReveals) CanAccept(msg tss.ParsedMessage) bool {
+	if _, ok := msg.Content().(*KGRevealMessage); ok {
+		return msg.IsBroadcast()
+	}
+	return false
+}
+
+func (round *roundReveals) Update() (bool, *tss.Error) {
+	for j, msg := range round.temp.kgRevealMessages {
+		if round.ok[j] {
+			continue
+		}
+		if msg == nil || !round.CanAccept(msg) {
+			return false, nil
+		}
+		round.ok[j] = true
+	}
+	return true, nil
+}
+
+func (round *roundReveals) NextRound() tss.Round {
+	round.started = false
+	if round.PedersenDKG() {
+		return &roundFeldman{round.round2}
+	}
+	return &round3{round.round2}
+}
+
+// ----- //
+
+// resolveComplaints disqualifies every accused dealer that did not reveal a valid share to its accuser, and takes the
+// shares revealed to us in place of the ones we complained about. The keys of the disqualified dealers are SAVED;
+// removeDisqualified later takes them out of the rest of the key data.
+func (round *base) resolveComplaints() *tss.Error {
+	Ps := round.Parties().IDs()
+	i := round.PartyID().Index
+
+	for k, msg := range round.temp.kgComplaintMessages {
+		for _, j := range msg.Content().(*KGComplaintMessage).UnmarshalAccused() {
+			if j < 0 || len(Ps) <= j || j == k || round.temp.disqualified[j] {
+				continue
+			}
+			r4msg := round.temp.kgRevealMessages[j].Content().(*KGRevealMessage)
+			share, blinding := r4msg.UnmarshalRevealedShare(k)
+			if share == nil || round.verifyShare(j, Ps[k].KeyInt(), share, blinding, round.temp.dealerVs[j]) != nil {
+				common.Logger.Warningf("party %s is disqualified: it did not reveal a valid share for party %s", Ps[j], Ps[k])
+				round.temp.disqualified[j] = true
+				continue
+			}
+			if k == i {
+				round.temp.revealedShares[j] = share
+			}
+		}
+	}
+
+	culprits := make([]*tss.PartyID, 0, len(Ps))
+	for j, Pj := range Ps {
+		if round.temp.disqualified[j] {
+			culprits = append(culprits, Pj)
+			round.save.DisqualifiedKs = append(round.save.DisqualifiedKs, Pj.KeyInt())
+		}
+	}
+	// the key is no longer safe if more dealers than the threshold are corrupt
+	if round.Threshold() < len(culprits) {
+		return round.WrapError(errors.New("too many parties were disqualified"), culprits...)
+	}
+	// nor can anyone sign with it if fewer than t+1 parties are left to hold its shares
+	if len(Ps)-len(culprits) < round.Threshold()+1 {
+		return round.WrapError(errors.New("too few parties are left to sign with the key"), culprits...)
+	}
+	return nil
+}
+
+// removeDisqualified takes the disqualified dealers out of the per-party data of the key, so that they cannot take part
+// in signing, resharing, refresh or repair with it. Their Paillier keys were never verified in round 4.
+func (round *base) removeDisqualified() {
+	save, kept := round.save, 0
+	for j := range save.Ks {
+		if round.temp.disqualified[j] {
+			continue
+		}
+		save.Ks[kept], save.BigXj[kept], save.PaillierPKs[kept] = save.Ks[j], save.BigXj[j], save.PaillierPKs[j]
+		save.NTildej[kept], save.H1j[kept], save.H2j[kept] = save.NTildej[j], save.H1j[j], save.H2j[j]
+		kept++
+	}
+	save.Ks, save.BigXj, save.PaillierPKs = save.Ks[:kept], save.BigXj[:kept], save.PaillierPKs[:kept]
+	save.NTildej, save.H1j, save.H2j = save.NTildej[:kept], save.H1j[:kept], save.H2j[:kept]
+}
@@ -18,7 +18,7 @@ func (round *round4) Start() *tss.Error {
 	if round.started {
 		return round.WrapError(errors.New("round already started"))
 	}
-	round.number = 4
+	round.number = 4 + round.complaintRounds()
 	round.started = true
 	round.resetOK()
 
@@ -35,13 +35,13 @@ func (round *round4) Start() *tss.Error {
 		chs[i] = make(chan bool)
 	}
 	for j, msg := range round.temp.kgRound3Messages {
-		if j == i {
+		if j == i || round.temp.disqualified[j] {
 			continue
 		}
 		r3msg := msg.Content().(*KGRound3Message)
 		go func(prf paillier.Proof, j int, ch chan<- bool) {
 			ppk := round.save.PaillierPKs[j]
-			ok, err := prf.Verify(ppk.N, PIDs[j], ecdsaPub)
+			ok, err := prf.Verify(round.transcript(Ps[j]), ppk.N, PIDs[j], ecdsaPub)
 			if err != nil {
 				common.Logger.Error(round.WrapError(err, Ps[j]).Error())
 				ch <- false
@@ -53,7 +53,8 @@ func (round *round4) Start() *tss.Error {
 
 	// consume unbuffered channels (end the goroutines)
 	for j, ch := range chs {
-		if j == i {
+		// the dealers left out of the key by robust keygen are not checked
+		if j == i || round.temp.disqualified[j] {
 			round.ok[j] = true
 			continue
 		}
@@ -73,6 +74,7 @@ func (round *round4) Start() *tss.Error {
 		return round.WrapError(errors.New("paillier verify failed"), culprits...)
 	}
 
+	round.removeDisqualified()
 	round.end <- *round.save
 
 	return nil
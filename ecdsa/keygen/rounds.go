@@ -7,6 +7,10 @@
 package keygen
 
 import (
+	"fmt"
+
+	"github.com/bnb-chain/tss-lib/crypto/group"
+	"github.com/bnb-chain/tss-lib/crypto/transcript"
 	"github.com/bnb-chain/tss-lib/tss"
 )
 
@@ -37,6 +41,23 @@ type (
 	round4 struct {
 		*round3
 	}
+	// in robust mode, the complaint rounds run between rounds 2 and 3
+	roundComplaints struct {
+		*round2
+	}
+	roundReveals struct {
+		*roundComplaints
+	}
+	// with the Pedersen DKG, the Feldman rounds run after the complaint rounds and before round 3
+	roundFeldman struct {
+		*round2
+	}
+	roundFeldmanComplaints struct {
+		*roundFeldman
+	}
+	roundReconstruct struct {
+		*roundFeldmanComplaints
+	}
 )
 
 var (
@@ -44,6 +65,11 @@ var (
 	_ tss.Round = (*round2)(nil)
 	_ tss.Round = (*round3)(nil)
 	_ tss.Round = (*round4)(nil)
+	_ tss.Round = (*roundComplaints)(nil)
+	_ tss.Round = (*roundReveals)(nil)
+	_ tss.Round = (*roundFeldman)(nil)
+	_ tss.Round = (*roundFeldmanComplaints)(nil)
+	_ tss.Round = (*roundReconstruct)(nil)
 )
 
 // ----- //
@@ -88,6 +114,40 @@ func (round *base) WrapError(err error, culprits ...*tss.PartyID) *tss.Error {
 
 // ----- //
 
+// curveGroup returns the group of the key generation curve, in whose constant-time scalars the secret values are computed
+func (round *base) curveGroup() (group.CurveGroup, error) {
+	g, ok := group.FromCurve(round.Params().EC())
+	if !ok {
+		return nil, fmt.Errorf("no group is registered for the curve %s", round.Params().EC().Params().Name)
+	}
+	return g, nil
+}
+
+// transcript returns the Fiat-Shamir transcript for a proof made by `prover`, or nil if the legacy proofs are used
+func (round *base) transcript(prover *tss.PartyID) *transcript.Transcript {
+	return transcript.ForProver(round.Params(), TaskName, prover)
+}
+
+// complaintRounds returns the number of rounds that run between rounds 2 and 3, which shift the later round numbers
+func (round *base) complaintRounds() int {
+	rounds := 0
+	if round.RobustKeygen() {
+		rounds += 2
+	}
+	if round.PedersenDKG() {
+		rounds += 3
+	}
+	return rounds
+}
+
+// feldmanRound returns the number of the first Feldman round of the Pedersen DKG
+func (round *base) feldmanRound() int {
+	if round.RobustKeygen() {
+		return 5
+	}
+	return 3
+}
+
 // `ok` tracks parties which have been verified by Update()
 func (round *base) resetOK() {
 	for j := range round.ok {
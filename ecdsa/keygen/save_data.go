@@ -11,11 +11,17 @@ import (
 	"errors"
 	"math/big"
 
+	"github.com/bnb-chain/tss-lib/common"
 	"github.com/bnb-chain/tss-lib/crypto"
+	"github.com/bnb-chain/tss-lib/crypto/ckd"
 	"github.com/bnb-chain/tss-lib/crypto/paillier"
 	"github.com/bnb-chain/tss-lib/tss"
+	"github.com/btcsuite/btcd/chaincfg"
 )
 
+// ChainCodeLen is the length in bytes of the BIP-32 chain code generated during keygen
+const ChainCodeLen = 32
+
 type (
 	LocalPreParams struct {
 		PaillierSK *paillier.PrivateKey // ski
@@ -47,6 +53,12 @@ type (
 
 		// used for test assertions (may be discarded)
 		ECDSAPub *crypto.ECPoint // y
+
+		// BIP-32 chain code generated jointly by the parties, shared by all of them
+		ChainCode []byte
+
+		// keys of the dealers that robust keygen left out of the key, and out of Ks, after they were proven to cheat
+		DisqualifiedKs []*big.Int
 	}
 )
 
@@ -74,6 +86,22 @@ func (preParams LocalPreParams) ValidateWithProof() bool {
 		preParams.Q != nil
 }
 
+// Wipe overwrites the Paillier secret key and the safe primes. Any copy of the pre-params is affected too, as they
+// share the same values.
+func (preParams LocalPreParams) Wipe() {
+	if preParams.PaillierSK != nil {
+		preParams.PaillierSK.Wipe()
+	}
+	common.WipeInts(preParams.Alpha, preParams.Beta, preParams.P, preParams.Q)
+}
+
+// Wipe overwrites the secret share and the pre-params once the key data is no longer needed in memory, e.g. after it
+// has been persisted. Any copy of the key data is affected too, as they share the same values.
+func (save LocalPartySaveData) Wipe() {
+	save.LocalPreParams.Wipe()
+	common.WipeInts(save.Xi)
+}
+
 // BuildLocalSaveDataSubset re-creates the LocalPartySaveData to contain data for only the list of signing parties.
 func BuildLocalSaveDataSubset(sourceData LocalPartySaveData, sortedIDs tss.SortedPartyIDs) LocalPartySaveData {
 	keysToIndices := make(map[string]int, len(sourceData.Ks))
@@ -84,6 +112,7 @@ func BuildLocalSaveDataSubset(sourceData LocalPartySaveData, sortedIDs tss.Sorte
 	newData.LocalPreParams = sourceData.LocalPreParams
 	newData.LocalSecrets = sourceData.LocalSecrets
 	newData.ECDSAPub = sourceData.ECDSAPub
+	newData.ChainCode = sourceData.ChainCode
 	for j, id := range sortedIDs {
 		savedIdx, ok := keysToIndices[hex.EncodeToString(id.Key)]
 		if !ok {
@@ -98,3 +127,29 @@ func BuildLocalSaveDataSubset(sourceData LocalPartySaveData, sortedIDs tss.Sorte
 	}
 	return newData
 }
+
+// ExtendedPublicKey returns the BIP-32 extended public key of the shared key at the given path, e.g. "m/44/60/0/0".
+// Only non-hardened paths can be derived because no party holds the full private key.
+func (save LocalPartySaveData) ExtendedPublicKey(path string) (*ckd.ExtendedKey, error) {
+	if save.ECDSAPub == nil {
+		return nil, errors.New("the save data has no public key")
+	}
+	if len(save.ChainCode) != ChainCodeLen {
+		return nil, errors.New("the save data has no chain code; it was created before chain codes were generated during keygen")
+	}
+	master, err := ckd.NewMasterExtendedKey(save.ECDSAPub.ToECDSAPubKey(), save.ChainCode, chaincfg.MainNetParams.HDPublicKeyID[:])
+	if err != nil {
+		return nil, err
+	}
+	_, extendedKey, err := ckd.DeriveChildKeyFromPath(path, master, save.ECDSAPub.Curve())
+	return extendedKey, err
+}
+
+// XPub returns the base58 serialization ("xpub...") of the extended public key at the given path
+func (save LocalPartySaveData) XPub(path string) (string, error) {
+	extendedKey, err := save.ExtendedPublicKey(path)
+	if err != nil {
+		return "", err
+	}
+	return extendedKey.String(), nil
+}
@@ -0,0 +1,59 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package keygen
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bnb-chain/tss-lib/crypto"
+	"github.com/bnb-chain/tss-lib/crypto/ckd"
+	"github.com/bnb-chain/tss-lib/tss"
+)
+
+func TestSaveDataXPub(t *testing.T) {
+	// BIP-32 test vector 1
+	masterXPub := "xpub661MyMwAqRbcFtXgS5sYJABqqG9YLmC4Q1Rdap9gSE8NqtwybGhePY2gZ29ESFjqJoCu1Rupje8YtGqsefD265TMg7usUDFdp6W1EGMcet8"
+	master, err := ckd.NewExtendedKeyFromString(masterXPub, tss.S256())
+	assert.NoError(t, err)
+
+	save := NewLocalPartySaveData(1)
+	save.ECDSAPub, err = crypto.NewECPoint(tss.S256(), master.X, master.Y)
+	assert.NoError(t, err)
+	save.ChainCode = master.ChainCode
+
+	xpub, err := save.XPub("m")
+	assert.NoError(t, err)
+	assert.Equal(t, masterXPub, xpub)
+
+	xpub, err = save.XPub("m/0/1/2/2")
+	assert.NoError(t, err)
+	assert.Equal(t, "xpub6FHUhLbYYkgFQiFrDiXRfQFXBB2msCxKTsNyAExi6keFxQ8sHfwpogY3p3s1ePSpUqLNYks5T6a3JqpCGszt4kxbyq7tUoFP5c8KWyiDtPp", xpub)
+
+	_, err = save.XPub("m/44'/60'/0'/0/5")
+	assert.Error(t, err, "hardened paths should be rejected")
+
+	save.ChainCode = nil
+	_, err = save.XPub("m/0")
+	assert.Error(t, err, "save data without a chain code should be rejected")
+}
+
+func TestSaveDataWipe(t *testing.T) {
+	fixtures, _, err := LoadKeygenTestFixtures(1)
+	if err != nil {
+		t.Skip("the test fixtures are needed to wipe key data")
+	}
+	save := fixtures[0]
+	cpy := BuildLocalSaveDataSubset(save, tss.SortedPartyIDs{})
+	save.Wipe()
+	for _, x := range []*big.Int{cpy.Xi, cpy.PaillierSK.LambdaN, cpy.PaillierSK.PhiN, cpy.Alpha, cpy.Beta, cpy.P, cpy.Q} {
+		assert.Zero(t, x.Sign())
+	}
+	assert.NotZero(t, save.ShareID.Sign(), "public values are kept")
+}
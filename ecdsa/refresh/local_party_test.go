@@ -0,0 +1,210 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package refresh_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ipfs/go-log"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bnb-chain/tss-lib/common"
+	"github.com/bnb-chain/tss-lib/crypto"
+	"github.com/bnb-chain/tss-lib/crypto/vss"
+	"github.com/bnb-chain/tss-lib/ecdsa/keygen"
+	. "github.com/bnb-chain/tss-lib/ecdsa/refresh"
+	"github.com/bnb-chain/tss-lib/ecdsa/signing"
+	"github.com/bnb-chain/tss-lib/test"
+	"github.com/bnb-chain/tss-lib/tss"
+)
+
+const (
+	testParticipants = test.TestParticipants
+	testThreshold    = test.TestThreshold
+)
+
+func setUp(level string) {
+	if err := log.SetLogLevel("tss-lib", level); err != nil {
+		panic(err)
+	}
+}
+
+// startParties starts a refresh of `keys` by the parties `pIDs`, which rotate their Paillier keys to `preParams` when set
+func startParties(keys []keygen.LocalPartySaveData, preParams map[int]keygen.LocalPreParams, pIDs tss.SortedPartyIDs, errCh chan *tss.Error, outCh chan tss.Message, endCh chan keygen.LocalPartySaveData) []*LocalParty {
+	p2pCtx := tss.NewPeerContext(pIDs)
+	parties := make([]*LocalParty, 0, len(pIDs))
+	for j, pID := range pIDs {
+		params := tss.NewParameters(tss.S256(), p2pCtx, pID, len(pIDs), testThreshold)
+		var optionalPreParams []keygen.LocalPreParams
+		if pp, ok := preParams[j]; ok {
+			optionalPreParams = append(optionalPreParams, pp)
+		}
+		P := NewLocalParty(params, keys[j], outCh, endCh, optionalPreParams...).(*LocalParty)
+		parties = append(parties, P)
+		go func(P *LocalParty) {
+			if err := P.Start(); err != nil {
+				errCh <- err
+			}
+		}(P)
+	}
+	return parties
+}
+
+func deliver(parties []*LocalParty, msg tss.Message, errCh chan *tss.Error) {
+	dest := msg.GetTo()
+	if dest == nil {
+		for _, P := range parties {
+			if P.PartyID().Index != msg.GetFrom().Index {
+				go test.SharedPartyUpdater(P, msg, errCh)
+			}
+		}
+	} else {
+		go test.SharedPartyUpdater(parties[dest[0].Index], msg, errCh)
+	}
+}
+
+func TestE2EConcurrent(t *testing.T) {
+	setUp("info")
+
+	// PHASE: load keygen fixtures; every holder of a share takes part in the refresh
+	keys, pIDs, err := keygen.LoadKeygenTestFixtures(testParticipants)
+	assert.NoError(t, err, "should load keygen fixtures")
+	chainCode := common.SHA512_256([]byte("chain code"))
+	oldXis := make([]*big.Int, len(keys))
+	for j := range keys {
+		keys[j].ChainCode = chainCode
+		oldXis[j] = new(big.Int).Set(keys[j].Xi)
+	}
+	pubKey := keys[0].ECDSAPub
+
+	// P0 and P1 rotate their Paillier keys and NTilde, h1, h2 by swapping them; the other parties keep theirs
+	preParams := map[int]keygen.LocalPreParams{0: keys[1].LocalPreParams, 1: keys[0].LocalPreParams}
+	oldPaillierNs := make([]*big.Int, len(keys))
+	for j := range keys {
+		oldPaillierNs[j] = keys[j].PaillierSK.N
+	}
+
+	// PHASE: refresh
+	errCh := make(chan *tss.Error, len(pIDs))
+	outCh := make(chan tss.Message, len(pIDs))
+	endCh := make(chan keygen.LocalPartySaveData, len(pIDs))
+	parties := startParties(keys, preParams, pIDs, errCh, outCh, endCh)
+
+	newKeys := make([]keygen.LocalPartySaveData, len(pIDs))
+	for ended := 0; ended < len(pIDs); {
+		select {
+		case err := <-errCh:
+			common.Logger.Errorf("Error: %s", err)
+			assert.FailNow(t, err.Error())
+			return
+		case msg := <-outCh:
+			deliver(parties, msg, errCh)
+		case save := <-endCh:
+			index, err := save.OriginalIndex()
+			assert.NoErrorf(t, err, "should not be an error getting a party's index from save data")
+			newKeys[index] = save
+			ended++
+		}
+	}
+
+	shares := make(vss.Shares, len(newKeys))
+	for j, key := range newKeys {
+		assert.Zero(t, keys[j].Xi.Sign(), "the input share should be wiped")
+		assert.NotEqual(t, oldXis[j], key.Xi, "the share should change")
+		assert.True(t, key.ECDSAPub.Equals(pubKey), "the public key should not change")
+		assert.Equal(t, chainCode, key.ChainCode, "the chain code should be preserved")
+		for i, Xi := range key.BigXj {
+			assert.True(t, Xi.Equals(newKeys[0].BigXj[i]), "every party should agree on the public shares")
+		}
+		// xj test: BigXj == xj*G
+		assert.True(t, key.BigXj[j].Equals(crypto.ScalarBaseMult(tss.S256(), key.Xi)), "ensure BigX_j == g^x_j")
+		for i, pk := range key.PaillierPKs {
+			assert.Equal(t, newKeys[i].PaillierSK.N, pk.N, "every party should agree on the Paillier keys")
+			assert.Equal(t, newKeys[i].NTildei, key.NTildej[i], "every party should agree on NTilde")
+		}
+		switch j {
+		case 0, 1:
+			assert.Equal(t, oldPaillierNs[1-j], key.PaillierSK.N, "the Paillier key should be rotated")
+		default:
+			assert.Equal(t, oldPaillierNs[j], key.PaillierSK.N, "the Paillier key should be kept")
+		}
+		shares[j] = &vss.Share{Threshold: testThreshold, ID: key.ShareID, Share: key.Xi}
+	}
+	secret, err := shares[len(shares)-testThreshold-1:].ReConstruct(tss.S256())
+	assert.NoError(t, err)
+	assert.True(t, crypto.ScalarBaseMult(tss.S256(), secret).Equals(pubKey), "the refreshed shares should reconstruct the key")
+
+	// PHASE: signing with a subset of the refreshed keys; the parties only finish once the signature verifies
+	signKeys, signPIDs := newKeys[:testThreshold+1], pIDs[:testThreshold+1]
+	signP2pCtx := tss.NewPeerContext(signPIDs)
+	signParties := make([]*signing.LocalParty, 0, len(signPIDs))
+	signErrCh := make(chan *tss.Error, len(signPIDs))
+	signOutCh := make(chan tss.Message, len(signPIDs))
+	signEndCh := make(chan common.SignatureData, len(signPIDs))
+	for j, signPID := range signPIDs {
+		params := tss.NewParameters(tss.S256(), signP2pCtx, signPID, len(signPIDs), testThreshold)
+		P := signing.NewLocalParty(big.NewInt(42), params, signKeys[j], signOutCh, signEndCh).(*signing.LocalParty)
+		signParties = append(signParties, P)
+		go func(P *signing.LocalParty) {
+			if err := P.Start(); err != nil {
+				signErrCh <- err
+			}
+		}(P)
+	}
+	for ended := 0; ended < len(signPIDs); {
+		select {
+		case err := <-signErrCh:
+			assert.FailNow(t, err.Error())
+		case msg := <-signOutCh:
+			dest := msg.GetTo()
+			if dest == nil {
+				for _, P := range signParties {
+					if P.PartyID().Index != msg.GetFrom().Index {
+						go test.SharedPartyUpdater(P, msg, signErrCh)
+					}
+				}
+			} else {
+				go test.SharedPartyUpdater(signParties[dest[0].Index], msg, signErrCh)
+			}
+		case <-signEndCh:
+			ended++
+		}
+	}
+}
+
+func TestE2EBadZeroShareIsAttributed(t *testing.T) {
+	setUp("info")
+	keys, pIDs, err := keygen.LoadKeygenTestFixtures(testParticipants)
+	assert.NoError(t, err, "should load keygen fixtures")
+
+	errCh := make(chan *tss.Error, len(pIDs))
+	outCh := make(chan tss.Message, len(pIDs))
+	endCh := make(chan keygen.LocalPartySaveData, len(pIDs))
+	parties := startParties(keys, nil, pIDs, errCh, outCh, endCh)
+
+	// P0 sends a wrong zero share to P1, who must pin it on P0 and abort before saving anything
+	for {
+		select {
+		case err := <-errCh:
+			assert.Equal(t, 2, err.Round())
+			assert.Equal(t, []*tss.PartyID{pIDs[0]}, err.Culprits())
+			assert.Equal(t, pIDs[1], err.Victim())
+			assert.NotZero(t, keys[1].Xi.Sign(), "the input share should be kept")
+			return
+		case msg := <-outCh:
+			if r1msg2, ok := msg.(tss.ParsedMessage).Content().(*RFRound1Message2); ok &&
+				msg.GetFrom().Index == 0 && msg.GetTo()[0].Index == 1 {
+				share := new(big.Int).Add(new(big.Int).SetBytes(r1msg2.GetShare()), big.NewInt(1))
+				msg = NewRFRound1Message2(msg.GetTo()[0], msg.GetFrom(), &vss.Share{Share: share})
+			}
+			deliver(parties, msg, errCh)
+		case <-endCh:
+			assert.FailNow(t, "no party should finish without an ACK from P1")
+		}
+	}
+}
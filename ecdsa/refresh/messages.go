@@ -0,0 +1,174 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package refresh
+
+import (
+	"crypto/elliptic"
+	"math/big"
+
+	"github.com/bnb-chain/tss-lib/common"
+	"github.com/bnb-chain/tss-lib/crypto"
+	"github.com/bnb-chain/tss-lib/crypto/dlnproof"
+	"github.com/bnb-chain/tss-lib/crypto/paillier"
+	"github.com/bnb-chain/tss-lib/crypto/vss"
+	"github.com/bnb-chain/tss-lib/ecdsa/keygen"
+	"github.com/bnb-chain/tss-lib/tss"
+)
+
+// These messages were generated from Protocol Buffers definitions into ecdsa-refresh.pb.go
+
+var (
+	// Ensure that refresh messages implement ValidateBasic
+	_ = []tss.MessageContent{
+		(*RFRound1Message1)(nil),
+		(*RFRound1Message2)(nil),
+		(*RFRound2Message)(nil),
+	}
+)
+
+// ----- //
+
+// NewRFRound1Message1 returns the broadcast of the zero sharing commitments. When `preParams` is not nil the party
+// replaces its Paillier key and NTilde, h1, h2 with those in `preParams`, which come with their proofs.
+func NewRFRound1Message1(
+	from *tss.PartyID,
+	vs vss.Vs,
+	preParams *keygen.LocalPreParams,
+	paillierPf paillier.Proof,
+	dlnProof1, dlnProof2 *dlnproof.Proof,
+) (tss.ParsedMessage, error) {
+	meta := tss.MessageRouting{
+		From:        from,
+		IsBroadcast: true,
+	}
+	flatVs, err := crypto.FlattenECPoints(vs)
+	if err != nil {
+		return nil, err
+	}
+	content := &RFRound1Message1{
+		V: common.BigIntsToBytes(flatVs),
+	}
+	if preParams != nil {
+		dlnProof1Bz, err := dlnProof1.Serialize()
+		if err != nil {
+			return nil, err
+		}
+		dlnProof2Bz, err := dlnProof2.Serialize()
+		if err != nil {
+			return nil, err
+		}
+		content.PaillierN = preParams.PaillierSK.PublicKey.N.Bytes()
+		content.PaillierProof = common.BigIntsToBytes(paillierPf[:])
+		content.NTilde = preParams.NTildei.Bytes()
+		content.H1 = preParams.H1i.Bytes()
+		content.H2 = preParams.H2i.Bytes()
+		content.Dlnproof_1 = dlnProof1Bz
+		content.Dlnproof_2 = dlnProof2Bz
+	}
+	msg := tss.NewMessageWrapper(meta, content)
+	return tss.NewMessage(meta, content, msg), nil
+}
+
+func (m *RFRound1Message1) ValidateBasic() bool {
+	if m == nil || !common.NonEmptyMultiBytes(m.V) || len(m.V)%2 != 0 {
+		return false
+	}
+	if !m.RotatesKeys() {
+		return len(m.PaillierProof) == 0 && len(m.NTilde) == 0 && len(m.H1) == 0 && len(m.H2) == 0 &&
+			len(m.Dlnproof_1) == 0 && len(m.Dlnproof_2) == 0
+	}
+	return common.NonEmptyMultiBytes(m.PaillierProof, paillier.ProofIters) &&
+		common.NonEmptyBytes(m.NTilde) &&
+		common.NonEmptyBytes(m.H1) &&
+		common.NonEmptyBytes(m.H2) &&
+		// expected len of dln proof = sizeof(int64) + len(alpha) + len(t)
+		common.NonEmptyMultiBytes(m.GetDlnproof_1(), 2+(dlnproof.Iterations*2)) &&
+		common.NonEmptyMultiBytes(m.GetDlnproof_2(), 2+(dlnproof.Iterations*2))
+}
+
+// RotatesKeys reports whether the sender replaces its Paillier key and NTilde, h1, h2 in this refresh
+func (m *RFRound1Message1) RotatesKeys() bool {
+	return len(m.GetPaillierN()) > 0
+}
+
+func (m *RFRound1Message1) UnmarshalVs(ec elliptic.Curve) (vss.Vs, error) {
+	return crypto.UnFlattenECPoints(ec, common.MultiBytesToBigInts(m.GetV()))
+}
+
+func (m *RFRound1Message1) UnmarshalPaillierPK() *paillier.PublicKey {
+	return &paillier.PublicKey{
+		N: new(big.Int).SetBytes(m.GetPaillierN()),
+	}
+}
+
+func (m *RFRound1Message1) UnmarshalNTilde() *big.Int {
+	return new(big.Int).SetBytes(m.GetNTilde())
+}
+
+func (m *RFRound1Message1) UnmarshalH1() *big.Int {
+	return new(big.Int).SetBytes(m.GetH1())
+}
+
+func (m *RFRound1Message1) UnmarshalH2() *big.Int {
+	return new(big.Int).SetBytes(m.GetH2())
+}
+
+func (m *RFRound1Message1) UnmarshalPaillierProof() paillier.Proof {
+	var pf paillier.Proof
+	ints := common.MultiBytesToBigInts(m.GetPaillierProof())
+	copy(pf[:], ints[:paillier.ProofIters])
+	return pf
+}
+
+func (m *RFRound1Message1) UnmarshalDLNProof1() (*dlnproof.Proof, error) {
+	return dlnproof.UnmarshalDLNProof(m.GetDlnproof_1())
+}
+
+func (m *RFRound1Message1) UnmarshalDLNProof2() (*dlnproof.Proof, error) {
+	return dlnproof.UnmarshalDLNProof(m.GetDlnproof_2())
+}
+
+// ----- //
+
+func NewRFRound1Message2(
+	to, from *tss.PartyID,
+	share *vss.Share,
+) tss.ParsedMessage {
+	meta := tss.MessageRouting{
+		From:        from,
+		To:          []*tss.PartyID{to},
+		IsBroadcast: false,
+	}
+	content := &RFRound1Message2{
+		Share: share.Share.Bytes(),
+	}
+	msg := tss.NewMessageWrapper(meta, content)
+	return tss.NewMessage(meta, content, msg)
+}
+
+func (m *RFRound1Message2) ValidateBasic() bool {
+	return m != nil &&
+		common.NonEmptyBytes(m.Share)
+}
+
+// ----- //
+
+func NewRFRound2Message(
+	from *tss.PartyID,
+) tss.ParsedMessage {
+	meta := tss.MessageRouting{
+		From:        from,
+		IsBroadcast: true,
+	}
+	content := &RFRound2Message{}
+	msg := tss.NewMessageWrapper(meta, content)
+	return tss.NewMessage(meta, content, msg)
+}
+
+func (m *RFRound2Message) ValidateBasic() bool {
+	return true
+}
@@ -0,0 +1,216 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package refresh
+
+import (
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"sync"
+
+	"github.com/bnb-chain/tss-lib/common"
+	"github.com/bnb-chain/tss-lib/crypto"
+	"github.com/bnb-chain/tss-lib/crypto/group"
+	"github.com/bnb-chain/tss-lib/crypto/paillier"
+	"github.com/bnb-chain/tss-lib/crypto/vss"
+	"github.com/bnb-chain/tss-lib/ecdsa/keygen"
+	"github.com/bnb-chain/tss-lib/tss"
+)
+
+func (round *round2) Start() *tss.Error {
+	if round.started {
+		return round.WrapError(errors.New("round already started"))
+	}
+	round.number = 2
+	round.started = true
+	round.resetOK()
+
+	Ps := round.Parties().IDs()
+	Pi := round.PartyID()
+	i := Pi.Index
+
+	// 1-2. verify the proofs of the rotated Paillier keys and NTilde, h1, h2, then ensure the uniqueness of h1j, h2j
+	if err := round.verifyRotatedKeys(); err != nil {
+		return err
+	}
+
+	g, err := round.curveGroup()
+	if err != nil {
+		return round.WrapError(err)
+	}
+
+	// 3. start from the current share and public shares
+	newXi := g.ScalarFromBigInt(round.input.Xi)
+	newBigXjs := make([]group.Point, len(Ps))
+	for j, Xj := range round.input.BigXj {
+		Xjp, err := g.PointFromAffine(Xj.X(), Xj.Y())
+		if err != nil {
+			return round.WrapError(err, Ps[j])
+		}
+		newBigXjs[j] = Xjp
+	}
+
+	// 4-6. verify every zero share to us and add it to our share; add every party's zero share to its public share
+	culprits := make([]*tss.PartyID, 0, len(Ps)) // who caused the error(s)
+	for j, Pj := range Ps {
+		r1msg1 := round.temp.rfRound1Message1s[j].Content().(*RFRound1Message1)
+		r1msg2 := round.temp.rfRound1Message2s[j].Content().(*RFRound1Message2)
+		vj, err := r1msg1.UnmarshalVs(round.Params().EC())
+		if err != nil || len(vj) != round.Threshold() {
+			culprits = append(culprits, Pj)
+			continue
+		}
+		share := &vss.Share{
+			Threshold: round.Threshold(),
+			ID:        Pi.KeyInt(),
+			Share:     new(big.Int).SetBytes(r1msg2.Share),
+		}
+		if !share.VerifyZero(round.Params().EC(), round.Threshold(), vj) {
+			culprits = append(culprits, Pj)
+			continue
+		}
+		newXi = newXi.Add(g.ScalarFromBigInt(share.Share))
+		for k, kk := range round.input.Ks {
+			vk, err := vss.EvaluateZeroCommitments(g, vj, kk)
+			if err != nil {
+				return round.WrapError(err, Pj)
+			}
+			newBigXjs[k] = newBigXjs[k].Add(vk)
+		}
+	}
+	if len(culprits) > 0 {
+		return round.WrapError(errors.New("zero share from this party did not pass VerifyZero()"), culprits...)
+	}
+
+	// 7. the refreshed public shares must be valid points; the identity would mean a share of zero
+	round.temp.newBigXjs = make([]*crypto.ECPoint, len(Ps))
+	for j, Xj := range newBigXjs {
+		var err error
+		if round.temp.newBigXjs[j], err = crypto.NewECPointFromGroupPoint(g, Xj); err != nil {
+			return round.WrapError(err, Ps[j])
+		}
+	}
+	round.temp.newXi = newXi.BigInt()
+
+	// 8. BROADCAST an "ACK" to signal that we're ready to save our data
+	r2msg := NewRFRound2Message(Pi)
+	round.temp.rfRound2Messages[i] = r2msg
+	round.out <- r2msg
+	return nil
+}
+
+func (round *round2) CanAccept(msg tss.ParsedMessage) bool {
+	if _, ok := msg.Content().(*RFRound2Message); ok {
+		return msg.IsBroadcast()
+	}
+	return false
+}
+
+func (round *round2) Update() (bool, *tss.Error) {
+	for j, msg := range round.temp.rfRound2Messages {
+		if round.ok[j] {
+			continue
+		}
+		if msg == nil || !round.CanAccept(msg) {
+			return false, nil
+		}
+		round.ok[j] = true
+	}
+	return true, nil
+}
+
+func (round *round2) NextRound() tss.Round {
+	round.started = false
+	return &round3{round}
+}
+
+// ----- //
+
+// verifyRotatedKeys checks the proofs of the Paillier keys and NTilde, h1, h2 that the other parties rotate, then
+// stores the keys that every party will have after the refresh in the temp data
+func (round *round2) verifyRotatedKeys() *tss.Error {
+	Ps := round.Parties().IDs()
+	i := round.PartyID().Index
+	dlnVerifier := keygen.NewDlnProofVerifier(round.Concurrency())
+
+	round.temp.newPaillierPKs = append([]*paillier.PublicKey(nil), round.input.PaillierPKs...)
+	round.temp.newNTildej = append([]*big.Int(nil), round.input.NTildej...)
+	round.temp.newH1j = append([]*big.Int(nil), round.input.H1j...)
+	round.temp.newH2j = append([]*big.Int(nil), round.input.H2j...)
+	if preParams := round.temp.preParams; preParams != nil {
+		round.temp.newPaillierPKs[i] = &preParams.PaillierSK.PublicKey
+		round.temp.newNTildej[i] = preParams.NTildei
+		round.temp.newH1j[i], round.temp.newH2j[i] = preParams.H1i, preParams.H2i
+	}
+
+	paiProofCulprits := make([]*tss.PartyID, len(Ps)) // who caused the error(s)
+	dlnProof1FailCulprits := make([]*tss.PartyID, len(Ps))
+	dlnProof2FailCulprits := make([]*tss.PartyID, len(Ps))
+	wg := new(sync.WaitGroup)
+	for j, msg := range round.temp.rfRound1Message1s {
+		r1msg1 := msg.Content().(*RFRound1Message1)
+		if j == i || !r1msg1.RotatesKeys() {
+			continue
+		}
+		paiPK, NTildej, H1j, H2j :=
+			r1msg1.UnmarshalPaillierPK(),
+			r1msg1.UnmarshalNTilde(),
+			r1msg1.UnmarshalH1(),
+			r1msg1.UnmarshalH2()
+		wg.Add(3)
+		go func(j int, msg tss.ParsedMessage, r1msg1 *RFRound1Message1) {
+			if ok, err := r1msg1.UnmarshalPaillierProof().Verify(round.transcript(msg.GetFrom()), paiPK.N, msg.GetFrom().KeyInt(), round.input.ECDSAPub); err != nil || !ok {
+				paiProofCulprits[j] = msg.GetFrom()
+				common.Logger.Warningf("paillier verify failed for party %s: %v", msg.GetFrom(), err)
+			}
+			wg.Done()
+		}(j, msg, r1msg1)
+		_j := j
+		_msg := msg
+		dlnVerifier.VerifyDLNProof1(round.transcript(msg.GetFrom()), r1msg1, H1j, H2j, NTildej, func(isValid bool) {
+			if !isValid {
+				dlnProof1FailCulprits[_j] = _msg.GetFrom()
+				common.Logger.Warningf("dln proof 1 verify failed for party %s", _msg.GetFrom())
+			}
+			wg.Done()
+		})
+		dlnVerifier.VerifyDLNProof2(round.transcript(msg.GetFrom()), r1msg1, H2j, H1j, NTildej, func(isValid bool) {
+			if !isValid {
+				dlnProof2FailCulprits[_j] = _msg.GetFrom()
+				common.Logger.Warningf("dln proof 2 verify failed for party %s", _msg.GetFrom())
+			}
+			wg.Done()
+		})
+		round.temp.newPaillierPKs[j] = paiPK
+		round.temp.newNTildej[j] = NTildej
+		round.temp.newH1j[j], round.temp.newH2j[j] = H1j, H2j
+	}
+	wg.Wait()
+	for _, culprit := range append(append(paiProofCulprits, dlnProof1FailCulprits...), dlnProof2FailCulprits...) {
+		if culprit != nil {
+			return round.WrapError(errors.New("paillier or dln proof verification failed"), culprit)
+		}
+	}
+
+	// the h1j, h2j of all of the parties must still be unique once some of them are rotated
+	h1H2Map := make(map[string]struct{}, len(Ps)*2)
+	for j, Pj := range Ps {
+		H1j, H2j := round.temp.newH1j[j], round.temp.newH2j[j]
+		if H1j.Cmp(H2j) == 0 {
+			return round.WrapError(errors.New("h1j and h2j were equal for this party"), Pj)
+		}
+		h1JHex, h2JHex := hex.EncodeToString(H1j.Bytes()), hex.EncodeToString(H2j.Bytes())
+		if _, found := h1H2Map[h1JHex]; found {
+			return round.WrapError(errors.New("this h1j was already used by another party"), Pj)
+		}
+		if _, found := h1H2Map[h2JHex]; found {
+			return round.WrapError(errors.New("this h2j was already used by another party"), Pj)
+		}
+		h1H2Map[h1JHex], h1H2Map[h2JHex] = struct{}{}, struct{}{}
+	}
+	return nil
+}
@@ -0,0 +1,57 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package refresh
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/bnb-chain/tss-lib/tss"
+)
+
+func (round *round3) Start() *tss.Error {
+	if round.started {
+		return round.WrapError(errors.New("round already started"))
+	}
+	round.number = 3
+	round.started = true
+	round.resetOK()
+
+	// for this P: SAVE data. the refreshed share is a copy and the input share is wiped
+	round.save.LocalPreParams = round.input.LocalPreParams
+	if round.temp.preParams != nil {
+		round.save.LocalPreParams = *round.temp.preParams
+	}
+	round.save.ShareID = new(big.Int).Set(round.input.ShareID)
+	round.save.Xi = new(big.Int).Set(round.temp.newXi)
+	for j, kj := range round.input.Ks {
+		round.save.Ks[j] = new(big.Int).Set(kj)
+	}
+	round.save.BigXj = round.temp.newBigXjs
+	round.save.PaillierPKs = round.temp.newPaillierPKs
+	round.save.NTildej = round.temp.newNTildej
+	round.save.H1j, round.save.H2j = round.temp.newH1j, round.temp.newH2j
+	round.save.ECDSAPub = round.input.ECDSAPub
+	round.save.ChainCode = append([]byte(nil), round.input.ChainCode...)
+	round.save.DisqualifiedKs = append([]*big.Int(nil), round.input.DisqualifiedKs...)
+	round.input.Xi.SetInt64(0)
+
+	round.end <- *round.save
+	return nil
+}
+
+func (round *round3) CanAccept(msg tss.ParsedMessage) bool {
+	return false
+}
+
+func (round *round3) Update() (bool, *tss.Error) {
+	return false, nil
+}
+
+func (round *round3) NextRound() tss.Round {
+	return nil // finished!
+}
@@ -0,0 +1,207 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package repair
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/bnb-chain/tss-lib/common"
+	"github.com/bnb-chain/tss-lib/ecdsa/keygen"
+	"github.com/bnb-chain/tss-lib/tss"
+)
+
+// Implements Party
+// Implements Stringer
+var _ tss.Party = (*LocalParty)(nil)
+var _ fmt.Stringer = (*LocalParty)(nil)
+
+type (
+	LocalParty struct {
+		*tss.BaseParty
+		params *tss.Parameters
+
+		temp        localTempData
+		input, save keygen.LocalPartySaveData
+
+		// outbound messaging
+		out chan<- tss.Message
+		end chan<- keygen.LocalPartySaveData
+	}
+
+	localMessageStore struct {
+		rpRound1Message1s,
+		rpRound1Message2s,
+		rpRound2Message1s,
+		rpRound2Message2s []tss.ParsedMessage
+	}
+
+	localTempData struct {
+		localMessageStore
+
+		// the index of the party being repaired in the parties, or -1 if it is not one of them
+		lostIdx int
+
+		// temp data (thrown away after rounds)
+		parts []*big.Int
+		sum   *big.Int
+
+		// the pre-params of the party being repaired, if they were provided to the LocalParty constructor
+		preParams *keygen.LocalPreParams
+	}
+)
+
+// Exported, used in `tss` client
+// The parties of `params` are the party being repaired, identified by `lost`, and at least t+1 holders of the key that
+// help it to recover its share for the same ShareID. A helper passes its key data, which it keeps, and the party being
+// repaired passes empty key data. Every party receives its key data through `end` once the repair is done.
+// The party being repaired gets a new Paillier key and NTilde, h1, h2, which the helpers save in their key data in place
+// of the lost ones. It uses `optionalPreParams` if they are provided and generates them otherwise. It BROADCASTs them
+// in an RPRound2Message2, which must also reach the holders of the key that did not help, as they need to take them
+// up with UpdateKeyData before they sign, reshare or refresh with the party being repaired.
+func NewLocalParty(
+	params *tss.Parameters,
+	key keygen.LocalPartySaveData,
+	lost *tss.PartyID,
+	out chan<- tss.Message,
+	end chan<- keygen.LocalPartySaveData,
+	optionalPreParams ...keygen.LocalPreParams,
+) tss.Party {
+	partyCount := params.PartyCount()
+	p := &LocalParty{
+		BaseParty: new(tss.BaseParty),
+		params:    params,
+		temp:      localTempData{},
+		input:     key,
+		save:      keygen.NewLocalPartySaveData(len(key.Ks)),
+		out:       out,
+		end:       end,
+	}
+	// msgs init
+	p.temp.rpRound1Message1s = make([]tss.ParsedMessage, partyCount)
+	p.temp.rpRound1Message2s = make([]tss.ParsedMessage, partyCount)
+	p.temp.rpRound2Message1s = make([]tss.ParsedMessage, partyCount)
+	p.temp.rpRound2Message2s = make([]tss.ParsedMessage, partyCount)
+	// temp data init
+	p.temp.lostIdx = params.Parties().IDs().FindIndexByKey(lost.KeyInt())
+	// when `optionalPreParams` is provided we'll use the pre-computed primes instead of generating them from scratch
+	if 0 < len(optionalPreParams) {
+		if 1 < len(optionalPreParams) {
+			panic(errors.New("repair.NewLocalParty expected 0 or 1 item in `optionalPreParams`"))
+		}
+		if !optionalPreParams[0].ValidateWithProof() {
+			panic(errors.New("`optionalPreParams` failed to validate; it might have been generated with an older version of tss-lib"))
+		}
+		p.temp.preParams = &optionalPreParams[0]
+	}
+	return p
+}
+
+// UpdateKeyData returns a copy of the key data of a holder of the key that did not help with the repair of `lost`,
+// which holds the new Paillier key and NTilde, h1, h2 of `lost` from `msg`, the RPRound2Message2 that it broadcast,
+// once their proofs verify. `params` must have the curve and the session ID of the repair.
+func UpdateKeyData(params *tss.Parameters, key keygen.LocalPartySaveData, lost *tss.PartyID, msg tss.ParsedMessage) (keygen.LocalPartySaveData, error) {
+	r2msg2, ok := msg.Content().(*RPRound2Message2)
+	if !ok || !msg.IsBroadcast() || !msg.ValidateBasic() {
+		return key, errors.New("the message is not a valid RPRound2Message2")
+	}
+	if msg.GetFrom() == nil || msg.GetFrom().KeyInt().Cmp(lost.KeyInt()) != 0 {
+		return key, errors.New("the message is not from the party being repaired")
+	}
+	if err := checkKeyParties(&key, tss.SortedPartyIDs{lost}); err != nil {
+		return key, err
+	}
+	return updateLostKeys(params, key, lost, r2msg2)
+}
+
+func (p *LocalParty) FirstRound() tss.Round {
+	return newRound1(p.params, &p.input, &p.save, &p.temp, p.out, p.end)
+}
+
+func (p *LocalParty) Start() *tss.Error {
+	return tss.BaseStart(p, TaskName)
+}
+
+func (p *LocalParty) Update(msg tss.ParsedMessage) (ok bool, err *tss.Error) {
+	return tss.BaseUpdate(p, msg, TaskName)
+}
+
+func (p *LocalParty) UpdateFromBytes(wireBytes []byte, from *tss.PartyID, isBroadcast bool) (bool, *tss.Error) {
+	msg, err := tss.ParseWireMessage(wireBytes, from, isBroadcast)
+	if err != nil {
+		return false, p.WrapError(err)
+	}
+	return p.Update(msg)
+}
+
+func (p *LocalParty) ValidateMessage(msg tss.ParsedMessage) (bool, *tss.Error) {
+	if ok, err := p.BaseParty.ValidateMessage(msg); !ok || err != nil {
+		return ok, err
+	}
+	// check that the message's "from index" will fit into the array
+	if maxFromIdx := p.params.PartyCount() - 1; maxFromIdx < msg.GetFrom().Index {
+		return false, p.WrapError(fmt.Errorf("received msg with a sender index too great (%d <= %d)",
+			p.params.PartyCount(), msg.GetFrom().Index), msg.GetFrom())
+	}
+	// the party being repaired only sends its new Paillier key and NTilde, and the helpers send everything else
+	_, isLostMsg := msg.Content().(*RPRound2Message2)
+	if isLostMsg != (msg.GetFrom().Index == p.temp.lostIdx) {
+		return false, p.WrapError(fmt.Errorf("received msg from the wrong role in the repair: %s", msg), msg.GetFrom())
+	}
+	return true, nil
+}
+
+func (p *LocalParty) StoreMessage(msg tss.ParsedMessage) (bool, *tss.Error) {
+	// ValidateBasic is cheap; double-check the message here in case the public StoreMessage was called externally
+	if ok, err := p.ValidateMessage(msg); !ok || err != nil {
+		return ok, err
+	}
+	fromPIdx := msg.GetFrom().Index
+
+	// switch/case is necessary to store any messages beyond current round
+	// this does not handle message replays. we expect the caller to apply replay and spoofing protection.
+	switch msg.Content().(type) {
+	case *RPRound1Message1:
+		p.temp.rpRound1Message1s[fromPIdx] = msg
+	case *RPRound1Message2:
+		p.temp.rpRound1Message2s[fromPIdx] = msg
+	case *RPRound2Message1:
+		p.temp.rpRound2Message1s[fromPIdx] = msg
+	case *RPRound2Message2:
+		p.temp.rpRound2Message2s[fromPIdx] = msg
+	default: // unrecognised message, just ignore!
+		common.Logger.Warningf("unrecognised message ignored: %v", msg)
+		return false, nil
+	}
+	return true, nil
+}
+
+// WipeTempData overwrites the repair parts dealt and received and their sums. The repaired share saved in round 3 is
+// a copy and is kept.
+func (p *LocalParty) WipeTempData() {
+	common.WipeInts(p.temp.parts...)
+	common.WipeInts(p.temp.sum)
+	for _, msg := range p.temp.rpRound1Message1s {
+		if msg != nil {
+			common.WipeBytes(msg.Content().(*RPRound1Message1).Share)
+		}
+	}
+	for _, msg := range p.temp.rpRound2Message1s {
+		if msg != nil {
+			common.WipeBytes(msg.Content().(*RPRound2Message1).Share)
+		}
+	}
+}
+
+func (p *LocalParty) PartyID() *tss.PartyID {
+	return p.params.PartyID()
+}
+
+func (p *LocalParty) String() string {
+	return fmt.Sprintf("id: %s, %s", p.PartyID(), p.BaseParty.String())
+}
@@ -0,0 +1,259 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package repair_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ipfs/go-log"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bnb-chain/tss-lib/common"
+	"github.com/bnb-chain/tss-lib/ecdsa/keygen"
+	. "github.com/bnb-chain/tss-lib/ecdsa/repair"
+	"github.com/bnb-chain/tss-lib/ecdsa/signing"
+	"github.com/bnb-chain/tss-lib/test"
+	"github.com/bnb-chain/tss-lib/tss"
+)
+
+const (
+	testThreshold = test.TestThreshold
+)
+
+func setUp(level string) {
+	if err := log.SetLogLevel("tss-lib", level); err != nil {
+		panic(err)
+	}
+}
+
+// startParties starts the repair of the share of pIDs[lostIdx], which has lost its key data, by the other parties.
+// the party being repaired takes up its old pre-params again, which saves generating new ones in the tests
+func startParties(keys []keygen.LocalPartySaveData, pIDs tss.SortedPartyIDs, lostIdx int, errCh chan *tss.Error, outCh chan tss.Message, endCh chan keygen.LocalPartySaveData) []*LocalParty {
+	p2pCtx := tss.NewPeerContext(pIDs)
+	parties := make([]*LocalParty, 0, len(pIDs))
+	for j, pID := range pIDs {
+		params := tss.NewParameters(tss.S256(), p2pCtx, pID, len(pIDs), testThreshold)
+		var P *LocalParty
+		if j == lostIdx {
+			P = NewLocalParty(params, keygen.LocalPartySaveData{}, pIDs[lostIdx], outCh, endCh, keys[j].LocalPreParams).(*LocalParty)
+		} else {
+			P = NewLocalParty(params, keys[j], pIDs[lostIdx], outCh, endCh).(*LocalParty)
+		}
+		parties = append(parties, P)
+		go func(P *LocalParty) {
+			if err := P.Start(); err != nil {
+				errCh <- err
+			}
+		}(P)
+	}
+	return parties
+}
+
+func deliver(parties []*LocalParty, msg tss.Message, errCh chan *tss.Error) {
+	dest := msg.GetTo()
+	if dest == nil {
+		for _, P := range parties {
+			if P.PartyID().Index != msg.GetFrom().Index {
+				go test.SharedPartyUpdater(P, msg, errCh)
+			}
+		}
+	} else {
+		go test.SharedPartyUpdater(parties[dest[0].Index], msg, errCh)
+	}
+}
+
+func TestE2EConcurrent(t *testing.T) {
+	setUp("info")
+
+	// PHASE: load keygen fixtures; t+1 holders help the party at lostIdx, whose key data is lost
+	keys, pIDs, err := keygen.LoadKeygenTestFixtures(testThreshold + 2)
+	assert.NoError(t, err, "should load keygen fixtures")
+	lostIdx := 3
+	lostXi := new(big.Int).Set(keys[lostIdx].Xi)
+
+	// PHASE: repair
+	errCh := make(chan *tss.Error, len(pIDs))
+	outCh := make(chan tss.Message, len(pIDs))
+	endCh := make(chan keygen.LocalPartySaveData, len(pIDs))
+	parties := startParties(keys, pIDs, lostIdx, errCh, outCh, endCh)
+
+	for ended := 0; ended < len(pIDs); {
+		select {
+		case err := <-errCh:
+			common.Logger.Errorf("Error: %s", err)
+			assert.FailNow(t, err.Error())
+			return
+		case msg := <-outCh:
+			deliver(parties, msg, errCh)
+		case save := <-endCh:
+			index := -1
+			for j, pID := range pIDs {
+				if pID.KeyInt().Cmp(save.ShareID) == 0 {
+					index = j
+				}
+			}
+			assert.Equal(t, keys[index].Xi, save.Xi, "the share should be repaired or kept")
+			assert.Equal(t, keys[index].Ks, save.Ks)
+			assert.True(t, keys[index].ECDSAPub.Equals(save.ECDSAPub))
+			for j, Xj := range save.BigXj {
+				assert.True(t, keys[index].BigXj[j].Equals(Xj))
+			}
+			assert.Equal(t, keys[index].PaillierSK.N, save.PaillierSK.N)
+			for j, pk := range save.PaillierPKs {
+				assert.Equal(t, keys[index].PaillierPKs[j].N, pk.N, "every party should agree on the Paillier keys")
+				assert.Equal(t, keys[index].NTildej[j], save.NTildej[j], "every party should agree on NTilde")
+			}
+			if index == lostIdx {
+				assert.Equal(t, lostXi, save.Xi, "the lost share should be repaired")
+				assert.True(t, save.ValidateWithProof(), "the pre-params should be saved")
+			}
+			ended++
+		}
+	}
+}
+
+func TestE2EBadSumIsDetected(t *testing.T) {
+	setUp("info")
+	keys, pIDs, err := keygen.LoadKeygenTestFixtures(testThreshold + 2)
+	assert.NoError(t, err, "should load keygen fixtures")
+	lostIdx := 0
+
+	errCh := make(chan *tss.Error, len(pIDs))
+	outCh := make(chan tss.Message, len(pIDs))
+	endCh := make(chan keygen.LocalPartySaveData, len(pIDs))
+	parties := startParties(keys, pIDs, lostIdx, errCh, outCh, endCh)
+
+	// P1 sends a wrong sum to the party being repaired, which must not save the share; the helpers keep their key data
+	for ended, errs := 0, 0; ended < len(pIDs)-1 || errs < 1; {
+		select {
+		case err := <-errCh:
+			assert.Equal(t, 3, err.Round())
+			assert.Equal(t, pIDs[lostIdx], err.Victim())
+			errs++
+		case msg := <-outCh:
+			if r2msg1, ok := msg.(tss.ParsedMessage).Content().(*RPRound2Message1); ok && msg.GetFrom().Index == 1 {
+				msg = NewRPRound2Message1(msg.GetTo()[0], msg.GetFrom(), new(big.Int).Add(r2msg1.UnmarshalShare(), big.NewInt(1)))
+			}
+			deliver(parties, msg, errCh)
+		case save := <-endCh:
+			assert.NotEqual(t, pIDs[lostIdx].KeyInt(), save.ShareID)
+			ended++
+		}
+	}
+}
+
+func TestE2ERepairedPartySignsWithNonHelper(t *testing.T) {
+	setUp("info")
+
+	// PHASE: load keygen fixtures; t+1 holders help the party at lostIdx, and the last holder does not take part
+	keys, pIDs, err := keygen.LoadKeygenTestFixtures(testThreshold + 3)
+	assert.NoError(t, err, "should load keygen fixtures")
+	lostIdx, otherIdx := 3, len(pIDs)-1
+	repairPIDs := pIDs[:otherIdx]
+	// the party being repaired gets a new NTilde: its old one with h1 and h2 swapped, which saves generating primes
+	preParams := keys[lostIdx].LocalPreParams
+	preParams.H1i, preParams.H2i = preParams.H2i, preParams.H1i
+	preParams.Alpha, preParams.Beta = preParams.Beta, preParams.Alpha
+
+	// PHASE: repair
+	p2pCtx := tss.NewPeerContext(repairPIDs)
+	errCh := make(chan *tss.Error, len(repairPIDs))
+	outCh := make(chan tss.Message, len(repairPIDs))
+	endCh := make(chan keygen.LocalPartySaveData, len(repairPIDs))
+	parties := make([]*LocalParty, 0, len(repairPIDs))
+	for j, pID := range repairPIDs {
+		params := tss.NewParameters(tss.S256(), p2pCtx, pID, len(repairPIDs), testThreshold)
+		var P *LocalParty
+		if j == lostIdx {
+			P = NewLocalParty(params, keygen.LocalPartySaveData{}, repairPIDs[lostIdx], outCh, endCh, preParams).(*LocalParty)
+		} else {
+			P = NewLocalParty(params, keys[j], repairPIDs[lostIdx], outCh, endCh).(*LocalParty)
+		}
+		parties = append(parties, P)
+		go func(P *LocalParty) {
+			if err := P.Start(); err != nil {
+				errCh <- err
+			}
+		}(P)
+	}
+	saves := make(map[string]keygen.LocalPartySaveData, len(pIDs))
+	var lostMsg tss.Message
+	for len(saves) < len(repairPIDs) {
+		select {
+		case err := <-errCh:
+			assert.FailNow(t, err.Error())
+		case msg := <-outCh:
+			if _, ok := msg.(tss.ParsedMessage).Content().(*RPRound2Message2); ok {
+				lostMsg = msg
+			}
+			deliver(parties, msg, errCh)
+		case save := <-endCh:
+			saves[save.ShareID.String()] = save
+		}
+	}
+
+	// PHASE: the holder that did not help takes up the new NTilde of the repaired party
+	params := tss.NewParameters(tss.S256(), tss.NewPeerContext(pIDs), pIDs[otherIdx], len(pIDs), testThreshold)
+	other, err := UpdateKeyData(params, keys[otherIdx], pIDs[lostIdx], lostMsg.(tss.ParsedMessage))
+	if !assert.NoError(t, err) {
+		return
+	}
+	l := -1
+	for j, k := range other.Ks {
+		if k.Cmp(pIDs[lostIdx].KeyInt()) == 0 {
+			l = j
+		}
+	}
+	assert.Equal(t, preParams.H1i, other.H1j[l], "the new h1 should be taken up")
+	assert.NotEqual(t, keys[otherIdx].H1j[l], other.H1j[l])
+	saves[other.ShareID.String()] = other
+
+	// PHASE: the repaired party signs with the holder that did not help and t-1 helpers
+	signers := tss.UnSortedPartyIDs{pIDs[lostIdx], pIDs[otherIdx]}
+	for j := 0; len(signers) < testThreshold+1; j++ {
+		if j != lostIdx {
+			signers = append(signers, pIDs[j])
+		}
+	}
+	signPIDs := tss.SortPartyIDs(signers)
+	signP2pCtx := tss.NewPeerContext(signPIDs)
+	signErrCh := make(chan *tss.Error, len(signPIDs))
+	signOutCh := make(chan tss.Message, len(signPIDs))
+	signEndCh := make(chan common.SignatureData, len(signPIDs))
+	signParties := make([]*signing.LocalParty, 0, len(signPIDs))
+	for _, signPID := range signPIDs {
+		params := tss.NewParameters(tss.S256(), signP2pCtx, signPID, len(signPIDs), testThreshold)
+		P := signing.NewLocalParty(big.NewInt(42), params, saves[signPID.KeyInt().String()], signOutCh, signEndCh).(*signing.LocalParty)
+		signParties = append(signParties, P)
+		go func(P *signing.LocalParty) {
+			if err := P.Start(); err != nil {
+				signErrCh <- err
+			}
+		}(P)
+	}
+	for ended := 0; ended < len(signPIDs); {
+		select {
+		case err := <-signErrCh:
+			assert.FailNow(t, err.Error())
+		case msg := <-signOutCh:
+			dest := msg.GetTo()
+			if dest == nil {
+				for _, P := range signParties {
+					if P.PartyID().Index != msg.GetFrom().Index {
+						go test.SharedPartyUpdater(P, msg, signErrCh)
+					}
+				}
+			} else {
+				go test.SharedPartyUpdater(signParties[dest[0].Index], msg, signErrCh)
+			}
+		case <-signEndCh:
+			// the signature is checked against the public key of the saved data before it is output
+			ended++
+		}
+	}
+}
@@ -0,0 +1,234 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package repair
+
+import (
+	"crypto/elliptic"
+	"math/big"
+
+	"github.com/bnb-chain/tss-lib/common"
+	"github.com/bnb-chain/tss-lib/crypto"
+	"github.com/bnb-chain/tss-lib/crypto/dlnproof"
+	"github.com/bnb-chain/tss-lib/crypto/paillier"
+	"github.com/bnb-chain/tss-lib/ecdsa/keygen"
+	"github.com/bnb-chain/tss-lib/tss"
+)
+
+// These messages were generated from Protocol Buffers definitions into ecdsa-repair.pb.go
+
+var (
+	// Ensure that repair messages implement ValidateBasic
+	_ = []tss.MessageContent{
+		(*RPRound1Message1)(nil),
+		(*RPRound1Message2)(nil),
+		(*RPRound2Message1)(nil),
+		(*RPRound2Message2)(nil),
+	}
+)
+
+// ----- //
+
+func NewRPRound1Message1(
+	to, from *tss.PartyID,
+	part *big.Int,
+) tss.ParsedMessage {
+	meta := tss.MessageRouting{
+		From:        from,
+		To:          []*tss.PartyID{to},
+		IsBroadcast: false,
+	}
+	content := &RPRound1Message1{
+		Share: part.Bytes(),
+	}
+	msg := tss.NewMessageWrapper(meta, content)
+	return tss.NewMessage(meta, content, msg)
+}
+
+func (m *RPRound1Message1) ValidateBasic() bool {
+	return m != nil
+}
+
+func (m *RPRound1Message1) UnmarshalShare() *big.Int {
+	return new(big.Int).SetBytes(m.GetShare())
+}
+
+// ----- //
+
+// NewRPRound1Message2 returns the message that gives the public data of `key` to the party being repaired
+func NewRPRound1Message2(
+	to, from *tss.PartyID,
+	key *keygen.LocalPartySaveData,
+) (tss.ParsedMessage, error) {
+	meta := tss.MessageRouting{
+		From:        from,
+		To:          []*tss.PartyID{to},
+		IsBroadcast: false,
+	}
+	flatBigXj, err := crypto.FlattenECPoints(key.BigXj)
+	if err != nil {
+		return nil, err
+	}
+	paillierNs := make([]*big.Int, len(key.PaillierPKs))
+	for j, pk := range key.PaillierPKs {
+		paillierNs[j] = pk.N
+	}
+	content := &RPRound1Message2{
+		Ks:             common.BigIntsToBytes(key.Ks),
+		BigXj:          common.BigIntsToBytes(flatBigXj),
+		PaillierN:      common.BigIntsToBytes(paillierNs),
+		NTilde:         common.BigIntsToBytes(key.NTildej),
+		H1:             common.BigIntsToBytes(key.H1j),
+		H2:             common.BigIntsToBytes(key.H2j),
+		EcdsaPubX:      key.ECDSAPub.X().Bytes(),
+		EcdsaPubY:      key.ECDSAPub.Y().Bytes(),
+		ChainCode:      key.ChainCode,
+		DisqualifiedKs: common.BigIntsToBytes(key.DisqualifiedKs),
+	}
+	msg := tss.NewMessageWrapper(meta, content)
+	return tss.NewMessage(meta, content, msg), nil
+}
+
+func (m *RPRound1Message2) ValidateBasic() bool {
+	return m != nil &&
+		common.NonEmptyMultiBytes(m.Ks) &&
+		common.NonEmptyMultiBytes(m.BigXj, len(m.Ks)*2) &&
+		common.NonEmptyMultiBytes(m.PaillierN, len(m.Ks)) &&
+		common.NonEmptyMultiBytes(m.NTilde, len(m.Ks)) &&
+		common.NonEmptyMultiBytes(m.H1, len(m.Ks)) &&
+		common.NonEmptyMultiBytes(m.H2, len(m.Ks)) &&
+		common.NonEmptyBytes(m.EcdsaPubX) &&
+		common.NonEmptyBytes(m.EcdsaPubY) &&
+		(len(m.ChainCode) == 0 || len(m.ChainCode) == keygen.ChainCodeLen)
+}
+
+// UnmarshalKeyData returns key data holding the public data in the message, without any secrets
+func (m *RPRound1Message2) UnmarshalKeyData(ec elliptic.Curve) (keygen.LocalPartySaveData, error) {
+	key := keygen.NewLocalPartySaveData(len(m.GetKs()))
+	key.Ks = common.MultiBytesToBigInts(m.GetKs())
+	var err error
+	if key.BigXj, err = crypto.UnFlattenECPoints(ec, common.MultiBytesToBigInts(m.GetBigXj())); err != nil {
+		return key, err
+	}
+	for j, N := range common.MultiBytesToBigInts(m.GetPaillierN()) {
+		key.PaillierPKs[j] = &paillier.PublicKey{N: N}
+	}
+	key.NTildej = common.MultiBytesToBigInts(m.GetNTilde())
+	key.H1j, key.H2j = common.MultiBytesToBigInts(m.GetH1()), common.MultiBytesToBigInts(m.GetH2())
+	if key.ECDSAPub, err = crypto.NewECPoint(ec, new(big.Int).SetBytes(m.GetEcdsaPubX()), new(big.Int).SetBytes(m.GetEcdsaPubY())); err != nil {
+		return key, err
+	}
+	key.ChainCode = m.GetChainCode()
+	if len(m.GetDisqualifiedKs()) > 0 {
+		key.DisqualifiedKs = common.MultiBytesToBigInts(m.GetDisqualifiedKs())
+	}
+	return key, nil
+}
+
+// ----- //
+
+func NewRPRound2Message1(
+	to, from *tss.PartyID,
+	sum *big.Int,
+) tss.ParsedMessage {
+	meta := tss.MessageRouting{
+		From:        from,
+		To:          []*tss.PartyID{to},
+		IsBroadcast: false,
+	}
+	content := &RPRound2Message1{
+		Share: sum.Bytes(),
+	}
+	msg := tss.NewMessageWrapper(meta, content)
+	return tss.NewMessage(meta, content, msg)
+}
+
+func (m *RPRound2Message1) ValidateBasic() bool {
+	return m != nil
+}
+
+func (m *RPRound2Message1) UnmarshalShare() *big.Int {
+	return new(big.Int).SetBytes(m.GetShare())
+}
+
+// ----- //
+
+func NewRPRound2Message2(
+	from *tss.PartyID,
+	paillierPK *paillier.PublicKey,
+	paillierPf paillier.Proof,
+	NTildei, H1i, H2i *big.Int,
+	dlnProof1, dlnProof2 *dlnproof.Proof,
+) (tss.ParsedMessage, error) {
+	meta := tss.MessageRouting{
+		From:        from,
+		IsBroadcast: true,
+	}
+	dlnProof1Bz, err := dlnProof1.Serialize()
+	if err != nil {
+		return nil, err
+	}
+	dlnProof2Bz, err := dlnProof2.Serialize()
+	if err != nil {
+		return nil, err
+	}
+	content := &RPRound2Message2{
+		PaillierN:     paillierPK.N.Bytes(),
+		PaillierProof: common.BigIntsToBytes(paillierPf[:]),
+		NTilde:        NTildei.Bytes(),
+		H1:            H1i.Bytes(),
+		H2:            H2i.Bytes(),
+		Dlnproof_1:    dlnProof1Bz,
+		Dlnproof_2:    dlnProof2Bz,
+	}
+	msg := tss.NewMessageWrapper(meta, content)
+	return tss.NewMessage(meta, content, msg), nil
+}
+
+func (m *RPRound2Message2) ValidateBasic() bool {
+	return m != nil &&
+		common.NonEmptyMultiBytes(m.PaillierProof, paillier.ProofIters) &&
+		common.NonEmptyBytes(m.PaillierN) &&
+		common.NonEmptyBytes(m.NTilde) &&
+		common.NonEmptyBytes(m.H1) &&
+		common.NonEmptyBytes(m.H2) &&
+		// expected len of dln proof = sizeof(int64) + len(alpha) + len(t)
+		common.NonEmptyMultiBytes(m.GetDlnproof_1(), 2+(dlnproof.Iterations*2)) &&
+		common.NonEmptyMultiBytes(m.GetDlnproof_2(), 2+(dlnproof.Iterations*2))
+}
+
+func (m *RPRound2Message2) UnmarshalPaillierPK() *paillier.PublicKey {
+	return &paillier.PublicKey{
+		N: new(big.Int).SetBytes(m.GetPaillierN()),
+	}
+}
+
+func (m *RPRound2Message2) UnmarshalNTilde() *big.Int {
+	return new(big.Int).SetBytes(m.GetNTilde())
+}
+
+func (m *RPRound2Message2) UnmarshalH1() *big.Int {
+	return new(big.Int).SetBytes(m.GetH1())
+}
+
+func (m *RPRound2Message2) UnmarshalH2() *big.Int {
+	return new(big.Int).SetBytes(m.GetH2())
+}
+
+func (m *RPRound2Message2) UnmarshalPaillierProof() paillier.Proof {
+	var pf paillier.Proof
+	ints := common.MultiBytesToBigInts(m.GetPaillierProof())
+	copy(pf[:], ints[:paillier.ProofIters])
+	return pf
+}
+
+func (m *RPRound2Message2) UnmarshalDLNProof1() (*dlnproof.Proof, error) {
+	return dlnproof.UnmarshalDLNProof(m.GetDlnproof_1())
+}
+
+func (m *RPRound2Message2) UnmarshalDLNProof2() (*dlnproof.Proof, error) {
+	return dlnproof.UnmarshalDLNProof(m.GetDlnproof_2())
+}
@@ -0,0 +1,158 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package repair
+
+import (
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"sync"
+
+	"github.com/bnb-chain/tss-lib/common"
+	"github.com/bnb-chain/tss-lib/crypto"
+	"github.com/bnb-chain/tss-lib/crypto/paillier"
+	"github.com/bnb-chain/tss-lib/crypto/transcript"
+	"github.com/bnb-chain/tss-lib/crypto/vss"
+	"github.com/bnb-chain/tss-lib/ecdsa/keygen"
+	"github.com/bnb-chain/tss-lib/tss"
+)
+
+func (round *round3) Start() *tss.Error {
+	if round.started {
+		return round.WrapError(errors.New("round already started"))
+	}
+	round.number = 3
+	round.started = true
+	round.resetOK()
+
+	Pi := round.PartyID()
+
+	// the helpers keep their shares and take up the new Paillier key and NTilde of the party being repaired
+	if !round.isLost() {
+		key, err := round.updateLostKeys()
+		if err != nil {
+			return err
+		}
+		round.end <- key
+		return nil
+	}
+
+	// 1. add up the sums from every helper to recover the lost share
+	helpers := round.helpers()
+	sums := make([]*big.Int, len(helpers))
+	for j, Pj := range helpers {
+		sums[j] = round.temp.rpRound2Message1s[Pj.Index].Content().(*RPRound2Message1).UnmarshalShare()
+	}
+	xi, err := vss.SumRepairParts(round.Params().EC(), sums)
+	if err != nil {
+		return round.WrapError(err, Pi)
+	}
+
+	// 2. the share must match its public share. the helper that sent a bad part cannot be told from the sums
+	if !crypto.ScalarBaseMult(round.Params().EC(), xi).Equals(round.save.BigXj[keyIndex(round.save.Ks, Pi.KeyInt())]) {
+		return round.WrapError(errors.New("the repaired share does not match its public share"))
+	}
+
+	// for this P: SAVE data
+	round.save.ShareID = Pi.KeyInt()
+	round.save.Xi = xi
+	round.end <- *round.save
+	return nil
+}
+
+func (round *round3) CanAccept(msg tss.ParsedMessage) bool {
+	return false
+}
+
+func (round *round3) Update() (bool, *tss.Error) {
+	return false, nil
+}
+
+func (round *round3) NextRound() tss.Round {
+	return nil // finished!
+}
+
+// ----- //
+
+// updateLostKeys checks the proofs of the new Paillier key and NTilde, h1, h2 of the party being repaired, then
+// returns a copy of the key data of this helper that holds them in place of the lost ones
+func (round *round3) updateLostKeys() (keygen.LocalPartySaveData, *tss.Error) {
+	lost := round.Parties().IDs()[round.temp.lostIdx]
+	r2msg2 := round.temp.rpRound2Message2s[round.temp.lostIdx].Content().(*RPRound2Message2)
+	key, err := updateLostKeys(round.Params(), *round.input, lost, r2msg2)
+	if err != nil {
+		return key, round.WrapError(err, lost)
+	}
+	return key, nil
+}
+
+// updateLostKeys checks the proofs of the new Paillier key and NTilde, h1, h2 of `lost` in `r2msg2`, then returns a
+// copy of `key` that holds them in place of the lost ones
+func updateLostKeys(params *tss.Parameters, key keygen.LocalPartySaveData, lost *tss.PartyID, r2msg2 *RPRound2Message2) (keygen.LocalPartySaveData, error) {
+	tr := func() *transcript.Transcript { return transcript.ForProver(params, TaskName, lost) }
+	paiPK, NTildej, H1j, H2j :=
+		r2msg2.UnmarshalPaillierPK(),
+		r2msg2.UnmarshalNTilde(),
+		r2msg2.UnmarshalH1(),
+		r2msg2.UnmarshalH2()
+
+	// 1. verify the proofs
+	dlnVerifier := keygen.NewDlnProofVerifier(params.Concurrency())
+	var paiProofOK, dlnProof1OK, dlnProof2OK bool
+	wg := new(sync.WaitGroup)
+	wg.Add(3)
+	go func() {
+		ok, err := r2msg2.UnmarshalPaillierProof().Verify(tr(), paiPK.N, lost.KeyInt(), key.ECDSAPub)
+		if err != nil {
+			common.Logger.Warningf("paillier verify failed for party %s: %v", lost, err)
+		}
+		paiProofOK = err == nil && ok
+		wg.Done()
+	}()
+	dlnVerifier.VerifyDLNProof1(tr(), r2msg2, H1j, H2j, NTildej, func(isValid bool) {
+		dlnProof1OK = isValid
+		wg.Done()
+	})
+	dlnVerifier.VerifyDLNProof2(tr(), r2msg2, H2j, H1j, NTildej, func(isValid bool) {
+		dlnProof2OK = isValid
+		wg.Done()
+	})
+	wg.Wait()
+	if !paiProofOK || !dlnProof1OK || !dlnProof2OK {
+		return key, errors.New("paillier or dln proof verification failed")
+	}
+
+	// 2. replace the lost keys in copies of the slices, so that the input is left as it was
+	l := keyIndex(key.Ks, lost.KeyInt())
+	key.PaillierPKs = append([]*paillier.PublicKey(nil), key.PaillierPKs...)
+	key.NTildej = append([]*big.Int(nil), key.NTildej...)
+	key.H1j = append([]*big.Int(nil), key.H1j...)
+	key.H2j = append([]*big.Int(nil), key.H2j...)
+	key.PaillierPKs[l] = paiPK
+	key.NTildej[l] = NTildej
+	key.H1j[l], key.H2j[l] = H1j, H2j
+
+	// 3. the h1j, h2j of all of the shares must still be unique once the lost ones are replaced
+	if H1j.Cmp(H2j) == 0 {
+		return key, errors.New("h1j and h2j were equal for this party")
+	}
+	h1H2Map := make(map[string]struct{}, len(key.Ks)*2)
+	for j := range key.Ks {
+		if j == l {
+			continue
+		}
+		h1H2Map[hex.EncodeToString(key.H1j[j].Bytes())] = struct{}{}
+		h1H2Map[hex.EncodeToString(key.H2j[j].Bytes())] = struct{}{}
+	}
+	if _, found := h1H2Map[hex.EncodeToString(H1j.Bytes())]; found {
+		return key, errors.New("this h1j was already used by another party")
+	}
+	if _, found := h1H2Map[hex.EncodeToString(H2j.Bytes())]; found {
+		return key, errors.New("this h2j was already used by another party")
+	}
+	return key, nil
+}
@@ -7,6 +7,7 @@
 package resharing
 
 import (
+	"errors"
 	"fmt"
 	"math/big"
 
@@ -57,11 +58,15 @@ type (
 		newXi     *big.Int
 		newKs     []*big.Int
 		newBigXjs []*crypto.ECPoint // Xj to save in round 5
+
+		// pre-params are taken from this pool in round 2 when it is set and none were provided in `key`
+		preParamsPool *keygen.PreParamsPool
 	}
 )
 
 // Exported, used in `tss` client
 // The `key` is read from and/or written to depending on whether this party is part of the old or the new committee.
+// A party listed with the same key in both committees plays both roles and routes the messages between them internally.
 // You may optionally generate and set the LocalPreParams if you would like to use pre-generated safe primes and Paillier secret.
 // (This is similar to providing the `optionalPreParams` to `keygen.LocalParty`).
 func NewLocalParty(
@@ -98,6 +103,23 @@ func NewLocalParty(
 	return p
 }
 
+// NewLocalPartyWithPreParamsPool returns a party that, as a member of the new committee, takes its pre-params from
+// `pool` in round 2 instead of generating them inline. Pre-params already set in `key` take precedence.
+func NewLocalPartyWithPreParamsPool(
+	params *tss.ReSharingParameters,
+	key keygen.LocalPartySaveData,
+	pool *keygen.PreParamsPool,
+	out chan<- tss.Message,
+	end chan<- keygen.LocalPartySaveData,
+) tss.Party {
+	if pool == nil {
+		panic(errors.New("resharing.NewLocalPartyWithPreParamsPool expected a non-nil `pool`"))
+	}
+	p := NewLocalParty(params, key, out, end).(*LocalParty)
+	p.temp.preParamsPool = pool
+	return p
+}
+
 func (p *LocalParty) FirstRound() tss.Round {
 	return newRound1(p.params, &p.input, &p.save, &p.temp, p.out, p.end)
 }
@@ -122,17 +144,9 @@ func (p *LocalParty) ValidateMessage(msg tss.ParsedMessage) (bool, *tss.Error) {
 	if ok, err := p.BaseParty.ValidateMessage(msg); !ok || err != nil {
 		return ok, err
 	}
-	// check that the message's "from index" will fit into the array
-	var maxFromIdx int
-	switch msg.Content().(type) {
-	case *DGRound2Message1, *DGRound2Message2, *DGRound4Message:
-		maxFromIdx = len(p.params.NewParties().IDs()) - 1
-	default:
-		maxFromIdx = len(p.params.OldParties().IDs()) - 1
-	}
-	if maxFromIdx < msg.GetFrom().Index {
-		return false, p.WrapError(fmt.Errorf("received msg with a sender index too great (%d <= %d)",
-			maxFromIdx, msg.GetFrom().Index), msg.GetFrom())
+	// check that the sender is a member of the committee that sends this type of message
+	if p.senderIndex(msg) < 0 {
+		return false, p.WrapError(fmt.Errorf("received msg from a party outside the sending committee: %s", msg), msg.GetFrom())
 	}
 	return true, nil
 }
@@ -142,7 +156,7 @@ func (p *LocalParty) StoreMessage(msg tss.ParsedMessage) (bool, *tss.Error) {
 	if ok, err := p.ValidateMessage(msg); !ok || err != nil {
 		return ok, err
 	}
-	fromPIdx := msg.GetFrom().Index
+	fromPIdx := p.senderIndex(msg)
 
 	// switch/case is necessary to store any messages beyond current round
 	// this does not handle message replays. we expect the caller to apply replay and spoofing protection.
@@ -166,6 +180,31 @@ func (p *LocalParty) StoreMessage(msg tss.ParsedMessage) (bool, *tss.Error) {
 	return true, nil
 }
 
+// senderIndex returns the index of the sender of `msg` in the committee that sends this type of message, or -1 if it is
+// not a member of it. It is looked up by key because a party in both committees has a different index in each.
+func (p *LocalParty) senderIndex(msg tss.ParsedMessage) int {
+	var committee tss.SortedPartyIDs
+	switch msg.Content().(type) {
+	case *DGRound2Message1, *DGRound2Message2, *DGRound4Message:
+		committee = p.params.NewParties().IDs()
+	default:
+		committee = p.params.OldParties().IDs()
+	}
+	return committee.FindIndexByKey(msg.GetFrom().KeyInt())
+}
+
+// WipeTempData overwrites the shares dealt to the new committee and those received from the old committee. The new
+// share saved in round 5 is a copy and is kept.
+func (p *LocalParty) WipeTempData() {
+	p.temp.NewShares.Wipe()
+	common.WipeInts(p.temp.newXi)
+	for _, msg := range p.temp.dgRound3Message1s {
+		if msg != nil {
+			common.WipeBytes(msg.Content().(*DGRound3Message1).Share)
+		}
+	}
+}
+
 func (p *LocalParty) PartyID() *tss.PartyID {
 	return p.params.PartyID()
 }
@@ -7,6 +7,7 @@
 package resharing
 
 import (
+	"context"
 	"errors"
 
 	"github.com/bnb-chain/tss-lib/crypto/dlnproof"
@@ -28,7 +29,7 @@ func (round *round2) Start() *tss.Error {
 	}
 
 	Pi := round.PartyID()
-	i := Pi.Index
+	i := round.NewPartyIndex()
 
 	// 2. "broadcast" "ACK" members of the OLD committee
 	r2msg1 := NewDGRound2Message2(
@@ -47,9 +48,19 @@ func (round *round2) Start() *tss.Error {
 			errors.New("`optionalPreParams` failed to validate; it might have been generated with an older version of tss-lib"))
 	} else if round.save.LocalPreParams.ValidateWithProof() {
 		preParams = &round.save.LocalPreParams
+	} else if round.temp.preParamsPool != nil {
+		var err error
+		ctx, cancel := context.WithTimeout(context.Background(), round.SafePrimeGenTimeout())
+		preParams, err = round.temp.preParamsPool.Take(ctx)
+		cancel()
+		if err != nil {
+			return round.WrapError(err, Pi)
+		}
 	} else {
 		var err error
-		preParams, err = keygen.GeneratePreParams(round.SafePrimeGenTimeout(), round.Concurrency())
+		ctx, cancel := context.WithTimeout(context.Background(), round.SafePrimeGenTimeout())
+		preParams, err = keygen.GeneratePreParamsWithContextAndRandom(ctx, round.Rand(), round.Concurrency())
+		cancel()
 		if err != nil {
 			return round.WrapError(errors.New("pre-params generation failed"), Pi)
 		}
@@ -67,10 +78,10 @@ func (round *round2) Start() *tss.Error {
 		preParams.P,
 		preParams.Q,
 		preParams.NTildei
-	dlnProof1 := dlnproof.NewDLNProof(h1i, h2i, alpha, p, q, NTildei)
-	dlnProof2 := dlnproof.NewDLNProof(h2i, h1i, beta, p, q, NTildei)
+	dlnProof1 := dlnproof.NewDLNProof(round.transcript(Pi), h1i, h2i, alpha, p, q, NTildei, round.Rand())
+	dlnProof2 := dlnproof.NewDLNProof(round.transcript(Pi), h2i, h1i, beta, p, q, NTildei, round.Rand())
 
-	paillierPf := preParams.PaillierSK.Proof(Pi.KeyInt(), round.save.ECDSAPub)
+	paillierPf := preParams.PaillierSK.Proof(round.transcript(Pi), Pi.KeyInt(), round.save.ECDSAPub)
 	r2msg2, err := NewDGRound2Message1(
 		round.NewParties().IDs().Exclude(round.PartyID()), round.PartyID(),
 		&preParams.PaillierSK.PublicKey, paillierPf, preParams.NTildei, preParams.H1i, preParams.H2i, dlnProof1, dlnProof2)
@@ -45,7 +45,7 @@ func (round *round4) Start() *tss.Error {
 	dlnVerifier := keygen.NewDlnProofVerifier(round.Concurrency())
 
 	Pi := round.PartyID()
-	i := Pi.Index
+	i := round.NewPartyIndex()
 
 	// 1-3. verify paillier & dln proofs, store message pieces, ensure uniqueness of h1j, h2j
 	h1H2Map := make(map[string]struct{}, len(round.temp.dgRound2Message1s)*2)
@@ -73,7 +73,7 @@ func (round *round4) Start() *tss.Error {
 		h1H2Map[h1JHex], h1H2Map[h2JHex] = struct{}{}, struct{}{}
 		wg.Add(3)
 		go func(j int, msg tss.ParsedMessage, r2msg1 *DGRound2Message1) {
-			if ok, err := r2msg1.UnmarshalPaillierProof().Verify(paiPK.N, msg.GetFrom().KeyInt(), round.save.ECDSAPub); err != nil || !ok {
+			if ok, err := r2msg1.UnmarshalPaillierProof().Verify(round.transcript(msg.GetFrom()), paiPK.N, msg.GetFrom().KeyInt(), round.save.ECDSAPub); err != nil || !ok {
 				paiProofCulprits[j] = msg.GetFrom()
 				common.Logger.Warningf("paillier verify failed for party %s", msg.GetFrom(), err)
 			}
@@ -81,14 +81,14 @@ func (round *round4) Start() *tss.Error {
 		}(j, msg, r2msg1)
 		_j := j
 		_msg := msg
-		dlnVerifier.VerifyDLNProof1(r2msg1, H1j, H2j, NTildej, func(isValid bool) {
+		dlnVerifier.VerifyDLNProof1(round.transcript(msg.GetFrom()), r2msg1, H1j, H2j, NTildej, func(isValid bool) {
 			if !isValid {
 				dlnProof1FailCulprits[_j] = _msg.GetFrom()
 				common.Logger.Warningf("dln proof 1 verify failed for party %s", _msg.GetFrom())
 			}
 			wg.Done()
 		})
-		dlnVerifier.VerifyDLNProof2(r2msg1, H2j, H1j, NTildej, func(isValid bool) {
+		dlnVerifier.VerifyDLNProof2(round.transcript(msg.GetFrom()), r2msg1, H2j, H1j, NTildej, func(isValid bool) {
 			if !isValid {
 				dlnProof2FailCulprits[_j] = _msg.GetFrom()
 				common.Logger.Warningf("dln proof 2 verify failed for party %s", _msg.GetFrom())
@@ -113,11 +113,15 @@ func (round *round4) Start() *tss.Error {
 		round.save.H2j[j] = new(big.Int).SetBytes(r2msg1.H2)
 	}
 
+	g, err := round.curveGroup()
+	if err != nil {
+		return round.WrapError(err)
+	}
+
 	// 4.
-	newXi := big.NewInt(0)
+	newXi := g.NewScalar()
 
 	// 5-9.
-	modQ := common.ModInt(round.Params().EC().Params().N)
 	vjc := make([][]*crypto.ECPoint, len(round.OldParties().IDs()))
 	for j := 0; j <= len(vjc)-1; j++ { // P1..P_t+1. Ps are indexed from 0 here
 		// 6-7.
@@ -152,11 +156,10 @@ func (round *round4) Start() *tss.Error {
 		}
 
 		// 9.
-		newXi = new(big.Int).Add(newXi, sharej.Share)
+		newXi = newXi.Add(g.ScalarFromBigInt(sharej.Share))
 	}
 
 	// 10-13.
-	var err error
 	Vc := make([]*crypto.ECPoint, round.NewThreshold()+1)
 	for c := 0; c <= round.NewThreshold(); c++ {
 		Vc[c] = vjc[0][c]
@@ -182,10 +185,10 @@ func (round *round4) Start() *tss.Error {
 		kj := Pj.KeyInt()
 		newBigXj := Vc[0]
 		newKs = append(newKs, kj)
-		z := new(big.Int).SetInt64(int64(1))
+		z := g.ScalarFromBigInt(big.NewInt(1))
 		for c := 1; c <= round.NewThreshold(); c++ {
-			z = modQ.Mul(z, kj)
-			newBigXj, err = newBigXj.Add(Vc[c].ScalarMult(z))
+			z = z.Mul(g.ScalarFromBigInt(kj))
+			newBigXj, err = newBigXj.Add(Vc[c].ScalarMult(z.BigInt()))
 			if err != nil {
 				paiProofCulprits = append(paiProofCulprits, Pj)
 			}
@@ -196,12 +199,12 @@ func (round *round4) Start() *tss.Error {
 		return round.WrapError(errors2.Wrapf(err, "newBigXj.Add(Vc[c].ScalarMult(z))"), paiProofCulprits...)
 	}
 
-	round.temp.newXi = newXi
+	round.temp.newXi = newXi.BigInt()
 	round.temp.newKs = newKs
 	round.temp.newBigXjs = newBigXjs
 
 	// Send an "ACK" message to both committees to signal that we're ready to save our data
-	r4msg := NewDGRound4Message(round.OldAndNewParties(), Pi)
+	r4msg := NewDGRound4Message(tss.SortedPartyIDs(round.OldAndNewParties()).Exclude(Pi), Pi)
 	round.temp.dgRound4Messages[i] = r4msg
 	round.out <- r4msg
 
@@ -8,6 +8,7 @@ package resharing
 
 import (
 	"errors"
+	"math/big"
 
 	"github.com/bnb-chain/tss-lib/tss"
 )
@@ -22,15 +23,14 @@ func (round *round5) Start() *tss.Error {
 	round.allOldOK()
 	round.allNewOK()
 
-	Pi := round.PartyID()
-	i := Pi.Index
+	i := round.NewPartyIndex()
 
 	if round.IsNewCommittee() {
 		// 21.
 		// for this P: SAVE data
 		round.save.BigXj = round.temp.newBigXjs
 		round.save.ShareID = round.PartyID().KeyInt()
-		round.save.Xi = round.temp.newXi
+		round.save.Xi = new(big.Int).Set(round.temp.newXi)
 		round.save.Ks = round.temp.newKs
 
 		// misc: build list of paillier public keys to save
@@ -41,7 +41,8 @@ func (round *round5) Start() *tss.Error {
 			r2msg1 := msg.Content().(*DGRound2Message1)
 			round.save.PaillierPKs[j] = r2msg1.UnmarshalPaillierPK()
 		}
-	} else if round.IsOldCommittee() {
+	}
+	if round.IsOldCommittee() {
 		round.input.Xi.SetInt64(0)
 	}
 
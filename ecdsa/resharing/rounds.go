@@ -7,6 +7,10 @@
 package resharing
 
 import (
+	"fmt"
+
+	"github.com/bnb-chain/tss-lib/crypto/group"
+	"github.com/bnb-chain/tss-lib/crypto/transcript"
 	"github.com/bnb-chain/tss-lib/ecdsa/keygen"
 	"github.com/bnb-chain/tss-lib/tss"
 )
@@ -110,6 +114,20 @@ func (round *base) WrapError(err error, culprits ...*tss.PartyID) *tss.Error {
 
 // ----- //
 
+// curveGroup returns the group of the resharing curve, in whose constant-time scalars the secret values are computed
+func (round *base) curveGroup() (group.CurveGroup, error) {
+	g, ok := group.FromCurve(round.Params().EC())
+	if !ok {
+		return nil, fmt.Errorf("no group is registered for the curve %s", round.Params().EC().Params().Name)
+	}
+	return g, nil
+}
+
+// transcript returns the Fiat-Shamir transcript for a proof made by `prover`, or nil if the legacy proofs are used
+func (round *base) transcript(prover *tss.PartyID) *transcript.Transcript {
+	return transcript.ForProver(round.Params(), TaskName, prover)
+}
+
 // `oldOK` tracks parties which have been verified by Update()
 func (round *base) resetOK() {
 	for j := range round.oldOK {
@@ -587,6 +587,7 @@ type SignRound9Message struct {
 	unknownFields protoimpl.UnknownFields
 
 	S []byte `protobuf:"bytes,1,opt,name=s,proto3" json:"s,omitempty"`
+	L []byte `protobuf:"bytes,2,opt,name=l,proto3" json:"l,omitempty"`
 }
 
 func (x *SignRound9Message) Reset() {
@@ -628,6 +629,159 @@ func (x *SignRound9Message) GetS() []byte {
 	return nil
 }
 
+func (x *SignRound9Message) GetL() []byte {
+	if x != nil {
+		return x.L
+	}
+	return nil
+}
+
+//
+// Represents a BROADCAST message sent to all parties instead of the Round 9 message when U != T, which reveals the
+// values of the sender needed to identify the party that caused it.
+type SignIdentificationMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	K              []byte   `protobuf:"bytes,1,opt,name=k,proto3" json:"k,omitempty"`
+	Gamma          []byte   `protobuf:"bytes,2,opt,name=gamma,proto3" json:"gamma,omitempty"`
+	KRandomness    [][]byte `protobuf:"bytes,3,rep,name=k_randomness,json=kRandomness,proto3" json:"k_randomness,omitempty"`
+	Alphas         [][]byte `protobuf:"bytes,4,rep,name=alphas,proto3" json:"alphas,omitempty"`
+	Betas          [][]byte `protobuf:"bytes,5,rep,name=betas,proto3" json:"betas,omitempty"`
+	UPoints        [][]byte `protobuf:"bytes,6,rep,name=u_points,json=uPoints,proto3" json:"u_points,omitempty"`
+	VPoints        [][]byte `protobuf:"bytes,7,rep,name=v_points,json=vPoints,proto3" json:"v_points,omitempty"`
+	BigS           [][]byte `protobuf:"bytes,8,rep,name=big_s,json=bigS,proto3" json:"big_s,omitempty"`
+	DleqProofAlpha [][]byte `protobuf:"bytes,9,rep,name=dleq_proof_alpha,json=dleqProofAlpha,proto3" json:"dleq_proof_alpha,omitempty"`
+	DleqProofBeta  [][]byte `protobuf:"bytes,10,rep,name=dleq_proof_beta,json=dleqProofBeta,proto3" json:"dleq_proof_beta,omitempty"`
+	DleqProofT     []byte   `protobuf:"bytes,11,opt,name=dleq_proof_t,json=dleqProofT,proto3" json:"dleq_proof_t,omitempty"`
+	Rho            []byte   `protobuf:"bytes,12,opt,name=rho,proto3" json:"rho,omitempty"`
+	L              []byte   `protobuf:"bytes,13,opt,name=l,proto3" json:"l,omitempty"`
+}
+
+func (x *SignIdentificationMessage) Reset() {
+	*x = SignIdentificationMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protob_ecdsa_signing_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SignIdentificationMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignIdentificationMessage) ProtoMessage() {}
+
+func (x *SignIdentificationMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_protob_ecdsa_signing_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignIdentificationMessage.ProtoReflect.Descriptor instead.
+func (*SignIdentificationMessage) Descriptor() ([]byte, []int) {
+	return file_protob_ecdsa_signing_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SignIdentificationMessage) GetK() []byte {
+	if x != nil {
+		return x.K
+	}
+	return nil
+}
+
+func (x *SignIdentificationMessage) GetGamma() []byte {
+	if x != nil {
+		return x.Gamma
+	}
+	return nil
+}
+
+func (x *SignIdentificationMessage) GetKRandomness() [][]byte {
+	if x != nil {
+		return x.KRandomness
+	}
+	return nil
+}
+
+func (x *SignIdentificationMessage) GetAlphas() [][]byte {
+	if x != nil {
+		return x.Alphas
+	}
+	return nil
+}
+
+func (x *SignIdentificationMessage) GetBetas() [][]byte {
+	if x != nil {
+		return x.Betas
+	}
+	return nil
+}
+
+func (x *SignIdentificationMessage) GetUPoints() [][]byte {
+	if x != nil {
+		return x.UPoints
+	}
+	return nil
+}
+
+func (x *SignIdentificationMessage) GetVPoints() [][]byte {
+	if x != nil {
+		return x.VPoints
+	}
+	return nil
+}
+
+func (x *SignIdentificationMessage) GetBigS() [][]byte {
+	if x != nil {
+		return x.BigS
+	}
+	return nil
+}
+
+func (x *SignIdentificationMessage) GetDleqProofAlpha() [][]byte {
+	if x != nil {
+		return x.DleqProofAlpha
+	}
+	return nil
+}
+
+func (x *SignIdentificationMessage) GetDleqProofBeta() [][]byte {
+	if x != nil {
+		return x.DleqProofBeta
+	}
+	return nil
+}
+
+func (x *SignIdentificationMessage) GetDleqProofT() []byte {
+	if x != nil {
+		return x.DleqProofT
+	}
+	return nil
+}
+
+func (x *SignIdentificationMessage) GetRho() []byte {
+	if x != nil {
+		return x.Rho
+	}
+	return nil
+}
+
+func (x *SignIdentificationMessage) GetL() []byte {
+	if x != nil {
+		return x.L
+	}
+	return nil
+}
+
 var File_protob_ecdsa_signing_proto protoreflect.FileDescriptor
 
 var file_protob_ecdsa_signing_proto_rawDesc = []byte{
@@ -691,9 +845,33 @@ var file_protob_ecdsa_signing_proto_rawDesc = []byte{
 	0x6f, 0x75, 0x6e, 0x64, 0x38, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x23, 0x0a, 0x0d,
 	0x64, 0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20,
 	0x03, 0x28, 0x0c, 0x52, 0x0c, 0x64, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e,
-	0x74, 0x22, 0x21, 0x0a, 0x11, 0x53, 0x69, 0x67, 0x6e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x39, 0x4d,
+	0x74, 0x22, 0x2f, 0x0a, 0x11, 0x53, 0x69, 0x67, 0x6e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x39, 0x4d,
 	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x0c, 0x0a, 0x01, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x01, 0x73, 0x42, 0x0f, 0x5a, 0x0d, 0x65, 0x63, 0x64, 0x73, 0x61, 0x2f, 0x73, 0x69,
+	0x0c, 0x52, 0x01, 0x73, 0x12, 0x0c, 0x0a, 0x01, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x01, 0x6c, 0x22, 0xef, 0x02, 0x0a, 0x19, 0x53, 0x69, 0x67, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x0c, 0x0a, 0x01, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x01, 0x6b, 0x12, 0x14,
+	0x0a, 0x05, 0x67, 0x61, 0x6d, 0x6d, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x67,
+	0x61, 0x6d, 0x6d, 0x61, 0x12, 0x21, 0x0a, 0x0c, 0x6b, 0x5f, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d,
+	0x6e, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0b, 0x6b, 0x52, 0x61, 0x6e,
+	0x64, 0x6f, 0x6d, 0x6e, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x06, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x73, 0x12,
+	0x14, 0x0a, 0x05, 0x62, 0x65, 0x74, 0x61, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x05,
+	0x62, 0x65, 0x74, 0x61, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x75, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x07, 0x75, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73,
+	0x12, 0x19, 0x0a, 0x08, 0x76, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x07, 0x20, 0x03,
+	0x28, 0x0c, 0x52, 0x07, 0x76, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x13, 0x0a, 0x05, 0x62,
+	0x69, 0x67, 0x5f, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x04, 0x62, 0x69, 0x67, 0x53,
+	0x12, 0x28, 0x0a, 0x10, 0x64, 0x6c, 0x65, 0x71, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x5f, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0e, 0x64, 0x6c, 0x65, 0x71,
+	0x50, 0x72, 0x6f, 0x6f, 0x66, 0x41, 0x6c, 0x70, 0x68, 0x61, 0x12, 0x26, 0x0a, 0x0f, 0x64, 0x6c,
+	0x65, 0x71, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x5f, 0x62, 0x65, 0x74, 0x61, 0x18, 0x0a, 0x20,
+	0x03, 0x28, 0x0c, 0x52, 0x0d, 0x64, 0x6c, 0x65, 0x71, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x42, 0x65,
+	0x74, 0x61, 0x12, 0x20, 0x0a, 0x0c, 0x64, 0x6c, 0x65, 0x71, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66,
+	0x5f, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x64, 0x6c, 0x65, 0x71, 0x50, 0x72,
+	0x6f, 0x6f, 0x66, 0x54, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x68, 0x6f, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x03, 0x72, 0x68, 0x6f, 0x12, 0x0c, 0x0a, 0x01, 0x6c, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x01, 0x6c, 0x42, 0x0f, 0x5a, 0x0d, 0x65, 0x63, 0x64, 0x73, 0x61, 0x2f, 0x73, 0x69,
 	0x67, 0x6e, 0x69, 0x6e, 0x67, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
@@ -709,18 +887,19 @@ func file_protob_ecdsa_signing_proto_rawDescGZIP() []byte {
 	return file_protob_ecdsa_signing_proto_rawDescData
 }
 
-var file_protob_ecdsa_signing_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_protob_ecdsa_signing_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
 var file_protob_ecdsa_signing_proto_goTypes = []interface{}{
-	(*SignRound1Message1)(nil), // 0: binance.tsslib.ecdsa.signing.SignRound1Message1
-	(*SignRound1Message2)(nil), // 1: binance.tsslib.ecdsa.signing.SignRound1Message2
-	(*SignRound2Message)(nil),  // 2: binance.tsslib.ecdsa.signing.SignRound2Message
-	(*SignRound3Message)(nil),  // 3: binance.tsslib.ecdsa.signing.SignRound3Message
-	(*SignRound4Message)(nil),  // 4: binance.tsslib.ecdsa.signing.SignRound4Message
-	(*SignRound5Message)(nil),  // 5: binance.tsslib.ecdsa.signing.SignRound5Message
-	(*SignRound6Message)(nil),  // 6: binance.tsslib.ecdsa.signing.SignRound6Message
-	(*SignRound7Message)(nil),  // 7: binance.tsslib.ecdsa.signing.SignRound7Message
-	(*SignRound8Message)(nil),  // 8: binance.tsslib.ecdsa.signing.SignRound8Message
-	(*SignRound9Message)(nil),  // 9: binance.tsslib.ecdsa.signing.SignRound9Message
+	(*SignRound1Message1)(nil),        // 0: binance.tsslib.ecdsa.signing.SignRound1Message1
+	(*SignRound1Message2)(nil),        // 1: binance.tsslib.ecdsa.signing.SignRound1Message2
+	(*SignRound2Message)(nil),         // 2: binance.tsslib.ecdsa.signing.SignRound2Message
+	(*SignRound3Message)(nil),         // 3: binance.tsslib.ecdsa.signing.SignRound3Message
+	(*SignRound4Message)(nil),         // 4: binance.tsslib.ecdsa.signing.SignRound4Message
+	(*SignRound5Message)(nil),         // 5: binance.tsslib.ecdsa.signing.SignRound5Message
+	(*SignRound6Message)(nil),         // 6: binance.tsslib.ecdsa.signing.SignRound6Message
+	(*SignRound7Message)(nil),         // 7: binance.tsslib.ecdsa.signing.SignRound7Message
+	(*SignRound8Message)(nil),         // 8: binance.tsslib.ecdsa.signing.SignRound8Message
+	(*SignRound9Message)(nil),         // 9: binance.tsslib.ecdsa.signing.SignRound9Message
+	(*SignIdentificationMessage)(nil), // 10: binance.tsslib.ecdsa.signing.SignIdentificationMessage
 }
 var file_protob_ecdsa_signing_proto_depIdxs = []int32{
 	0, // [0:0] is the sub-list for method output_type
@@ -856,6 +1035,18 @@ func file_protob_ecdsa_signing_proto_init() {
 				return nil
 			}
 		}
+		file_protob_ecdsa_signing_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignIdentificationMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -863,7 +1054,7 @@ func file_protob_ecdsa_signing_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_protob_ecdsa_signing_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   10,
+			NumMessages:   11,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -12,7 +12,8 @@ import (
 	"fmt"
 	"math/big"
 
-	"github.com/bnb-chain/tss-lib/common"
+	"github.com/bnb-chain/tss-lib/crypto"
+	"github.com/bnb-chain/tss-lib/crypto/group"
 	"github.com/bnb-chain/tss-lib/tss"
 )
 
@@ -24,17 +25,40 @@ func (round *finalization) Start() *tss.Error {
 	round.started = true
 	round.resetOK()
 
-	sumS := round.temp.si
-	modN := common.ModInt(round.Params().EC().Params().N)
+	g, err := round.curveGroup()
+	if err != nil {
+		return round.WrapError(err)
+	}
+	s := round.temp.si
 
-	for j := range round.Parties().IDs() {
+	for j, Pj := range round.Parties().IDs() {
 		round.ok[j] = true
 		if j == round.PartyID().Index {
 			continue
 		}
 		r9msg := round.temp.signRound9Messages[j].Content().(*SignRound9Message)
-		sumS = modN.Add(sumS, r9msg.UnmarshalS())
+		sj := r9msg.UnmarshalS()
+		s = s.Add(g.ScalarFromBigInt(sj))
+		if !r9msg.HasL() {
+			// parties running earlier versions do not send l_j, so a bad s_j only shows in the final signature
+			if round.Params().LegacyProofs() {
+				continue
+			}
+			return round.WrapError(errors.New("the partial signature of this party has no l_j"), Pj)
+		}
+		// identify a bad s_j before it spoils the signature: s_j*R + l_j*G must open the V_j committed to in round 5
+		evidence := &SignatureShareEvidence{
+			Culprit: Pj,
+			R:       round.temp.bigR,
+			Vj:      round.temp.bigVjs[j],
+			Sj:      sj,
+			Lj:      r9msg.UnmarshalL(),
+		}
+		if evidence.Proves() {
+			return round.WrapError(evidence, Pj)
+		}
 	}
+	sumS := s.BigInt()
 
 	recid := 0
 	// byte v = if(R.X > curve.N) then 2 else 0) | (if R.Y.IsEven then 0 else 1);
@@ -49,8 +73,9 @@ func (round *finalization) Start() *tss.Error {
 	// https://github.com/btcsuite/btcd/blob/c26ffa870fd817666a857af1bf6498fabba1ffe3/btcec/signature.go#L442-L444
 	// This is needed because of tendermint checks here:
 	// https://github.com/tendermint/tendermint/blob/d9481e3648450cb99e15c6a070c1fb69aa0c255b/crypto/secp256k1/secp256k1_nocgo.go#L43-L47
-	secp256k1halfN := new(big.Int).Rsh(round.Params().EC().Params().N, 1)
-	if sumS.Cmp(secp256k1halfN) > 0 {
+	// The normalization only depends on the curve order, so it applies to any curve (e.g. P-256) in the same way.
+	halfN := new(big.Int).Rsh(round.Params().EC().Params().N, 1)
+	if !round.Params().SkipLowS() && sumS.Cmp(halfN) > 0 {
 		sumS.Sub(round.Params().EC().Params().N, sumS)
 		recid ^= 1
 	}
@@ -61,14 +86,14 @@ func (round *finalization) Start() *tss.Error {
 	round.data.S = padToLengthBytesInPlace(sumS.Bytes(), bitSizeInBytes)
 	round.data.Signature = append(round.data.R, round.data.S...)
 	round.data.SignatureRecovery = []byte{byte(recid)}
-	round.data.M = round.temp.m.Bytes()
+	round.data.M = round.temp.mBytes
 
 	pk := ecdsa.PublicKey{
 		Curve: round.Params().EC(),
 		X:     round.key.ECDSAPub.X(),
 		Y:     round.key.ECDSAPub.Y(),
 	}
-	ok := ecdsa.Verify(&pk, round.temp.m.Bytes(), round.temp.rx, sumS)
+	ok := ecdsa.Verify(&pk, round.temp.mBytes, round.temp.rx, sumS)
 	if !ok {
 		return round.WrapError(fmt.Errorf("signature verification failed"))
 	}
@@ -92,6 +117,42 @@ func (round *finalization) NextRound() tss.Round {
 	return nil // finished!
 }
 
+// SignatureShareEvidence shows that a party revealed a partial signature s_j in round 9 that does not match the
+// V_j = s_j*R + l_j*G it committed to in round 5. It holds only values broadcast during signing, so that any party
+// can check it independently with Proves. A V_j that was built from a bad s_j makes U != T instead, and the party is
+// then identified in the identification round. With LegacyProofs, the check is skipped for a party that does not send
+// l_j, and no party is identified when U != T.
+type SignatureShareEvidence struct {
+	Culprit *tss.PartyID
+	R, Vj   *crypto.ECPoint
+	Sj, Lj  *big.Int
+}
+
+func (ev *SignatureShareEvidence) Error() string {
+	return fmt.Sprintf("the partial signature of party %s does not match its V_j", ev.Culprit)
+}
+
+// Proves reports whether the evidence proves that the partial signature is bad, i.e. s_j*R + l_j*G != V_j
+func (ev *SignatureShareEvidence) Proves() bool {
+	if ev.R == nil || ev.Vj == nil || ev.Sj == nil || ev.Lj == nil {
+		return false
+	}
+	g, ok := group.FromCurve(ev.R.Curve())
+	if !ok {
+		return false
+	}
+	R, err := ev.R.ToGroupPoint()
+	if err != nil {
+		return false
+	}
+	Vj, err := ev.Vj.ToGroupPoint()
+	if err != nil {
+		return false
+	}
+	opened := R.ScalarMult(g.ScalarFromBigInt(ev.Sj)).Add(g.Generator().ScalarMult(g.ScalarFromBigInt(ev.Lj)))
+	return !opened.Equal(Vj)
+}
+
 func padToLengthBytesInPlace(src []byte, length int) []byte {
 	oriLen := len(src)
 	if oriLen < length {
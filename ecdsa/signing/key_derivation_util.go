@@ -5,12 +5,14 @@ package signing
 import (
 	"crypto/ecdsa"
 	"crypto/elliptic"
+	"errors"
 	"math/big"
 
 	"github.com/bnb-chain/tss-lib/common"
 	"github.com/bnb-chain/tss-lib/crypto"
 	"github.com/bnb-chain/tss-lib/crypto/ckd"
 	"github.com/bnb-chain/tss-lib/ecdsa/keygen"
+	"github.com/bnb-chain/tss-lib/tss"
 
 	"github.com/btcsuite/btcd/chaincfg"
 )
@@ -57,3 +59,34 @@ func derivingPubkeyFromPath(masterPub *crypto.ECPoint, chainCode []byte, path []
 
 	return ckd.DeriveChildKeyFromHierarchy(path, extendedParentPk, ec.Params().N, ec)
 }
+
+// NewLocalPartyWithPath returns a party that signs with the child key at the given non-hardened BIP-32 path, e.g. "m/44/60/0/0/5".
+// The child key is derived from the public key and chain code in the save data, which is left unmodified.
+func NewLocalPartyWithPath(
+	msg *big.Int,
+	params *tss.Parameters,
+	key keygen.LocalPartySaveData,
+	path string,
+	out chan<- tss.Message,
+	end chan<- common.SignatureData,
+) (tss.Party, error) {
+	if len(key.ChainCode) != keygen.ChainCodeLen {
+		return nil, errors.New("the save data has no chain code; it was created before chain codes were generated during keygen")
+	}
+	indices, err := ckd.ParseDerivationPath(path)
+	if err != nil {
+		return nil, err
+	}
+	ec := params.EC()
+	keyDerivationDelta, extendedChildPk, err := derivingPubkeyFromPath(key.ECDSAPub, key.ChainCode, indices, ec)
+	if err != nil {
+		return nil, err
+	}
+	// copy the public shares so that adjusting them does not alter the caller's save data
+	keys := []keygen.LocalPartySaveData{key}
+	keys[0].BigXj = append([]*crypto.ECPoint(nil), key.BigXj...)
+	if err = UpdatePublicKeyAndAdjustBigXj(keyDerivationDelta, keys, &extendedChildPk.PublicKey, ec); err != nil {
+		return nil, err
+	}
+	return NewLocalPartyWithKDD(msg, params, keys[0], keyDerivationDelta, out, end), nil
+}
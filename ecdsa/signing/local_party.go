@@ -14,7 +14,9 @@ import (
 	"github.com/bnb-chain/tss-lib/common"
 	"github.com/bnb-chain/tss-lib/crypto"
 	cmt "github.com/bnb-chain/tss-lib/crypto/commitments"
+	"github.com/bnb-chain/tss-lib/crypto/group"
 	"github.com/bnb-chain/tss-lib/crypto/mta"
+	"github.com/bnb-chain/tss-lib/crypto/paillier"
 	"github.com/bnb-chain/tss-lib/ecdsa/keygen"
 	"github.com/bnb-chain/tss-lib/tss"
 )
@@ -48,49 +50,68 @@ type (
 		signRound6Messages,
 		signRound7Messages,
 		signRound8Messages,
-		signRound9Messages []tss.ParsedMessage
+		signRound9Messages,
+		signIdentificationMessages []tss.ParsedMessage
 	}
 
 	localTempData struct {
 		localMessageStore
 
 		// temp data (thrown away after sign) / round 1
-		w,
 		m,
+		keyDerivationDelta *big.Int
+		xi, // the share of this party, with the key derivation delta added when one is set
+		w,
 		k,
 		theta,
 		thetaInverse,
 		sigma,
-		keyDerivationDelta,
-		gamma *big.Int
-		cis        []*big.Int
-		bigWs      []*crypto.ECPoint
-		pointGamma *crypto.ECPoint
-		deCommit   cmt.HashDeCommitment
+		gamma group.Scalar
+		mBytes      []byte
+		cis         []*big.Int
+		kRandomness []*big.Int // the Paillier randomness of cis, revealed if U != T
+		bigWs       []*crypto.ECPoint
+		pointGamma  *crypto.ECPoint
+		deCommit    cmt.HashDeCommitment
 
 		// round 2
 		betas, // return value of Bob_mid
+		vs []group.Scalar // return value of Bob_mid_wc
 		c1jis,
-		c2jis,
-		vs []*big.Int // return value of Bob_mid_wc
+		c2jis []*big.Int
 		pi1jis []*mta.ProofBob
 		pi2jis []*mta.ProofBobWC
 
+		// round 3
+		alphas []group.Scalar // return value of Alice_end, kept with the betas in case U != T
+		uPoints,
+		vPoints []*crypto.ECPoint // u*G and v*G for the Alice_end_wc and Bob_mid_wc shares
+
 		// round 5
 		li,
 		si,
+		roi group.Scalar
 		rx,
-		ry,
-		roi *big.Int
+		ry *big.Int
 		bigR,
 		bigAi,
 		bigVi *crypto.ECPoint
-		DPower cmt.HashDeCommitment
+		DPower     cmt.HashDeCommitment
+		bigGammaJs []*crypto.ECPoint
 
 		// round 7
 		Ui,
 		Ti *crypto.ECPoint
 		DTelda cmt.HashDeCommitment
+		bigVjs []*crypto.ECPoint // every party's V_j, to check its s_j in finalization
+		bigAjs []*crypto.ECPoint
+		bigV,
+		bigA *crypto.ECPoint
+
+		// round 9
+		bigUjs,
+		bigTjs []*crypto.ECPoint
+		identify bool // U != T, so the parties reveal their values to identify who caused it
 	}
 )
 
@@ -111,6 +132,39 @@ func NewLocalPartyWithKDD(
 	keyDerivationDelta *big.Int,
 	out chan<- tss.Message,
 	end chan<- common.SignatureData,
+) tss.Party {
+	return newLocalParty(msg, msg.Bytes(), params, key, keyDerivationDelta, out, end)
+}
+
+// NewLocalPartyWithMessage returns a party that signs the raw message hashed with the given hash.
+// The digest is kept byte-for-byte, including any leading zeros, in the output SignatureData.M.
+// With common.MessageHashNone the message must already be a digest no longer than the curve order.
+func NewLocalPartyWithMessage(
+	msg []byte,
+	hash common.MessageHash,
+	params *tss.Parameters,
+	key keygen.LocalPartySaveData,
+	out chan<- tss.Message,
+	end chan<- common.SignatureData,
+) (tss.Party, error) {
+	digest, err := hash.Digest(msg)
+	if err != nil {
+		return nil, err
+	}
+	if len(digest) == 0 || len(digest) > (params.EC().Params().BitSize+7)/8 {
+		return nil, fmt.Errorf("the %s digest of the message must be between 1 and %d bytes long", hash, (params.EC().Params().BitSize+7)/8)
+	}
+	return newLocalParty(new(big.Int).SetBytes(digest), digest, params, key, nil, out, end), nil
+}
+
+func newLocalParty(
+	msg *big.Int,
+	msgBytes []byte,
+	params *tss.Parameters,
+	key keygen.LocalPartySaveData,
+	keyDerivationDelta *big.Int,
+	out chan<- tss.Message,
+	end chan<- common.SignatureData,
 ) tss.Party {
 	partyCount := len(params.Parties().IDs())
 	p := &LocalParty{
@@ -133,20 +187,40 @@ func NewLocalPartyWithKDD(
 	p.temp.signRound7Messages = make([]tss.ParsedMessage, partyCount)
 	p.temp.signRound8Messages = make([]tss.ParsedMessage, partyCount)
 	p.temp.signRound9Messages = make([]tss.ParsedMessage, partyCount)
+	p.temp.signIdentificationMessages = make([]tss.ParsedMessage, partyCount)
 	// temp data init
 	p.temp.keyDerivationDelta = keyDerivationDelta
 	p.temp.m = msg
+	p.temp.mBytes = msgBytes
 	p.temp.cis = make([]*big.Int, partyCount)
+	p.temp.kRandomness = make([]*big.Int, partyCount)
 	p.temp.bigWs = make([]*crypto.ECPoint, partyCount)
-	p.temp.betas = make([]*big.Int, partyCount)
+	p.temp.betas = make([]group.Scalar, partyCount)
 	p.temp.c1jis = make([]*big.Int, partyCount)
 	p.temp.c2jis = make([]*big.Int, partyCount)
 	p.temp.pi1jis = make([]*mta.ProofBob, partyCount)
 	p.temp.pi2jis = make([]*mta.ProofBobWC, partyCount)
-	p.temp.vs = make([]*big.Int, partyCount)
+	p.temp.vs = make([]group.Scalar, partyCount)
+	p.temp.alphas = make([]group.Scalar, partyCount)
+	p.temp.uPoints = make([]*crypto.ECPoint, partyCount)
+	p.temp.vPoints = make([]*crypto.ECPoint, partyCount)
 	return p
 }
 
+// UseRandomnessPools makes the party take the Paillier randomness of the MtA rounds from the given pools, which should
+// be created for the Paillier keys of the signers and started beforehand. It must be called before Start().
+// Pools for keys that no signer holds are ignored.
+func (p *LocalParty) UseRandomnessPools(pools ...*paillier.RandomnessPool) {
+	for _, pool := range pools {
+		pk := pool.PublicKey()
+		for j, pkj := range p.keys.PaillierPKs {
+			if pkj != nil && pkj.N.Cmp(pk.N) == 0 {
+				p.keys.PaillierPKs[j] = pk
+			}
+		}
+	}
+}
+
 func (p *LocalParty) FirstRound() tss.Round {
 	return newRound1(p.params, &p.keys, &p.data, &p.temp, p.out, p.end)
 }
@@ -218,6 +292,8 @@ func (p *LocalParty) StoreMessage(msg tss.ParsedMessage) (bool, *tss.Error) {
 		p.temp.signRound8Messages[fromPIdx] = msg
 	case *SignRound9Message:
 		p.temp.signRound9Messages[fromPIdx] = msg
+	case *SignIdentificationMessage:
+		p.temp.signIdentificationMessages[fromPIdx] = msg
 	default: // unrecognised message, just ignore!
 		common.Logger.Warningf("unrecognised message ignored: %v", msg)
 		return false, nil
@@ -225,6 +301,16 @@ func (p *LocalParty) StoreMessage(msg tss.ParsedMessage) (bool, *tss.Error) {
 	return true, nil
 }
 
+// WipeTempData overwrites the share, the nonces, the additive share of the key, the MtA shares and the randomness
+// that encrypts k. The partial signature si and R are kept as they are public once broadcast.
+func (p *LocalParty) WipeTempData() {
+	group.WipeScalars(p.temp.xi, p.temp.w, p.temp.k, p.temp.gamma, p.temp.sigma, p.temp.li, p.temp.roi)
+	group.WipeScalars(p.temp.alphas...)
+	group.WipeScalars(p.temp.betas...)
+	group.WipeScalars(p.temp.vs...)
+	common.WipeInts(p.temp.kRandomness...)
+}
+
 func (p *LocalParty) PartyID() *tss.PartyID {
 	return p.params.PartyID()
 }
@@ -8,6 +8,8 @@ package signing
 
 import (
 	"crypto/ecdsa"
+	"crypto/elliptic"
+	"errors"
 	"fmt"
 	"math/big"
 	"runtime"
@@ -19,6 +21,8 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/bnb-chain/tss-lib/common"
+	"github.com/bnb-chain/tss-lib/crypto"
+	"github.com/bnb-chain/tss-lib/crypto/group"
 	"github.com/bnb-chain/tss-lib/ecdsa/keygen"
 	"github.com/bnb-chain/tss-lib/test"
 	"github.com/bnb-chain/tss-lib/tss"
@@ -108,7 +112,7 @@ signing:
 				// BEGIN check s correctness
 				sumS := big.NewInt(0)
 				for _, p := range parties {
-					sumS = modN.Add(sumS, p.temp.si)
+					sumS = modN.Add(sumS, p.temp.si.BigInt())
 				}
 				fmt.Printf("S: %s\n", sumS.String())
 				// END check s correctness
@@ -216,7 +220,7 @@ signing:
 				// BEGIN check s correctness
 				sumS := big.NewInt(0)
 				for _, p := range parties {
-					sumS = modN.Add(sumS, p.temp.si)
+					sumS = modN.Add(sumS, p.temp.si.BigInt())
 				}
 				fmt.Printf("S: %s\n", sumS.String())
 				// END check s correctness
@@ -262,3 +266,333 @@ func fillBytes(x *big.Int, buf []byte) []byte {
 	}
 	return buf
 }
+
+func TestE2ESeededRunIsReproducible(t *testing.T) {
+	setUp("info")
+	keys, signPIDs, err := keygen.LoadKeygenTestFixtures(testThreshold + 1)
+	assert.NoError(t, err, "should load keygen fixtures")
+
+	run := func() []byte {
+		parties, _ := runSigning(t, tss.S256(), signPIDs, func(i int, params *tss.Parameters, outCh chan tss.Message, endCh chan common.SignatureData) (tss.Party, error) {
+			params.SetRand(common.NewDeterministicRandom([]byte(fmt.Sprintf("signing-%d", i))))
+			return NewLocalParty(big.NewInt(42), params, keys[i], outCh, endCh), nil
+		}, nil)
+		return parties[0].data.GetSignature()
+	}
+	signature1, signature2 := run(), run()
+	assert.NotEmpty(t, signature1)
+	assert.Equal(t, signature1, signature2, "the signature should be identical across seeded runs")
+}
+
+func TestNewLocalPartyWithPath(t *testing.T) {
+	setUp("info")
+	keys, signPIDs, err := keygen.LoadKeygenTestFixtures(testThreshold + 1)
+	assert.NoError(t, err, "should load keygen fixtures")
+	chainCode := common.SHA512_256([]byte("chain code"))
+	for i := range keys {
+		keys[i].ChainCode = chainCode
+	}
+	path := "m/44/60/0/0/5"
+	childPk, err := keys[0].ExtendedPublicKey(path)
+	assert.NoError(t, err)
+
+	p2pCtx := tss.NewPeerContext(signPIDs)
+	params := tss.NewParameters(tss.S256(), p2pCtx, signPIDs[0], len(signPIDs), testThreshold)
+	masterPub, masterBigXj := keys[0].ECDSAPub, keygen.BuildLocalSaveDataSubset(keys[0], params.Parties().IDs()).BigXj
+	outCh := make(chan tss.Message, len(signPIDs))
+	endCh := make(chan common.SignatureData, len(signPIDs))
+	party, err := NewLocalPartyWithPath(big.NewInt(42), params, keys[0], path, outCh, endCh)
+	assert.NoError(t, err)
+	P := party.(*LocalParty)
+
+	assert.Equal(t, childPk.X, P.keys.ECDSAPub.X(), "the party should sign with the derived child key")
+	assert.Equal(t, childPk.Y, P.keys.ECDSAPub.Y(), "the party should sign with the derived child key")
+	assert.NotNil(t, P.temp.keyDerivationDelta)
+	gDelta := crypto.ScalarBaseMult(tss.S256(), P.temp.keyDerivationDelta)
+	for j := range P.keys.BigXj {
+		expected, err := masterBigXj[j].Add(gDelta)
+		assert.NoError(t, err)
+		assert.True(t, expected.Equals(P.keys.BigXj[j]), "BigXj should be shifted by the derivation delta")
+	}
+	assert.Equal(t, masterPub, keys[0].ECDSAPub, "the caller's save data should be left unmodified")
+	assert.Equal(t, masterBigXj, keygen.BuildLocalSaveDataSubset(keys[0], params.Parties().IDs()).BigXj, "the caller's save data should be left unmodified")
+
+	_, err = NewLocalPartyWithPath(big.NewInt(42), params, keys[0], "m/44'/60'/0'/0/5", outCh, endCh)
+	assert.Error(t, err, "hardened paths should be rejected")
+}
+
+func TestE2EWithRawMessage(t *testing.T) {
+	setUp("info")
+	keys, signPIDs, err := keygen.LoadKeygenTestFixtures(testThreshold + 1)
+	assert.NoError(t, err, "should load keygen fixtures")
+
+	// find a message whose digest starts with a zero byte, which must survive signing
+	var msg, digest []byte
+	for i := 0; len(digest) == 0 || digest[0] != 0; i++ {
+		msg = []byte(fmt.Sprintf("raw message %d", i))
+		digest, err = common.MessageHashKeccak256.Digest(msg)
+		assert.NoError(t, err)
+	}
+
+	parties, _ := runSigning(t, tss.S256(), signPIDs, func(i int, params *tss.Parameters, outCh chan tss.Message, endCh chan common.SignatureData) (tss.Party, error) {
+		return NewLocalPartyWithMessage(msg, common.MessageHashKeccak256, params, keys[i], outCh, endCh)
+	}, nil)
+
+	data := &parties[0].data
+	assert.Equal(t, digest, data.GetM(), "the digest should be preserved byte-for-byte")
+	pk := keys[0].ECDSAPub.ToECDSAPubKey()
+	ok := ecdsa.Verify(pk, digest, new(big.Int).SetBytes(data.GetR()), new(big.Int).SetBytes(data.GetS()))
+	assert.True(t, ok, "ecdsa verify must pass")
+}
+
+func TestNewLocalPartyWithMessageRejectsLongDigests(t *testing.T) {
+	keys, signPIDs, err := keygen.LoadKeygenTestFixtures(testThreshold + 1)
+	assert.NoError(t, err, "should load keygen fixtures")
+	params := tss.NewParameters(tss.S256(), tss.NewPeerContext(signPIDs), signPIDs[0], len(signPIDs), testThreshold)
+	outCh := make(chan tss.Message, len(signPIDs))
+	endCh := make(chan common.SignatureData, len(signPIDs))
+
+	_, err = NewLocalPartyWithMessage(make([]byte, 33), common.MessageHashNone, params, keys[0], outCh, endCh)
+	assert.Error(t, err, "an unhashed message longer than the curve order should be rejected")
+	_, err = NewLocalPartyWithMessage(make([]byte, 33), common.MessageHashSHA256, params, keys[0], outCh, endCh)
+	assert.NoError(t, err)
+}
+
+func TestNewLocalPartyRejectsDisqualifiedParty(t *testing.T) {
+	keys, signPIDs, err := keygen.LoadKeygenTestFixtures(3)
+	assert.NoError(t, err, "should load keygen fixtures")
+	outCh := make(chan tss.Message, len(signPIDs))
+	endCh := make(chan common.SignatureData, len(signPIDs))
+
+	// the key of P1 as robust keygen saves it after P0 was disqualified
+	key := keygen.BuildLocalSaveDataSubset(keys[1], signPIDs[1:])
+	key.DisqualifiedKs = []*big.Int{signPIDs[0].KeyInt()}
+
+	params := tss.NewParameters(tss.S256(), tss.NewPeerContext(signPIDs), signPIDs[1], len(signPIDs), 1)
+	assert.Panics(t, func() { NewLocalParty(big.NewInt(42), params, key, outCh, endCh) }, "P0 should not sign")
+	params = tss.NewParameters(tss.S256(), tss.NewPeerContext(signPIDs[1:]), signPIDs[1], len(signPIDs)-1, 1)
+	assert.NotPanics(t, func() { NewLocalParty(big.NewInt(42), params, key, outCh, endCh) })
+}
+
+func TestE2EBadPartialSignatureIsAttributed(t *testing.T) {
+	setUp("info")
+	keys, signPIDs, err := keygen.LoadKeygenTestFixtures(testThreshold + 1)
+	assert.NoError(t, err, "should load keygen fixtures")
+
+	// P0 broadcasts a wrong s_0 in round 9, which everyone else must pin on it
+	_, errs := runSigning(t, tss.S256(), signPIDs, func(i int, params *tss.Parameters, outCh chan tss.Message, endCh chan common.SignatureData) (tss.Party, error) {
+		return NewLocalParty(big.NewInt(42), params, keys[i], outCh, endCh), nil
+	}, func(msg tss.Message) tss.Message {
+		if r9msg, ok := msg.(tss.ParsedMessage).Content().(*SignRound9Message); ok && msg.GetFrom().Index == 0 {
+			badS := new(big.Int).Add(r9msg.UnmarshalS(), big.NewInt(1))
+			return NewSignRound9Message(msg.GetFrom(), badS, r9msg.UnmarshalL())
+		}
+		return msg
+	})
+	assert.Equal(t, len(signPIDs)-1, len(errs), "every other party should abort")
+	var evidence *SignatureShareEvidence
+	for _, err := range errs {
+		assert.Equal(t, 10, err.Round())
+		assert.Equal(t, []*tss.PartyID{signPIDs[0]}, err.Culprits())
+		if assert.True(t, errors.As(err.Cause(), &evidence)) {
+			assert.True(t, evidence.Proves(), "the evidence should hold up")
+		}
+	}
+
+	// the evidence does not hold for the right partial signature
+	evidence.Sj = new(big.Int).Sub(evidence.Sj, big.NewInt(1))
+	assert.False(t, evidence.Proves())
+}
+
+func TestE2EBadVjIsIdentified(t *testing.T) {
+	setUp("info")
+	keys, signPIDs, err := keygen.LoadKeygenTestFixtures(testThreshold + 1)
+	assert.NoError(t, err, "should load keygen fixtures")
+
+	// P0 computes s_0, and V_0 with it, from a wrong sigma_0, so that U != T in round 9 and everyone else must find it
+	var p0 *LocalParty
+	_, errs := runSigning(t, tss.S256(), signPIDs, func(i int, params *tss.Parameters, outCh chan tss.Message, endCh chan common.SignatureData) (tss.Party, error) {
+		P := NewLocalParty(big.NewInt(42), params, keys[i], outCh, endCh)
+		if i == 0 {
+			p0 = P.(*LocalParty)
+		}
+		return P, nil
+	}, func(msg tss.Message) tss.Message {
+		if _, ok := msg.(tss.ParsedMessage).Content().(*SignRound3Message); ok && msg.GetFrom().Index == 0 {
+			g, _ := group.FromCurve(tss.S256())
+			p0.temp.sigma = p0.temp.sigma.Add(g.ScalarFromBigInt(big.NewInt(1)))
+		}
+		return msg
+	})
+	assert.Equal(t, len(signPIDs), len(errs), "every party should abort")
+	for _, err := range errs {
+		assert.Equal(t, 10, err.Round())
+		if err.Victim().Index == 0 {
+			// the values of the honest parties hold up, including those of their MtAs with P0
+			assert.Empty(t, err.Culprits())
+			continue
+		}
+		assert.Equal(t, []*tss.PartyID{signPIDs[0]}, err.Culprits())
+	}
+}
+
+func TestE2EPartialSignatureWithoutL(t *testing.T) {
+	setUp("info")
+	keys, signPIDs, err := keygen.LoadKeygenTestFixtures(testThreshold + 1)
+	assert.NoError(t, err, "should load keygen fixtures")
+
+	// P0 runs an earlier version, which does not send l_0 with its partial signature in round 9
+	run := func(legacy bool) []*tss.Error {
+		_, errs := runSigning(t, tss.S256(), signPIDs, func(i int, params *tss.Parameters, outCh chan tss.Message, endCh chan common.SignatureData) (tss.Party, error) {
+			params.SetLegacyProofs(legacy)
+			return NewLocalParty(big.NewInt(42), params, keys[i], outCh, endCh), nil
+		}, func(msg tss.Message) tss.Message {
+			if r9msg, ok := msg.(tss.ParsedMessage).Content().(*SignRound9Message); ok && msg.GetFrom().Index == 0 {
+				meta := tss.MessageRouting{From: msg.GetFrom(), IsBroadcast: true}
+				content := &SignRound9Message{S: r9msg.GetS()}
+				return tss.NewMessage(meta, content, tss.NewMessageWrapper(meta, content))
+			}
+			return msg
+		})
+		return errs
+	}
+	assert.Empty(t, run(true), "the legacy proofs should accept a partial signature without l_j")
+	errs := run(false)
+	assert.Equal(t, len(signPIDs)-1, len(errs), "every other party should abort")
+	for _, err := range errs {
+		assert.Equal(t, []*tss.PartyID{signPIDs[0]}, err.Culprits())
+	}
+}
+
+func TestE2EHedgedNoncesWithStuckRNG(t *testing.T) {
+	setUp("info")
+	keys, signPIDs, err := keygen.LoadKeygenTestFixtures(testThreshold + 1)
+	assert.NoError(t, err, "should load keygen fixtures")
+
+	// every run starts each party from the same RNG state, as with a broken RNG or a restored VM snapshot. without
+	// hedging, signing another message would then reuse the nonces and reveal the key
+	sign := func(m *big.Int) []byte {
+		parties, _ := runSigning(t, tss.S256(), signPIDs, func(i int, params *tss.Parameters, outCh chan tss.Message, endCh chan common.SignatureData) (tss.Party, error) {
+			params.SetRand(common.NewDeterministicRandom([]byte(fmt.Sprintf("stuck-%d", i))))
+			return NewLocalParty(m, params, keys[i], outCh, endCh), nil
+		}, nil)
+		return parties[0].data.GetR()
+	}
+	assert.NotEqual(t, sign(big.NewInt(42)), sign(big.NewInt(43)), "R should not repeat for another message")
+}
+
+func TestE2EP256(t *testing.T) {
+	setUp("info")
+	ec := tss.P256()
+	fixtures, signPIDs, err := keygen.LoadKeygenTestFixtures(3)
+	if !assert.NoError(t, err, "should load keygen fixtures") {
+		t.FailNow()
+	}
+
+	// PHASE: keygen on P-256, re-using the fixture pre-params for speed
+	p2pCtx := tss.NewPeerContext(signPIDs)
+	kgParties := make([]*keygen.LocalParty, 0, len(signPIDs))
+	errCh := make(chan *tss.Error, len(signPIDs))
+	outCh := make(chan tss.Message, len(signPIDs))
+	endCh := make(chan keygen.LocalPartySaveData, len(signPIDs))
+	for i, pID := range signPIDs {
+		params := tss.NewParameters(ec, p2pCtx, pID, len(signPIDs), len(signPIDs)-1)
+		P := keygen.NewLocalParty(params, outCh, endCh, fixtures[i].LocalPreParams).(*keygen.LocalParty)
+		kgParties = append(kgParties, P)
+		go func(P tss.Party) {
+			if err := P.Start(); err != nil {
+				errCh <- err
+			}
+		}(P)
+	}
+	keys := make([]keygen.LocalPartySaveData, len(signPIDs))
+	for ended := 0; ended < len(signPIDs); {
+		select {
+		case err := <-errCh:
+			assert.FailNow(t, err.Error())
+		case msg := <-outCh:
+			if dest := msg.GetTo(); dest == nil {
+				for _, P := range kgParties {
+					if P.PartyID().Index != msg.GetFrom().Index {
+						go test.SharedPartyUpdater(P, msg, errCh)
+					}
+				}
+			} else {
+				go test.SharedPartyUpdater(kgParties[dest[0].Index], msg, errCh)
+			}
+		case save := <-endCh:
+			index, err := save.OriginalIndex()
+			assert.NoError(t, err)
+			keys[index] = save
+			ended++
+		}
+	}
+
+	// PHASE: signing
+	parties, _ := runSigning(t, ec, signPIDs, func(i int, params *tss.Parameters, outCh chan tss.Message, endCh chan common.SignatureData) (tss.Party, error) {
+		return NewLocalParty(big.NewInt(42), params, keys[i], outCh, endCh), nil
+	}, nil)
+	data := &parties[0].data
+	ok := ecdsa.Verify(keys[0].ECDSAPub.ToECDSAPubKey(), big.NewInt(42).Bytes(),
+		new(big.Int).SetBytes(data.GetR()),
+		new(big.Int).SetBytes(data.GetS()))
+	assert.True(t, ok, "ecdsa verify with crypto/ecdsa on P-256 must pass")
+}
+
+// runSigning runs signing with a party made by newParty for each of signPIDs, all of whom are needed to sign, routing
+// the messages until every party has ended or failed. A party that fails fails the test, unless tamper is given to
+// replace messages before they are delivered; the errors are then returned.
+func runSigning(
+	t *testing.T,
+	ec elliptic.Curve,
+	signPIDs tss.SortedPartyIDs,
+	newParty func(i int, params *tss.Parameters, outCh chan tss.Message, endCh chan common.SignatureData) (tss.Party, error),
+	tamper func(tss.Message) tss.Message,
+) ([]*LocalParty, []*tss.Error) {
+	p2pCtx := tss.NewPeerContext(signPIDs)
+	parties := make([]*LocalParty, 0, len(signPIDs))
+	errCh := make(chan *tss.Error, len(signPIDs))
+	outCh := make(chan tss.Message, len(signPIDs))
+	endCh := make(chan common.SignatureData, len(signPIDs))
+	for i := range signPIDs {
+		params := tss.NewParameters(ec, p2pCtx, signPIDs[i], len(signPIDs), len(signPIDs)-1)
+		P, err := newParty(i, params, outCh, endCh)
+		if !assert.NoError(t, err) {
+			t.FailNow()
+		}
+		parties = append(parties, P.(*LocalParty))
+		go func(P tss.Party) {
+			if err := P.Start(); err != nil {
+				errCh <- err
+			}
+		}(P)
+	}
+
+	var errs []*tss.Error
+	for ended := 0; ended+len(errs) < len(signPIDs); {
+		select {
+		case err := <-errCh:
+			if tamper == nil {
+				assert.FailNow(t, err.Error())
+			}
+			errs = append(errs, err)
+		case msg := <-outCh:
+			if tamper != nil {
+				msg = tamper(msg)
+			}
+			dest := msg.GetTo()
+			if dest == nil {
+				for _, P := range parties {
+					if P.PartyID().Index != msg.GetFrom().Index {
+						go test.SharedPartyUpdater(P, msg, errCh)
+					}
+				}
+			} else {
+				go test.SharedPartyUpdater(parties[dest[0].Index], msg, errCh)
+			}
+		case <-endCh:
+			ended++
+		}
+	}
+	return parties, errs
+}
@@ -8,6 +8,7 @@ package signing
 
 import (
 	"crypto/elliptic"
+	"errors"
 	"math/big"
 
 	"github.com/bnb-chain/tss-lib/common"
@@ -34,6 +35,7 @@ var (
 		(*SignRound7Message)(nil),
 		(*SignRound8Message)(nil),
 		(*SignRound9Message)(nil),
+		(*SignIdentificationMessage)(nil),
 	}
 )
 
@@ -368,7 +370,7 @@ func (m *SignRound8Message) UnmarshalDeCommitment() []*big.Int {
 
 func NewSignRound9Message(
 	from *tss.PartyID,
-	si *big.Int,
+	si, li *big.Int,
 ) tss.ParsedMessage {
 	meta := tss.MessageRouting{
 		From:        from,
@@ -376,16 +378,181 @@ func NewSignRound9Message(
 	}
 	content := &SignRound9Message{
 		S: si.Bytes(),
+		L: li.Bytes(),
 	}
 	msg := tss.NewMessageWrapper(meta, content)
 	return tss.NewMessage(meta, content, msg)
 }
 
+// ValidateBasic does not require L, which parties running earlier versions do not send. Signing requires it unless
+// the legacy proofs are used.
 func (m *SignRound9Message) ValidateBasic() bool {
 	return m != nil &&
 		common.NonEmptyBytes(m.S)
 }
 
+// HasL reports whether the message carries the blinding l_i, which parties running earlier versions do not send
+func (m *SignRound9Message) HasL() bool {
+	return common.NonEmptyBytes(m.GetL())
+}
+
 func (m *SignRound9Message) UnmarshalS() *big.Int {
 	return new(big.Int).SetBytes(m.S)
 }
+
+// UnmarshalL returns the blinding l_i of V_i = s_i*R + l_i*G, which lets the other parties check s_i against V_i
+func (m *SignRound9Message) UnmarshalL() *big.Int {
+	return new(big.Int).SetBytes(m.GetL())
+}
+
+// ----- //
+
+// NewSignIdentificationMessage reveals the values of the sender that the other parties need to find who caused U != T.
+// The slices are indexed by party and the entries of the sender itself are nil.
+func NewSignIdentificationMessage(
+	from *tss.PartyID,
+	k, gamma *big.Int,
+	kRandomness, alphas, betas []*big.Int,
+	uPoints, vPoints []*crypto.ECPoint,
+	bigS *crypto.ECPoint,
+	proof *schnorr.ZKDLEQProof,
+	rho, l *big.Int,
+) tss.ParsedMessage {
+	meta := tss.MessageRouting{
+		From:        from,
+		IsBroadcast: true,
+	}
+	content := &SignIdentificationMessage{
+		K:              k.Bytes(),
+		Gamma:          gamma.Bytes(),
+		KRandomness:    intsToBytes(kRandomness),
+		Alphas:         intsToBytes(alphas),
+		Betas:          intsToBytes(betas),
+		UPoints:        pointsToBytes(uPoints...),
+		VPoints:        pointsToBytes(vPoints...),
+		BigS:           pointsToBytes(bigS),
+		DleqProofAlpha: pointsToBytes(proof.Alpha),
+		DleqProofBeta:  pointsToBytes(proof.Beta),
+		DleqProofT:     proof.T.Bytes(),
+		Rho:            rho.Bytes(),
+		L:              l.Bytes(),
+	}
+	msg := tss.NewMessageWrapper(meta, content)
+	return tss.NewMessage(meta, content, msg)
+}
+
+func (m *SignIdentificationMessage) ValidateBasic() bool {
+	n := len(m.GetAlphas())
+	return m != nil &&
+		common.NonEmptyBytes(m.K) &&
+		common.NonEmptyBytes(m.Gamma) &&
+		len(m.KRandomness) == n &&
+		len(m.Betas) == n &&
+		len(m.UPoints) == 2*n &&
+		len(m.VPoints) == 2*n &&
+		common.NonEmptyMultiBytes(m.BigS, 2) &&
+		common.NonEmptyMultiBytes(m.DleqProofAlpha, 2) &&
+		common.NonEmptyMultiBytes(m.DleqProofBeta, 2) &&
+		common.NonEmptyBytes(m.DleqProofT) &&
+		common.NonEmptyBytes(m.Rho) &&
+		common.NonEmptyBytes(m.L)
+}
+
+// PartyCount returns the number of signers that the per-party values of the message are given for
+func (m *SignIdentificationMessage) PartyCount() int {
+	return len(m.GetAlphas())
+}
+
+func (m *SignIdentificationMessage) UnmarshalK() *big.Int {
+	return new(big.Int).SetBytes(m.GetK())
+}
+
+func (m *SignIdentificationMessage) UnmarshalGamma() *big.Int {
+	return new(big.Int).SetBytes(m.GetGamma())
+}
+
+// UnmarshalKRandomness returns the Paillier randomness of the encryption of k sent to party j in round 1
+func (m *SignIdentificationMessage) UnmarshalKRandomness(j int) *big.Int {
+	return new(big.Int).SetBytes(m.GetKRandomness()[j])
+}
+
+// UnmarshalAlpha returns the share of the sender in the MtA of its k and the gamma of party j
+func (m *SignIdentificationMessage) UnmarshalAlpha(j int) *big.Int {
+	return new(big.Int).SetBytes(m.GetAlphas()[j])
+}
+
+// UnmarshalBeta returns the share of the sender in the MtA of the k of party j and its gamma
+func (m *SignIdentificationMessage) UnmarshalBeta(j int) *big.Int {
+	return new(big.Int).SetBytes(m.GetBetas()[j])
+}
+
+// UnmarshalUPoint returns u*G for the share u of the sender in the MtA of its k and the w of party j
+func (m *SignIdentificationMessage) UnmarshalUPoint(ec elliptic.Curve, j int) (*crypto.ECPoint, error) {
+	return pointFromBytes(ec, m.GetUPoints()[2*j:2*j+2])
+}
+
+// UnmarshalVPoint returns v*G for the share v of the sender in the MtA of the k of party j and its w
+func (m *SignIdentificationMessage) UnmarshalVPoint(ec elliptic.Curve, j int) (*crypto.ECPoint, error) {
+	return pointFromBytes(ec, m.GetVPoints()[2*j:2*j+2])
+}
+
+// UnmarshalBigS returns S_i = sigma_i*R
+func (m *SignIdentificationMessage) UnmarshalBigS(ec elliptic.Curve) (*crypto.ECPoint, error) {
+	return pointFromBytes(ec, m.GetBigS())
+}
+
+// UnmarshalDLEQProof returns the proof that S_i and sigma_i*G have the same discrete logarithm sigma_i
+func (m *SignIdentificationMessage) UnmarshalDLEQProof(ec elliptic.Curve) (*schnorr.ZKDLEQProof, error) {
+	alpha, err := pointFromBytes(ec, m.GetDleqProofAlpha())
+	if err != nil {
+		return nil, err
+	}
+	beta, err := pointFromBytes(ec, m.GetDleqProofBeta())
+	if err != nil {
+		return nil, err
+	}
+	return &schnorr.ZKDLEQProof{
+		Alpha: alpha,
+		Beta:  beta,
+		T:     new(big.Int).SetBytes(m.GetDleqProofT()),
+	}, nil
+}
+
+func (m *SignIdentificationMessage) UnmarshalRho() *big.Int {
+	return new(big.Int).SetBytes(m.GetRho())
+}
+
+func (m *SignIdentificationMessage) UnmarshalL() *big.Int {
+	return new(big.Int).SetBytes(m.GetL())
+}
+
+// ----- //
+
+// intsToBytes is common.BigIntsToBytes that keeps nil entries as empty ones
+func intsToBytes(ints []*big.Int) [][]byte {
+	bzs := make([][]byte, len(ints))
+	for i, n := range ints {
+		if n != nil {
+			bzs[i] = n.Bytes()
+		}
+	}
+	return bzs
+}
+
+// pointsToBytes flattens the points into X and Y coordinates, with two empty entries for a nil point
+func pointsToBytes(points ...*crypto.ECPoint) [][]byte {
+	bzs := make([][]byte, 2*len(points))
+	for i, p := range points {
+		if p != nil {
+			bzs[2*i], bzs[2*i+1] = p.X().Bytes(), p.Y().Bytes()
+		}
+	}
+	return bzs
+}
+
+func pointFromBytes(ec elliptic.Curve, bzs [][]byte) (*crypto.ECPoint, error) {
+	if !common.NonEmptyMultiBytes(bzs, 2) {
+		return nil, errors.New("the point is missing")
+	}
+	return crypto.NewECPoint(ec, new(big.Int).SetBytes(bzs[0]), new(big.Int).SetBytes(bzs[1]))
+}
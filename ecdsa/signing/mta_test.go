@@ -0,0 +1,100 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package signing
+
+import (
+	"crypto/rand"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bnb-chain/tss-lib/common"
+	"github.com/bnb-chain/tss-lib/crypto"
+	"github.com/bnb-chain/tss-lib/crypto/mta"
+	"github.com/bnb-chain/tss-lib/crypto/paillier"
+	"github.com/bnb-chain/tss-lib/ecdsa/keygen"
+	"github.com/bnb-chain/tss-lib/tss"
+)
+
+func TestUseRandomnessPools(t *testing.T) {
+	keys, signPIDs, err := keygen.LoadKeygenTestFixtures(2)
+	if err != nil {
+		t.Skip("the test fixtures are needed for the Paillier keys")
+	}
+	params := tss.NewParameters(tss.EC(), tss.NewPeerContext(signPIDs), signPIDs[0], len(signPIDs), 1)
+	party := NewLocalParty(big.NewInt(42), params, keys[0], nil, nil).(*LocalParty)
+	pool, err := paillier.NewRandomnessPool(keys[0].PaillierPKs[1], rand.Reader, 1)
+	assert.NoError(t, err)
+	party.UseRandomnessPools(pool)
+
+	assert.NotEqual(t, keys[0].PaillierPKs[1], party.keys.PaillierPKs[1], "the key should be replaced by the pool's")
+	assert.Equal(t, 0, keys[0].PaillierPKs[1].N.Cmp(party.keys.PaillierPKs[1].N))
+	assert.Equal(t, keys[0].PaillierPKs[0], party.keys.PaillierPKs[0], "keys without a pool should be kept")
+}
+
+// benchmarkRounds1To3 runs the Paillier work of rounds 1 to 3 between two signers: Alice's encryption of k with its
+// range proof (round 1), Bob's MtA and MtAwc responses (round 2) and Alice's decryptions (round 3).
+func benchmarkRounds1To3(b *testing.B, usePool bool) {
+	keys, _, err := keygen.LoadKeygenTestFixtures(2)
+	if err != nil {
+		b.Skip("the test fixtures are needed for the Paillier keys")
+	}
+	ec := tss.EC()
+	alice, bob := keys[0], keys[1]
+	pkA := alice.PaillierPKs[0]
+	if usePool {
+		// each run of the rounds takes an r^N for the encryption and one for the proof in each of the 3 MtA calls
+		pool, err := paillier.NewRandomnessPool(pkA, rand.Reader, 6*b.N)
+		if err != nil {
+			b.Fatal(err)
+		}
+		pool.Start()
+		for pool.Len() < 6*b.N {
+			time.Sleep(10 * time.Millisecond)
+		}
+		// the pool is filled while the party is idle and does not compete with the timed rounds for the CPU
+		pool.Stop()
+		pkA = pool.PublicKey()
+	}
+	k := common.GetRandomPositiveInt(ec.Params().N)
+	gamma := common.GetRandomPositiveInt(ec.Params().N)
+	w := common.GetRandomPositiveInt(ec.Params().N)
+	bigW := crypto.ScalarBaseMult(ec, w)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		cA, pi, err := mta.AliceInit(nil, ec, pkA, k, alice.NTildej[1], alice.H1j[1], alice.H2j[1], rand.Reader)
+		if err != nil {
+			b.Fatal(err)
+		}
+		_, c1, _, pi1, err := mta.BobMid(nil, nil, ec, pkA, pi, gamma, cA,
+			bob.NTildej[0], bob.H1j[0], bob.H2j[0], bob.NTildej[1], bob.H1j[1], bob.H2j[1], rand.Reader)
+		if err != nil {
+			b.Fatal(err)
+		}
+		_, c2, _, pi2, err := mta.BobMidWC(nil, nil, ec, pkA, pi, w, cA,
+			bob.NTildej[0], bob.H1j[0], bob.H2j[0], bob.NTildej[1], bob.H1j[1], bob.H2j[1], bigW, rand.Reader)
+		if err != nil {
+			b.Fatal(err)
+		}
+		if _, err = mta.AliceEnd(nil, ec, pkA, pi1, alice.H1j[0], alice.H2j[0], cA, c1, alice.NTildej[0], alice.PaillierSK); err != nil {
+			b.Fatal(err)
+		}
+		if _, err = mta.AliceEndWC(nil, ec, pkA, pi2, bigW, cA, c2, alice.NTildej[0], alice.H1j[0], alice.H2j[0], alice.PaillierSK); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkRounds1To3(b *testing.B) {
+	benchmarkRounds1To3(b, false)
+}
+
+func BenchmarkRounds1To3WithRandomnessPool(b *testing.B) {
+	benchmarkRounds1To3(b, true)
+}
@@ -11,13 +11,22 @@ import (
 	"fmt"
 	"math/big"
 
-	"github.com/bnb-chain/tss-lib/common"
 	"github.com/bnb-chain/tss-lib/crypto"
+	"github.com/bnb-chain/tss-lib/crypto/group"
 )
 
 // PrepareForSigning(), GG18Spec (11) Fig. 14
 func PrepareForSigning(ec elliptic.Curve, i, pax int, xi *big.Int, ks []*big.Int, bigXs []*crypto.ECPoint) (wi *big.Int, bigWs []*crypto.ECPoint) {
-	modQ := common.ModInt(ec.Params().N)
+	g, ok := group.FromCurve(ec)
+	if !ok {
+		panic(fmt.Errorf("PrepareForSigning: unsupported curve %s", ec.Params().Name))
+	}
+	w, bigWs := prepareForSigning(g, i, pax, g.ScalarFromBigInt(xi), ks, bigXs)
+	return w.BigInt(), bigWs
+}
+
+// prepareForSigning is PrepareForSigning for a share that is kept as a scalar of the group of the curve
+func prepareForSigning(g group.CurveGroup, i, pax int, xi group.Scalar, ks []*big.Int, bigXs []*crypto.ECPoint) (wi group.Scalar, bigWs []*crypto.ECPoint) {
 	if len(ks) != len(bigXs) {
 		panic(fmt.Errorf("PrepareForSigning: len(ks) != len(bigXs) (%d != %d)", len(ks), len(bigXs)))
 	}
@@ -29,39 +38,31 @@ func PrepareForSigning(ec elliptic.Curve, i, pax int, xi *big.Int, ks []*big.Int
 	}
 
 	// 2-4.
-	wi = xi
+	wi = lagrangeCoefficient(g, i, ks).Mul(xi)
+
+	// 5-10.
+	bigWs = make([]*crypto.ECPoint, len(ks))
 	for j := 0; j < pax; j++ {
+		bigWs[j] = bigXs[j].ScalarMult(lagrangeCoefficient(g, j, ks).BigInt())
+	}
+	return
+}
+
+// lagrangeCoefficient returns the product of ks[j] / (ks[j] - ks[i]) over j != i, which is computed with a single
+// constant-time inversion.
+func lagrangeCoefficient(g group.Group, i int, ks []*big.Int) group.Scalar {
+	ksi := g.ScalarFromBigInt(ks[i])
+	num, den := g.ScalarFromBigInt(big.NewInt(1)), g.ScalarFromBigInt(big.NewInt(1))
+	for j := range ks {
 		if j == i {
 			continue
 		}
-		ksj := ks[j]
-		ksi := ks[i]
-		if ksj.Cmp(ksi) == 0 {
+		ksj := g.ScalarFromBigInt(ks[j])
+		if ksj.Equal(ksi) {
 			panic(fmt.Errorf("index of two parties are equal"))
 		}
-		// big.Int Div is calculated as: a/b = a * modInv(b,q)
-		coef := modQ.Mul(ks[j], modQ.ModInverse(new(big.Int).Sub(ksj, ksi)))
-		wi = modQ.Mul(wi, coef)
-	}
-
-	// 5-10.
-	bigWs = make([]*crypto.ECPoint, len(ks))
-	for j := 0; j < pax; j++ {
-		bigWj := bigXs[j]
-		for c := 0; c < pax; c++ {
-			if j == c {
-				continue
-			}
-			ksc := ks[c]
-			ksj := ks[j]
-			if ksj.Cmp(ksc) == 0 {
-				panic(fmt.Errorf("index of two parties are equal"))
-			}
-			// big.Int Div is calculated as: a/b = a * modInv(b,q)
-			iota := modQ.Mul(ksc, modQ.ModInverse(new(big.Int).Sub(ksc, ksj)))
-			bigWj = bigWj.ScalarMult(iota)
-		}
-		bigWs[j] = bigWj
+		num = num.Mul(ksj)
+		den = den.Mul(ksj.Sub(ksi))
 	}
-	return
+	return num.Mul(den.Invert())
 }
@@ -0,0 +1,66 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package signing
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bnb-chain/tss-lib/common"
+	"github.com/bnb-chain/tss-lib/crypto"
+	"github.com/bnb-chain/tss-lib/crypto/vss"
+	"github.com/bnb-chain/tss-lib/tss"
+)
+
+// prepareFixture shares a random secret among `num` parties with the given threshold
+func prepareFixture(num, threshold int) (secret *big.Int, ks []*big.Int, xs []*big.Int, bigXs []*crypto.ECPoint) {
+	ec := tss.EC()
+	secret = common.GetRandomPositiveInt(ec.Params().N)
+	for i := 0; i < num; i++ {
+		ks = append(ks, common.GetRandomPositiveInt(ec.Params().N))
+	}
+	_, shares, err := vss.Create(ec, threshold, secret, ks)
+	if err != nil {
+		panic(err)
+	}
+	for _, share := range shares {
+		xs = append(xs, share.Share)
+		bigXs = append(bigXs, crypto.ScalarBaseMult(ec, share.Share))
+	}
+	return
+}
+
+func TestPrepareForSigning(t *testing.T) {
+	ec := tss.EC()
+	num, threshold := 5, 2
+	secret, ks, xs, bigXs := prepareFixture(num, threshold)
+
+	// the additive shares wi of any t+1 signers sum to the secret, and Wj = wj G for each of them
+	ks, xs, bigXs = ks[1:threshold+2], xs[1:threshold+2], bigXs[1:threshold+2]
+	sum := new(big.Int)
+	for i := range ks {
+		wi, bigWs := PrepareForSigning(ec, i, len(ks), xs[i], ks, bigXs)
+		sum = new(big.Int).Mod(new(big.Int).Add(sum, wi), ec.Params().N)
+		for j := range ks {
+			wj, _ := PrepareForSigning(ec, j, len(ks), xs[j], ks, bigXs)
+			assert.True(t, bigWs[j].Equals(crypto.ScalarBaseMult(ec, wj)))
+		}
+	}
+	assert.Equal(t, 0, secret.Cmp(sum))
+}
+
+func BenchmarkPrepareForSigning(b *testing.B) {
+	num, threshold := 20, 10
+	_, ks, xs, bigXs := prepareFixture(num, threshold)
+	ks, xs, bigXs = ks[:threshold+1], xs[:threshold+1], bigXs[:threshold+1]
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		PrepareForSigning(tss.EC(), 0, len(ks), xs[0], ks, bigXs)
+	}
+}
@@ -9,20 +9,16 @@ package signing
 import (
 	"errors"
 	"fmt"
-	"math/big"
 
 	"github.com/bnb-chain/tss-lib/common"
 	"github.com/bnb-chain/tss-lib/crypto"
 	"github.com/bnb-chain/tss-lib/crypto/commitments"
+	"github.com/bnb-chain/tss-lib/crypto/group"
 	"github.com/bnb-chain/tss-lib/crypto/mta"
 	"github.com/bnb-chain/tss-lib/ecdsa/keygen"
 	"github.com/bnb-chain/tss-lib/tss"
 )
 
-var (
-	zero = big.NewInt(0)
-)
-
 // round 1 represents round 1 of the signing part of the GG18 ECDSA TSS spec (Gennaro, Goldfeder; 2018)
 func newRound1(params *tss.Parameters, key *keygen.LocalPartySaveData, data *common.SignatureData, temp *localTempData, out chan<- tss.Message, end chan<- common.SignatureData) tss.Round {
 	return &round1{
@@ -46,11 +42,15 @@ func (round *round1) Start() *tss.Error {
 	round.started = true
 	round.resetOK()
 
-	k := common.GetRandomPositiveInt(round.Params().EC().Params().N)
-	gamma := common.GetRandomPositiveInt(round.Params().EC().Params().N)
+	g, err := round.curveGroup()
+	if err != nil {
+		return round.WrapError(err)
+	}
+	k := round.nonce(g, "k")
+	gamma := round.nonce(g, "gamma")
 
-	pointGamma := crypto.ScalarBaseMult(round.Params().EC(), gamma)
-	cmt := commitments.NewHashCommitment(pointGamma.X(), pointGamma.Y())
+	pointGamma := crypto.ScalarBaseMultScalar(round.Params().EC(), gamma)
+	cmt := commitments.NewHashCommitmentWithRandom(round.Rand(), pointGamma.X(), pointGamma.Y())
 	round.temp.k = k
 	round.temp.gamma = gamma
 	round.temp.pointGamma = pointGamma
@@ -59,16 +59,19 @@ func (round *round1) Start() *tss.Error {
 	i := round.PartyID().Index
 	round.ok[i] = true
 
+	kInt := k.BigInt()
+	defer common.WipeInts(kInt)
 	for j, Pj := range round.Parties().IDs() {
 		if j == i {
 			continue
 		}
-		cA, pi, err := mta.AliceInit(round.Params().EC(), round.key.PaillierPKs[i], k, round.key.NTildej[j], round.key.H1j[j], round.key.H2j[j])
+		cA, rA, pi, err := mta.AliceInitWithRandomness(round.transcript(round.PartyID()), round.Params().EC(), round.key.PaillierPKs[i], kInt, round.key.NTildej[j], round.key.H1j[j], round.key.H2j[j], round.Rand())
 		if err != nil {
 			return round.WrapError(fmt.Errorf("failed to init mta: %v", err))
 		}
 		r1msg1 := NewSignRound1Message1(Pj, round.PartyID(), cA, pi)
 		round.temp.cis[j] = cA
+		round.temp.kRandomness[j] = rA
 		round.out <- r1msg1
 	}
 
@@ -117,23 +120,28 @@ func (round *round1) NextRound() tss.Round {
 func (round *round1) prepare() error {
 	i := round.PartyID().Index
 
-	xi := round.key.Xi
 	ks := round.key.Ks
 	bigXs := round.key.BigXj
 
+	g, err := round.curveGroup()
+	if err != nil {
+		return err
+	}
+	xi := g.ScalarFromBigInt(round.key.Xi)
 	if round.temp.keyDerivationDelta != nil {
 		// adding the key derivation delta to the xi's
 		// Suppose x has shamir shares x_0,     x_1,     ..., x_n
 		// So x + D has shamir shares  x_0 + D, x_1 + D, ..., x_n + D
-		mod := common.ModInt(round.Params().EC().Params().N)
-		xi = mod.Add(round.temp.keyDerivationDelta, xi)
-		round.key.Xi = xi
+		derivedXi := g.ScalarFromBigInt(round.temp.keyDerivationDelta).Add(xi)
+		group.WipeScalars(xi)
+		xi = derivedXi
 	}
+	round.temp.xi = xi
 
 	if round.Threshold()+1 > len(ks) {
 		return fmt.Errorf("t+1=%d is not satisfied by the key count of %d", round.Threshold()+1, len(ks))
 	}
-	wi, bigWs := PrepareForSigning(round.Params().EC(), i, len(ks), xi, ks, bigXs)
+	wi, bigWs := prepareForSigning(g, i, len(ks), xi, ks, bigXs)
 
 	round.temp.w = wi
 	round.temp.bigWs = bigWs
@@ -12,6 +12,7 @@ import (
 
 	errorspkg "github.com/pkg/errors"
 
+	"github.com/bnb-chain/tss-lib/common"
 	"github.com/bnb-chain/tss-lib/crypto/mta"
 	"github.com/bnb-chain/tss-lib/tss"
 )
@@ -27,6 +28,18 @@ func (round *round2) Start() *tss.Error {
 	i := round.PartyID().Index
 	round.ok[i] = true
 
+	g, err := round.curveGroup()
+	if err != nil {
+		return round.WrapError(err)
+	}
+	gamma, w := round.temp.gamma.BigInt(), round.temp.w.BigInt()
+	defer common.WipeInts(gamma, w)
+
+	// each goroutine below draws from its own fork so that a deterministic source yields the same result on every run
+	rands, err := common.ForkRandom(round.Rand(), len(round.Parties().IDs())*2)
+	if err != nil {
+		return round.WrapError(err)
+	}
 	errChs := make(chan *tss.Error, (len(round.Parties().IDs())-1)*2)
 	wg := sync.WaitGroup{}
 	wg.Add((len(round.Parties().IDs()) - 1) * 2)
@@ -44,24 +57,29 @@ func (round *round2) Start() *tss.Error {
 				return
 			}
 			beta, c1ji, _, pi1ji, err := mta.BobMid(
+				round.transcript(Pj),
+				round.transcript(round.PartyID()),
 				round.Parameters.EC(),
 				round.key.PaillierPKs[j],
 				rangeProofAliceJ,
-				round.temp.gamma,
+				gamma,
 				r1msg.UnmarshalC(),
 				round.key.NTildej[j],
 				round.key.H1j[j],
 				round.key.H2j[j],
 				round.key.NTildej[i],
 				round.key.H1j[i],
-				round.key.H2j[i])
-			// should be thread safe as these are pre-allocated
-			round.temp.betas[j] = beta
-			round.temp.c1jis[j] = c1ji
-			round.temp.pi1jis[j] = pi1ji
+				round.key.H2j[i],
+				rands[j*2])
 			if err != nil {
 				errChs <- round.WrapError(err, Pj)
+				return
 			}
+			// should be thread safe as these are pre-allocated
+			round.temp.betas[j] = g.ScalarFromBigInt(beta)
+			common.WipeInts(beta)
+			round.temp.c1jis[j] = c1ji
+			round.temp.pi1jis[j] = pi1ji
 		}(j, Pj)
 		// Bob_mid_wc
 		go func(j int, Pj *tss.PartyID) {
@@ -73,10 +91,12 @@ func (round *round2) Start() *tss.Error {
 				return
 			}
 			v, c2ji, _, pi2ji, err := mta.BobMidWC(
+				round.transcript(Pj),
+				round.transcript(round.PartyID()),
 				round.Parameters.EC(),
 				round.key.PaillierPKs[j],
 				rangeProofAliceJ,
-				round.temp.w,
+				w,
 				r1msg.UnmarshalC(),
 				round.key.NTildej[j],
 				round.key.H1j[j],
@@ -84,13 +104,16 @@ func (round *round2) Start() *tss.Error {
 				round.key.NTildej[i],
 				round.key.H1j[i],
 				round.key.H2j[i],
-				round.temp.bigWs[i])
-			round.temp.vs[j] = v
-			round.temp.c2jis[j] = c2ji
-			round.temp.pi2jis[j] = pi2ji
+				round.temp.bigWs[i],
+				rands[j*2+1])
 			if err != nil {
 				errChs <- round.WrapError(err, Pj)
+				return
 			}
+			round.temp.vs[j] = g.ScalarFromBigInt(v)
+			common.WipeInts(v)
+			round.temp.c2jis[j] = c2ji
+			round.temp.pi2jis[j] = pi2ji
 		}(j, Pj)
 	}
 	// consume error channels; wait for goroutines
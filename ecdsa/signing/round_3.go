@@ -14,6 +14,8 @@ import (
 	errorspkg "github.com/pkg/errors"
 
 	"github.com/bnb-chain/tss-lib/common"
+	"github.com/bnb-chain/tss-lib/crypto"
+	"github.com/bnb-chain/tss-lib/crypto/group"
 	"github.com/bnb-chain/tss-lib/crypto/mta"
 	"github.com/bnb-chain/tss-lib/tss"
 )
@@ -28,6 +30,9 @@ func (round *round3) Start() *tss.Error {
 
 	var alphas = make([]*big.Int, len(round.Parties().IDs()))
 	var us = make([]*big.Int, len(round.Parties().IDs()))
+	// the MtA shares are only needed for theta and sigma, and for identifying a cheater if U != T
+	defer common.WipeInts(alphas...)
+	defer common.WipeInts(us...)
 
 	i := round.PartyID().Index
 
@@ -48,6 +53,7 @@ func (round *round3) Start() *tss.Error {
 				return
 			}
 			alphaIj, err := mta.AliceEnd(
+				round.transcript(Pj),
 				round.Params().EC(),
 				round.key.PaillierPKs[i],
 				proofBob,
@@ -72,6 +78,7 @@ func (round *round3) Start() *tss.Error {
 				return
 			}
 			uIj, err := mta.AliceEndWC(
+				round.transcript(Pj),
 				round.Params().EC(),
 				round.key.PaillierPKs[i],
 				proofBobWC,
@@ -100,21 +107,31 @@ func (round *round3) Start() *tss.Error {
 		return round.WrapError(errors.New("failed to calculate Alice_end or Alice_end_wc"), culprits...)
 	}
 
-	modN := common.ModInt(round.Params().EC().Params().N)
-	thelta := modN.Mul(round.temp.k, round.temp.gamma)
-	sigma := modN.Mul(round.temp.k, round.temp.w)
+	g, err := round.curveGroup()
+	if err != nil {
+		return round.WrapError(err)
+	}
+	thelta := round.temp.k.Mul(round.temp.gamma)
+	sigma := round.temp.k.Mul(round.temp.w)
 
 	for j := range round.Parties().IDs() {
 		if j == round.PartyID().Index {
 			continue
 		}
-		thelta = modN.Add(thelta, alphas[j].Add(alphas[j], round.temp.betas[j]))
-		sigma = modN.Add(sigma, us[j].Add(us[j], round.temp.vs[j]))
+		round.temp.alphas[j] = g.ScalarFromBigInt(alphas[j])
+		u := g.ScalarFromBigInt(us[j])
+		thelta = thelta.Add(round.temp.alphas[j]).Add(round.temp.betas[j])
+		sigma = sigma.Add(u).Add(round.temp.vs[j])
+		// the shares of the MtA with w are only ever revealed as points, as together with k they would give away w
+		round.temp.uPoints[j] = crypto.ScalarBaseMultScalar(round.Params().EC(), u)
+		round.temp.vPoints[j] = crypto.ScalarBaseMultScalar(round.Params().EC(), round.temp.vs[j])
+		group.WipeScalars(u)
 	}
+	group.WipeScalars(round.temp.vs...)
 
 	round.temp.theta = thelta
 	round.temp.sigma = sigma
-	r3msg := NewSignRound3Message(round.PartyID(), thelta)
+	r3msg := NewSignRound3Message(round.PartyID(), thelta.BigInt())
 	round.temp.signRound3Messages[round.PartyID().Index] = r3msg
 	round.out <- r3msg
 
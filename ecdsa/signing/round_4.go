@@ -25,10 +25,11 @@ func (round *round4) Start() *tss.Error {
 	round.started = true
 	round.resetOK()
 
-	theta := *round.temp.theta
-	thetaInverse := &theta
-
-	modN := common.ModInt(round.Params().EC().Params().N)
+	g, err := round.curveGroup()
+	if err != nil {
+		return round.WrapError(err)
+	}
+	theta := round.temp.theta
 
 	for j := range round.Parties().IDs() {
 		if j == round.PartyID().Index {
@@ -36,12 +37,17 @@ func (round *round4) Start() *tss.Error {
 		}
 		r3msg := round.temp.signRound3Messages[j].Content().(*SignRound3Message)
 		theltaJ := r3msg.GetTheta()
-		thetaInverse = modN.Add(thetaInverse, new(big.Int).SetBytes(theltaJ))
+		theta = theta.Add(g.ScalarFromBigInt(new(big.Int).SetBytes(theltaJ)))
+	}
+	if theta.IsZero() {
+		return round.WrapError(errors.New("the sum of the theta shares is zero"))
 	}
 
 	// compute the multiplicative inverse thelta mod q
-	thetaInverse = modN.ModInverse(thetaInverse)
-	piGamma, err := schnorr.NewZKProof(round.temp.gamma, round.temp.pointGamma)
+	thetaInverse := theta.Invert()
+	gamma := round.temp.gamma.BigInt()
+	defer common.WipeInts(gamma)
+	piGamma, err := schnorr.NewZKProof(round.transcript(round.PartyID()), gamma, round.temp.pointGamma, round.Rand())
 	if err != nil {
 		return round.WrapError(errors2.Wrapf(err, "NewZKProof(gamma, bigGamma)"))
 	}
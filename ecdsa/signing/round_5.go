@@ -11,9 +11,9 @@ import (
 
 	errors2 "github.com/pkg/errors"
 
-	"github.com/bnb-chain/tss-lib/common"
 	"github.com/bnb-chain/tss-lib/crypto"
 	"github.com/bnb-chain/tss-lib/crypto/commitments"
+	"github.com/bnb-chain/tss-lib/crypto/group"
 	"github.com/bnb-chain/tss-lib/tss"
 )
 
@@ -26,6 +26,8 @@ func (round *round5) Start() *tss.Error {
 	round.resetOK()
 
 	R := round.temp.pointGamma
+	bigGammaJs := make([]*crypto.ECPoint, len(round.Parties().IDs()))
+	bigGammaJs[round.PartyID().Index] = round.temp.pointGamma
 	for j, Pj := range round.Parties().IDs() {
 		if j == round.PartyID().Index {
 			continue
@@ -46,38 +48,41 @@ func (round *round5) Start() *tss.Error {
 		if err != nil {
 			return round.WrapError(errors.New("failed to unmarshal bigGamma proof"), Pj)
 		}
-		ok = proof.Verify(bigGammaJPoint)
+		ok = proof.Verify(round.transcript(Pj), bigGammaJPoint)
 		if !ok {
 			return round.WrapError(errors.New("failed to prove bigGamma"), Pj)
 		}
+		bigGammaJs[j] = bigGammaJPoint
 		R, err = R.Add(bigGammaJPoint)
 		if err != nil {
 			return round.WrapError(errors2.Wrapf(err, "R.Add(bigGammaJ)"), Pj)
 		}
 	}
 
-	R = R.ScalarMult(round.temp.thetaInverse)
-	N := round.Params().EC().Params().N
-	modN := common.ModInt(N)
+	R = R.ScalarMultScalar(round.temp.thetaInverse)
+	g, err := round.curveGroup()
+	if err != nil {
+		return round.WrapError(err)
+	}
 	rx := R.X()
 	ry := R.Y()
-	si := modN.Add(modN.Mul(round.temp.m, round.temp.k), modN.Mul(rx, round.temp.sigma))
+	si := g.ScalarFromBigInt(round.temp.m).Mul(round.temp.k).
+		Add(g.ScalarFromBigInt(rx).Mul(round.temp.sigma))
 
-	// clear temp.w and temp.k from memory, lint ignore
-	round.temp.w = zero
-	round.temp.k = zero
+	// clear temp.w from memory; k is kept until the end as it is revealed if U != T
+	group.WipeScalars(round.temp.w)
 
-	li := common.GetRandomPositiveInt(N)  // li
-	roI := common.GetRandomPositiveInt(N) // pi
-	rToSi := R.ScalarMult(si)
-	liPoint := crypto.ScalarBaseMult(round.Params().EC(), li)
-	bigAi := crypto.ScalarBaseMult(round.Params().EC(), roI)
+	li := round.randomScalar(g)  // li
+	roI := round.randomScalar(g) // pi
+	rToSi := R.ScalarMultScalar(si)
+	liPoint := crypto.ScalarBaseMultScalar(round.Params().EC(), li)
+	bigAi := crypto.ScalarBaseMultScalar(round.Params().EC(), roI)
 	bigVi, err := rToSi.Add(liPoint)
 	if err != nil {
 		return round.WrapError(errors2.Wrapf(err, "rToSi.Add(li)"))
 	}
 
-	cmt := commitments.NewHashCommitment(bigVi.X(), bigVi.Y(), bigAi.X(), bigAi.Y())
+	cmt := commitments.NewHashCommitmentWithRandom(round.Rand(), bigVi.X(), bigVi.Y(), bigAi.X(), bigAi.Y())
 	r5msg := NewSignRound5Message(round.PartyID(), cmt.C)
 	round.temp.signRound5Messages[round.PartyID().Index] = r5msg
 	round.out <- r5msg
@@ -91,6 +96,7 @@ func (round *round5) Start() *tss.Error {
 	round.temp.rx = rx
 	round.temp.ry = ry
 	round.temp.bigR = R
+	round.temp.bigGammaJs = bigGammaJs
 
 	return nil
 }
@@ -11,6 +11,7 @@ import (
 
 	errors2 "github.com/pkg/errors"
 
+	"github.com/bnb-chain/tss-lib/common"
 	"github.com/bnb-chain/tss-lib/crypto/schnorr"
 	"github.com/bnb-chain/tss-lib/tss"
 )
@@ -23,11 +24,13 @@ func (round *round6) Start() *tss.Error {
 	round.started = true
 	round.resetOK()
 
-	piAi, err := schnorr.NewZKProof(round.temp.roi, round.temp.bigAi)
+	roi, si, li := round.temp.roi.BigInt(), round.temp.si.BigInt(), round.temp.li.BigInt()
+	defer common.WipeInts(roi, si, li)
+	piAi, err := schnorr.NewZKProof(round.transcript(round.PartyID()), roi, round.temp.bigAi, round.Rand())
 	if err != nil {
 		return round.WrapError(errors2.Wrapf(err, "NewZKProof(roi, bigAi)"))
 	}
-	piV, err := schnorr.NewZKVProof(round.temp.bigVi, round.temp.bigR, round.temp.si, round.temp.li)
+	piV, err := schnorr.NewZKVProof(round.transcript(round.PartyID()), round.temp.bigVi, round.temp.bigR, si, li, round.Rand())
 	if err != nil {
 		return round.WrapError(errors2.Wrapf(err, "NewZKVProof(bigVi, bigR, si, li)"))
 	}
@@ -8,11 +8,9 @@ package signing
 
 import (
 	"errors"
-	"math/big"
 
 	errors2 "github.com/pkg/errors"
 
-	"github.com/bnb-chain/tss-lib/common"
 	"github.com/bnb-chain/tss-lib/crypto"
 	"github.com/bnb-chain/tss-lib/crypto/commitments"
 	"github.com/bnb-chain/tss-lib/tss"
@@ -52,20 +50,28 @@ func (round *round7) Start() *tss.Error {
 		}
 		bigAjs[j] = bigAj
 		pijA, err := r6msg.UnmarshalZKProof(round.Params().EC())
-		if err != nil || !pijA.Verify(bigAj) {
+		if err != nil || !pijA.Verify(round.transcript(Pj), bigAj) {
 			return round.WrapError(errors.New("schnorr verify for Aj failed"), Pj)
 		}
 		pijV, err := r6msg.UnmarshalZKVProof(round.Params().EC())
-		if err != nil || !pijV.Verify(bigVj, round.temp.bigR) {
+		if err != nil || !pijV.Verify(round.transcript(Pj), bigVj, round.temp.bigR) {
 			return round.WrapError(errors.New("vverify for Vj failed"), Pj)
 		}
 	}
 
-	modN := common.ModInt(round.Params().EC().Params().N)
+	bigVjs[round.PartyID().Index] = round.temp.bigVi
+	bigAjs[round.PartyID().Index] = round.temp.bigAi
+	round.temp.bigVjs = bigVjs
+	round.temp.bigAjs = bigAjs
+
+	g, err := round.curveGroup()
+	if err != nil {
+		return round.WrapError(err)
+	}
 	AX, AY := round.temp.bigAi.X(), round.temp.bigAi.Y()
-	minusM := modN.Sub(big.NewInt(0), round.temp.m)
+	minusM := g.ScalarFromBigInt(round.temp.m).Negate()
 	gToMInvX, gToMInvY := round.Params().EC().ScalarBaseMult(minusM.Bytes())
-	minusR := modN.Sub(big.NewInt(0), round.temp.rx)
+	minusR := g.ScalarFromBigInt(round.temp.rx).Negate()
 	yToRInvX, yToRInvY := round.Params().EC().ScalarMult(round.key.ECDSAPub.X(), round.key.ECDSAPub.Y(), minusR.Bytes())
 	VX, VY := round.Params().EC().Add(gToMInvX, gToMInvY, yToRInvX, yToRInvY)
 	VX, VY = round.Params().EC().Add(VX, VY, round.temp.bigVi.X(), round.temp.bigVi.Y())
@@ -78,11 +84,13 @@ func (round *round7) Start() *tss.Error {
 		AX, AY = round.Params().EC().Add(AX, AY, bigAjs[j].X(), bigAjs[j].Y())
 	}
 
+	round.temp.bigV = crypto.NewECPointNoCurveCheck(round.Params().EC(), VX, VY)
+	round.temp.bigA = crypto.NewECPointNoCurveCheck(round.Params().EC(), AX, AY)
 	UiX, UiY := round.Params().EC().ScalarMult(VX, VY, round.temp.roi.Bytes())
 	TiX, TiY := round.Params().EC().ScalarMult(AX, AY, round.temp.li.Bytes())
 	round.temp.Ui = crypto.NewECPointNoCurveCheck(round.Params().EC(), UiX, UiY)
 	round.temp.Ti = crypto.NewECPointNoCurveCheck(round.Params().EC(), TiX, TiY)
-	cmt := commitments.NewHashCommitment(UiX, UiY, TiX, TiY)
+	cmt := commitments.NewHashCommitmentWithRandom(round.Rand(), UiX, UiY, TiX, TiY)
 	r7msg := NewSignRound7Message(round.PartyID(), cmt.C)
 	round.temp.signRound7Messages[round.PartyID().Index] = r7msg
 	round.out <- r7msg
@@ -8,8 +8,12 @@ package signing
 
 import (
 	"errors"
+	"math/big"
 
+	"github.com/bnb-chain/tss-lib/common"
+	"github.com/bnb-chain/tss-lib/crypto"
 	"github.com/bnb-chain/tss-lib/crypto/commitments"
+	"github.com/bnb-chain/tss-lib/crypto/schnorr"
 	"github.com/bnb-chain/tss-lib/tss"
 )
 
@@ -21,6 +25,9 @@ func (round *round9) Start() *tss.Error {
 	round.started = true
 	round.resetOK()
 
+	bigUjs := make([]*crypto.ECPoint, len(round.Parties().IDs()))
+	bigTjs := make([]*crypto.ECPoint, len(round.Parties().IDs()))
+	bigUjs[round.PartyID().Index], bigTjs[round.PartyID().Index] = round.temp.Ui, round.temp.Ti
 	UX, UY := round.temp.Ui.X(), round.temp.Ui.Y()
 	TX, TY := round.temp.Ti.X(), round.temp.Ti.Y()
 	for j, Pj := range round.Parties().IDs() {
@@ -37,21 +44,40 @@ func (round *round9) Start() *tss.Error {
 			return round.WrapError(errors.New("de-commitment for bigVj and bigAj failed"), Pj)
 		}
 		UjX, UjY, TjX, TjY := values[0], values[1], values[2], values[3]
+		bigUjs[j] = crypto.NewECPointNoCurveCheck(round.Params().EC(), UjX, UjY)
+		bigTjs[j] = crypto.NewECPointNoCurveCheck(round.Params().EC(), TjX, TjY)
 		UX, UY = round.Params().EC().Add(UX, UY, UjX, UjY)
 		TX, TY = round.Params().EC().Add(TX, TY, TjX, TjY)
 	}
+	round.temp.bigUjs, round.temp.bigTjs = bigUjs, bigTjs
 	if UX.Cmp(TX) != 0 || UY.Cmp(TY) != 0 {
-		return round.WrapError(errors.New("U doesn't equal T"), round.PartyID())
+		if round.Params().LegacyProofs() {
+			return round.WrapError(errors.New("U doesn't equal T"), round.PartyID())
+		}
+		// no signature can be made with this R any more, so k and the MtA shares may be revealed to find the cheater
+		round.temp.identify = true
+		r9msg, err := round.identificationMessage()
+		if err != nil {
+			return round.WrapError(err)
+		}
+		round.temp.signIdentificationMessages[round.PartyID().Index] = r9msg
+		round.out <- r9msg
+		return nil
 	}
 
-	r9msg := NewSignRound9Message(round.PartyID(), round.temp.si)
+	// BROADCAST s_i along with l_i, which no longer needs hiding now that U = T, so that s_i can be checked against V_i
+	r9msg := NewSignRound9Message(round.PartyID(), round.temp.si.BigInt(), round.temp.li.BigInt())
 	round.temp.signRound9Messages[round.PartyID().Index] = r9msg
 	round.out <- r9msg
 	return nil
 }
 
 func (round *round9) Update() (bool, *tss.Error) {
-	for j, msg := range round.temp.signRound9Messages {
+	msgs := round.temp.signRound9Messages
+	if round.temp.identify {
+		msgs = round.temp.signIdentificationMessages
+	}
+	for j, msg := range msgs {
 		if round.ok[j] {
 			continue
 		}
@@ -64,6 +90,12 @@ func (round *round9) Update() (bool, *tss.Error) {
 }
 
 func (round *round9) CanAccept(msg tss.ParsedMessage) bool {
+	if round.temp.identify {
+		if _, ok := msg.Content().(*SignIdentificationMessage); ok {
+			return msg.IsBroadcast()
+		}
+		return false
+	}
 	if _, ok := msg.Content().(*SignRound9Message); ok {
 		return msg.IsBroadcast()
 	}
@@ -72,5 +104,43 @@ func (round *round9) CanAccept(msg tss.ParsedMessage) bool {
 
 func (round *round9) NextRound() tss.Round {
 	round.started = false
+	if round.temp.identify {
+		return &identification{round}
+	}
 	return &finalization{round}
 }
+
+// identificationMessage reveals k_i with the randomness of its encryptions, gamma_i, rho_i and l_i, the shares of the
+// MtA with gamma, and the shares of the MtA with w as points. S_i = sigma_i*R comes with a proof that it has the same
+// discrete logarithm as sigma_i*G, which the others compute from the revealed points.
+func (round *round9) identificationMessage() (tss.ParsedMessage, error) {
+	n := len(round.Parties().IDs())
+	alphas, betas := make([]*big.Int, n), make([]*big.Int, n)
+	for j := range round.Parties().IDs() {
+		if j == round.PartyID().Index {
+			continue
+		}
+		alphas[j], betas[j] = round.temp.alphas[j].BigInt(), round.temp.betas[j].BigInt()
+	}
+	sigmaPoint := crypto.ScalarBaseMultScalar(round.Params().EC(), round.temp.sigma)
+	bigS := round.temp.bigR.ScalarMultScalar(round.temp.sigma)
+	sigma := round.temp.sigma.BigInt()
+	defer common.WipeInts(sigma)
+	proof, err := schnorr.NewZKDLEQProof(round.transcript(round.PartyID()), sigma, sigmaPoint, round.temp.bigR, bigS, round.Rand())
+	if err != nil {
+		return nil, err
+	}
+	return NewSignIdentificationMessage(
+		round.PartyID(),
+		round.temp.k.BigInt(),
+		round.temp.gamma.BigInt(),
+		round.temp.kRandomness,
+		alphas,
+		betas,
+		round.temp.uPoints,
+		round.temp.vPoints,
+		bigS,
+		proof,
+		round.temp.roi.BigInt(),
+		round.temp.li.BigInt()), nil
+}
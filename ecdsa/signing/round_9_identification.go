@@ -0,0 +1,236 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package signing
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/bnb-chain/tss-lib/common"
+	"github.com/bnb-chain/tss-lib/crypto"
+	"github.com/bnb-chain/tss-lib/crypto/group"
+	"github.com/bnb-chain/tss-lib/tss"
+)
+
+// The identification round runs instead of finalization when U != T, which means that some V_j was not built from a
+// correct s_j. Every party has revealed the values behind its theta_j, sigma_j*R, V_j, A_j, U_j and T_j, so that its
+// own values can be checked by anyone. The values it revealed for an MtA with another party can only be checked by
+// that party, as only the two of them know how the MtA went. Every pair of parties runs an MtA, so a party that finds
+// no fault with the others reports no culprit: the cheaters then only lied about the MtAs between themselves.
+func (round *identification) Start() *tss.Error {
+	if round.started {
+		return round.WrapError(errors.New("round already started"))
+	}
+	round.number = 10
+	round.started = true
+	round.resetOK()
+
+	g, err := round.curveGroup()
+	if err != nil {
+		return round.WrapError(err)
+	}
+	culprits := make([]*tss.PartyID, 0, len(round.Parties().IDs()))
+	for j, Pj := range round.Parties().IDs() {
+		round.ok[j] = true
+		if j == round.PartyID().Index {
+			continue
+		}
+		msg := round.temp.signIdentificationMessages[j].Content().(*SignIdentificationMessage)
+		if err := round.checkRevealed(g, j, msg); err != nil {
+			common.Logger.Warningf("the revealed values of party %s are bad: %v", Pj, err)
+			culprits = append(culprits, Pj)
+		}
+	}
+	if len(culprits) > 0 {
+		return round.WrapError(errors.New("U doesn't equal T because of the bad values of these parties"), culprits...)
+	}
+	return round.WrapError(errors.New("U doesn't equal T, but the party that caused it could not be identified"))
+}
+
+// checkRevealed checks the values that party j revealed, first against the values it broadcast during signing and
+// then against the MtAs that it ran with this party
+func (round *identification) checkRevealed(g group.CurveGroup, j int, msg *SignIdentificationMessage) error {
+	ec := round.Params().EC()
+	i := round.PartyID().Index
+	q := ec.Params().N
+	if msg.PartyCount() != len(round.Parties().IDs()) {
+		return errors.New("the values are not given for every party")
+	}
+	k := msg.UnmarshalK()
+	if k.Sign() <= 0 || k.Cmp(q) >= 0 {
+		return errors.New("k is not in [1, q)")
+	}
+	kj, gammaj := g.ScalarFromBigInt(k), g.ScalarFromBigInt(msg.UnmarshalGamma())
+	rhoj, lj := g.ScalarFromBigInt(msg.UnmarshalRho()), g.ScalarFromBigInt(msg.UnmarshalL())
+	gen := g.Generator()
+
+	bigGammaJ, err := round.temp.bigGammaJs[j].ToGroupPoint()
+	if err != nil {
+		return err
+	}
+	if !gen.ScalarMult(gammaj).Equal(bigGammaJ) {
+		return errors.New("gamma does not match the Gamma decommitted in round 4")
+	}
+
+	// theta_j = k_j*gamma_j + sum(alpha_jl + beta_lj) and sigma_j*G = k_j*W_j + sum(u_jl*G + v_lj*G)
+	theta := kj.Mul(gammaj)
+	bigWj, err := round.temp.bigWs[j].ToGroupPoint()
+	if err != nil {
+		return err
+	}
+	sigmaPoint := bigWj.ScalarMult(kj)
+	for l := range round.Parties().IDs() {
+		if l == j {
+			continue
+		}
+		theta = theta.Add(g.ScalarFromBigInt(msg.UnmarshalAlpha(l))).Add(g.ScalarFromBigInt(msg.UnmarshalBeta(l)))
+		uPoint, err := msg.UnmarshalUPoint(ec, l)
+		if err != nil {
+			return fmt.Errorf("u*G for party %d: %v", l, err)
+		}
+		vPoint, err := msg.UnmarshalVPoint(ec, l)
+		if err != nil {
+			return fmt.Errorf("v*G for party %d: %v", l, err)
+		}
+		u, err := uPoint.ToGroupPoint()
+		if err != nil {
+			return err
+		}
+		v, err := vPoint.ToGroupPoint()
+		if err != nil {
+			return err
+		}
+		sigmaPoint = sigmaPoint.Add(u).Add(v)
+	}
+	r3msg := round.temp.signRound3Messages[j].Content().(*SignRound3Message)
+	if !theta.Equal(g.ScalarFromBigInt(new(big.Int).SetBytes(r3msg.GetTheta()))) {
+		return errors.New("theta does not match the MtA shares")
+	}
+
+	// S_j = sigma_j*R, and V_j = m*k_j*R + r*S_j + l_j*G must be the V_j decommitted in round 6
+	sigmaECPoint, err := crypto.NewECPointFromGroupPoint(g, sigmaPoint)
+	if err != nil {
+		return err
+	}
+	bigSj, err := msg.UnmarshalBigS(ec)
+	if err != nil {
+		return err
+	}
+	proof, err := msg.UnmarshalDLEQProof(ec)
+	if err != nil {
+		return err
+	}
+	if !proof.Verify(round.transcript(round.Parties().IDs()[j]), sigmaECPoint, round.temp.bigR, bigSj) {
+		return errors.New("the proof of S = sigma*R failed to verify")
+	}
+	R, err := round.temp.bigR.ToGroupPoint()
+	if err != nil {
+		return err
+	}
+	S, err := bigSj.ToGroupPoint()
+	if err != nil {
+		return err
+	}
+	bigVj, err := round.temp.bigVjs[j].ToGroupPoint()
+	if err != nil {
+		return err
+	}
+	m, r := g.ScalarFromBigInt(round.temp.m), g.ScalarFromBigInt(round.temp.rx)
+	if !R.ScalarMult(m.Mul(kj)).Add(S.ScalarMult(r)).Add(gen.ScalarMult(lj)).Equal(bigVj) {
+		return errors.New("V is not s*R + l*G for the s of the revealed values")
+	}
+
+	// A_j = rho_j*G, U_j = rho_j*V and T_j = l_j*A
+	bigAj, err := round.temp.bigAjs[j].ToGroupPoint()
+	if err != nil {
+		return err
+	}
+	if !gen.ScalarMult(rhoj).Equal(bigAj) {
+		return errors.New("A is not rho*G")
+	}
+	V, err := round.temp.bigV.ToGroupPoint()
+	if err != nil {
+		return err
+	}
+	A, err := round.temp.bigA.ToGroupPoint()
+	if err != nil {
+		return err
+	}
+	bigUj, err := round.temp.bigUjs[j].ToGroupPoint()
+	if err != nil {
+		return err
+	}
+	bigTj, err := round.temp.bigTjs[j].ToGroupPoint()
+	if err != nil {
+		return err
+	}
+	if !V.ScalarMult(rhoj).Equal(bigUj) || !A.ScalarMult(lj).Equal(bigTj) {
+		return errors.New("U is not rho*V or T is not l*A")
+	}
+
+	// as Bob for k_j: c_j must encrypt k_j, alpha_ji = k_j*gamma_i - beta_ji and u_ji*G = k_j*W_i - v_ji*G
+	r1msg := round.temp.signRound1Message1s[j].Content().(*SignRound1Message1)
+	cj, err := round.key.PaillierPKs[j].EncryptWithRandomness(k, msg.UnmarshalKRandomness(i))
+	if err != nil || cj.Cmp(r1msg.UnmarshalC()) != 0 {
+		return errors.New("k does not open the encryption of k sent in round 1")
+	}
+	if !g.ScalarFromBigInt(msg.UnmarshalAlpha(i)).Equal(kj.Mul(round.temp.gamma).Sub(round.temp.betas[j])) {
+		return errors.New("alpha does not match our MtA with gamma")
+	}
+	uPoint, err := msg.UnmarshalUPoint(ec, i)
+	if err != nil {
+		return err
+	}
+	if !round.isMtAPoint(kj, round.temp.bigWs[i], uPoint, round.temp.vPoints[j]) {
+		return errors.New("u*G does not match our MtA with w")
+	}
+
+	// as Alice for gamma_j and w_j: beta_ij = k_i*gamma_j - alpha_ij and v_ij*G = k_i*W_j - u_ij*G
+	if !g.ScalarFromBigInt(msg.UnmarshalBeta(i)).Equal(round.temp.k.Mul(gammaj).Sub(round.temp.alphas[j])) {
+		return errors.New("beta does not match our MtA with gamma")
+	}
+	vPoint, err := msg.UnmarshalVPoint(ec, i)
+	if err != nil {
+		return err
+	}
+	if !round.isMtAPoint(round.temp.k, round.temp.bigWs[j], vPoint, round.temp.uPoints[j]) {
+		return errors.New("v*G does not match our MtA with w")
+	}
+	return nil
+}
+
+// isMtAPoint reports whether the shares of an MtA of k and w, revealed as points, add up to k*W
+func (round *identification) isMtAPoint(k group.Scalar, W, theirs, ours *crypto.ECPoint) bool {
+	bigW, err := W.ToGroupPoint()
+	if err != nil {
+		return false
+	}
+	t, err := theirs.ToGroupPoint()
+	if err != nil {
+		return false
+	}
+	o, err := ours.ToGroupPoint()
+	if err != nil {
+		return false
+	}
+	return t.Add(o).Equal(bigW.ScalarMult(k))
+}
+
+func (round *identification) CanAccept(msg tss.ParsedMessage) bool {
+	// not expecting any incoming messages in this round
+	return false
+}
+
+func (round *identification) Update() (bool, *tss.Error) {
+	// not expecting any incoming messages in this round
+	return false, nil
+}
+
+func (round *identification) NextRound() tss.Round {
+	return nil // finished!
+}
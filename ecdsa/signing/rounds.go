@@ -7,7 +7,11 @@
 package signing
 
 import (
+	"fmt"
+
 	"github.com/bnb-chain/tss-lib/common"
+	"github.com/bnb-chain/tss-lib/crypto/group"
+	"github.com/bnb-chain/tss-lib/crypto/transcript"
 	"github.com/bnb-chain/tss-lib/ecdsa/keygen"
 	"github.com/bnb-chain/tss-lib/tss"
 )
@@ -58,6 +62,10 @@ type (
 	finalization struct {
 		*round9
 	}
+	// runs instead of finalization when U != T
+	identification struct {
+		*round9
+	}
 )
 
 var (
@@ -71,6 +79,7 @@ var (
 	_ tss.Round = (*round8)(nil)
 	_ tss.Round = (*round9)(nil)
 	_ tss.Round = (*finalization)(nil)
+	_ tss.Round = (*identification)(nil)
 )
 
 // ----- //
@@ -115,6 +124,49 @@ func (round *base) WrapError(err error, culprits ...*tss.PartyID) *tss.Error {
 
 // ----- //
 
+// curveGroup returns the group of the signing curve, in whose constant-time scalars the secret values are computed
+func (round *base) curveGroup() (group.CurveGroup, error) {
+	g, ok := group.FromCurve(round.Params().EC())
+	if !ok {
+		return nil, fmt.Errorf("no group is registered for the curve %s", round.Params().EC().Params().Name)
+	}
+	return g, nil
+}
+
+// transcript returns the Fiat-Shamir transcript for a proof made by `prover`, or nil if the legacy proofs are used
+func (round *base) transcript(prover *tss.PartyID) *transcript.Transcript {
+	return transcript.ForProver(round.Params(), TaskName, prover)
+}
+
+// randomScalar returns a random scalar in [0, q) drawn from the randomness source of the party
+func (round *base) randomScalar(g group.Group) group.Scalar {
+	r := common.GetRandomPositiveIntWithRandom(round.Rand(), round.Params().EC().Params().N)
+	defer common.WipeInts(r)
+	return g.ScalarFromBigInt(r)
+}
+
+// nonce returns a secret nonce in [1, q) for the value named `label`. Unless hedged nonces are disabled, the randomness
+// is hedged with a PRF of the party's share, the message, the session ID and the signing parties, so that a weak or
+// repeated RNG state does not repeat the nonce for another message or session.
+func (round *base) nonce(g group.Group, label string) group.Scalar {
+	if !round.HedgedNonces() {
+		return round.randomScalar(g)
+	}
+	t := transcript.New(TaskName + " nonce")
+	t.AppendMessage("session", round.SessionID())
+	curveName, _ := tss.GetCurveName(round.Params().EC())
+	t.AppendMessage("curve", []byte(curveName))
+	for _, Pj := range round.Parties().IDs() {
+		t.AppendPartyID("party", Pj)
+	}
+	t.AppendPartyID("signer", round.PartyID())
+	t.AppendInts("message", round.temp.m)
+	xi := round.temp.xi.BigInt()
+	k := t.WitnessInt(label, xi, round.Rand(), round.Params().EC().Params().N)
+	defer common.WipeInts(xi, k)
+	return g.ScalarFromBigInt(k)
+}
+
 // `ok` tracks parties which have been verified by Update()
 func (round *base) resetOK() {
 	for j := range round.ok {
@@ -21,6 +21,7 @@ import (
 
 	"github.com/bnb-chain/tss-lib/common"
 	"github.com/bnb-chain/tss-lib/crypto"
+	cmt "github.com/bnb-chain/tss-lib/crypto/commitments"
 	"github.com/bnb-chain/tss-lib/crypto/vss"
 	"github.com/bnb-chain/tss-lib/test"
 	"github.com/bnb-chain/tss-lib/tss"
@@ -37,6 +38,34 @@ func setUp(level string) {
 	}
 }
 
+func TestAbortWipesTempData(t *testing.T) {
+	setUp("info")
+
+	pIDs := tss.GenerateTestPartyIDs(3)
+	params := tss.NewParameters(tss.Edwards(), tss.NewPeerContext(pIDs), pIDs[0], len(pIDs), 1)
+	out := make(chan tss.Message, len(pIDs))
+	lp := NewLocalParty(params, out, nil).(*LocalParty)
+	if err := lp.Start(); err != nil {
+		assert.FailNow(t, err.Error())
+	}
+	msg := <-out
+	ui, shares := lp.temp.ui, lp.temp.shares
+	assert.NotZero(t, ui.Sign())
+
+	tss.Abort(lp)
+	assert.False(t, lp.Running())
+	assert.Zero(t, ui.Sign())
+	for _, share := range shares {
+		assert.Zero(t, share.Share.Sign())
+	}
+
+	// an aborted party cannot be restarted or updated on its wiped values
+	assert.NotNil(t, lp.Start(), "an aborted party should not start again")
+	_, err := lp.Update(msg.(tss.ParsedMessage))
+	assert.NotNil(t, err, "an aborted party should not accept messages")
+	assert.False(t, lp.Running())
+}
+
 func TestE2EConcurrentAndSaveFixtures(t *testing.T) {
 	setUp("info")
 
@@ -75,6 +104,12 @@ func TestE2EConcurrentAndSaveFixtures(t *testing.T) {
 		}(P)
 	}
 
+	// dealtShares[j][i] is the share of Pj's polynomial sent to Pi
+	dealtShares := make([][]*big.Int, len(pIDs))
+	for j := range dealtShares {
+		dealtShares[j] = make([]*big.Int, len(pIDs))
+	}
+
 	// PHASE: keygen
 	var ended int32
 keygen:
@@ -100,6 +135,10 @@ keygen:
 					t.Fatalf("party %d tried to send a message to itself (%d)", dest[0].Index, msg.GetFrom().Index)
 					return
 				}
+				// the parties wipe the shares they received once they finish, so keep a copy for the checks below
+				if r2msg1, ok := msg.(tss.ParsedMessage).Content().(*KGRound2Message1); ok {
+					dealtShares[msg.GetFrom().Index][dest[0].Index] = new(big.Int).SetBytes(r2msg1.Share)
+				}
 				go updater(parties[dest[0].Index], msg, errCh)
 			}
 
@@ -122,12 +161,10 @@ keygen:
 						if j2 == j {
 							continue
 						}
-						vssMsgs := P.temp.kgRound2Message1s
-						share := vssMsgs[j].Content().(*KGRound2Message1).Share
 						shareStruct := &vss.Share{
 							Threshold: threshold,
 							ID:        P.PartyID().KeyInt(),
-							Share:     new(big.Int).SetBytes(share),
+							Share:     dealtShares[j][j2],
 						}
 						pShares = append(pShares, shareStruct)
 					}
@@ -135,7 +172,6 @@ keygen:
 					assert.NoError(t, err, "vss.ReConstruct should not throw error")
 
 					// uG test: u*G[j] == V[0]
-					assert.Equal(t, uj, Pj.temp.ui)
 					uG := crypto.ScalarBaseMult(tss.Edwards(), uj)
 					assert.True(t, uG.Equals(Pj.temp.vs[0]), "ensure u*G[j] == V_0")
 
@@ -149,10 +185,10 @@ keygen:
 					{
 						badShares := pShares[:threshold]
 						badShares[len(badShares)-1].Share.Set(big.NewInt(0))
-						uj, err := pShares[:threshold].ReConstruct(tss.Edwards())
+						badUj, err := pShares[:threshold].ReConstruct(tss.Edwards())
 						assert.NoError(t, err)
-						assert.NotEqual(t, parties[j].temp.ui, uj)
-						BigXjX, BigXjY := tss.Edwards().ScalarBaseMult(uj.Bytes())
+						assert.NotEqual(t, uj, badUj)
+						BigXjX, BigXjY := tss.Edwards().ScalarBaseMult(badUj.Bytes())
 						assert.NotEqual(t, BigXjX, Pj.temp.vs[0].X())
 						assert.NotEqual(t, BigXjY, Pj.temp.vs[0].Y())
 					}
@@ -185,10 +221,12 @@ keygen:
 				assert.Equal(t, pkY, ourPkY, "pkY should match expected pk derived from u")
 				t.Log("Public key tests done.")
 
-				// make sure everyone has the same EDDSA public key
+				// make sure everyone has the same EDDSA public key and chain code
 				for _, Pj := range parties {
 					assert.Equal(t, pkX, Pj.data.EDDSAPub.X())
 					assert.Equal(t, pkY, Pj.data.EDDSAPub.Y())
+					assert.Len(t, Pj.data.ChainCode, ChainCodeLen)
+					assert.Equal(t, save.ChainCode, Pj.data.ChainCode)
 				}
 				t.Log("Public key distribution test done.")
 
@@ -236,3 +274,329 @@ func tryWriteTestFixtureFile(t *testing.T, index int, data LocalPartySaveData) {
 	}
 	//
 }
+
+func TestE2EPedersenDKG(t *testing.T) {
+	setUp("info")
+	threshold := testThreshold
+	pIDs := tss.GenerateTestPartyIDs(testParticipants)
+	p2pCtx := tss.NewPeerContext(pIDs)
+	parties := make([]*LocalParty, 0, len(pIDs))
+	errCh := make(chan *tss.Error, len(pIDs))
+	outCh := make(chan tss.Message, len(pIDs))
+	endCh := make(chan LocalPartySaveData, len(pIDs))
+	for i := 0; i < len(pIDs); i++ {
+		params := tss.NewParameters(tss.Edwards(), p2pCtx, pIDs[i], len(pIDs), threshold)
+		params.SetPedersenDKG(true)
+		P := NewLocalParty(params, outCh, endCh).(*LocalParty)
+		parties = append(parties, P)
+		go func(P *LocalParty) {
+			if err := P.Start(); err != nil {
+				errCh <- err
+			}
+		}(P)
+	}
+	saves := make([]LocalPartySaveData, len(pIDs))
+	for ended := 0; ended < len(pIDs); {
+		select {
+		case err := <-errCh:
+			assert.FailNow(t, err.Error())
+		case msg := <-outCh:
+			if r1msg, ok := msg.(tss.ParsedMessage).Content().(*KGRound1Message); ok {
+				assert.Len(t, r1msg.GetPedersenCommitments(), 2*(threshold+1), "round 1 should carry the Pedersen commitments")
+			}
+			dest := msg.GetTo()
+			if dest == nil {
+				for _, P := range parties {
+					if P.PartyID().Index != msg.GetFrom().Index {
+						go test.SharedPartyUpdater(P, msg, errCh)
+					}
+				}
+			} else {
+				go test.SharedPartyUpdater(parties[dest[0].Index], msg, errCh)
+			}
+		case save := <-endCh:
+			index, err := save.OriginalIndex()
+			assert.NoError(t, err)
+			saves[index] = save
+			ended++
+		}
+	}
+
+	// the shares x_i reconstruct the secret key of the common public key
+	shares := make(vss.Shares, len(saves))
+	for i, save := range saves {
+		assert.True(t, save.EDDSAPub.Equals(saves[0].EDDSAPub), "every party should have the same public key")
+		shares[i] = &vss.Share{Threshold: threshold, ID: save.ShareID, Share: save.Xi}
+	}
+	x, err := shares[:threshold+1].ReConstruct(tss.Edwards())
+	assert.NoError(t, err)
+	assert.True(t, crypto.ScalarBaseMult(tss.Edwards(), x).Equals(saves[0].EDDSAPub))
+}
+
+func TestE2ERobustKeygen(t *testing.T) {
+	setUp("info")
+	threshold := 1
+	pIDs := tss.GenerateTestPartyIDs(4)
+	p2pCtx := tss.NewPeerContext(pIDs)
+	parties := make([]*LocalParty, 0, len(pIDs))
+	errCh := make(chan *tss.Error, len(pIDs))
+	outCh := make(chan tss.Message, len(pIDs))
+	endCh := make(chan LocalPartySaveData, len(pIDs))
+	for i := 0; i < len(pIDs); i++ {
+		params := tss.NewParameters(tss.Edwards(), p2pCtx, pIDs[i], len(pIDs), threshold)
+		params.SetRobustKeygen(true)
+		params.SetPedersenDKG(true)
+		P := NewLocalParty(params, outCh, endCh).(*LocalParty)
+		parties = append(parties, P)
+		go func(P *LocalParty) {
+			if err := P.Start(); err != nil {
+				errCh <- err
+			}
+		}(P)
+	}
+	badShare := &vss.Share{Share: big.NewInt(42)}
+
+	// P0 deals a bad share to P1 and reveals it again after P1's complaint, so it is disqualified;
+	// P2's share to P3 is corrupted in transit, so P2 reveals the good share and stays in
+	saves := make([]LocalPartySaveData, len(pIDs))
+	for ended := 0; ended < len(pIDs); {
+		select {
+		case err := <-errCh:
+			assert.FailNow(t, err.Error())
+		case msg := <-outCh:
+			from, dest := msg.GetFrom(), msg.GetTo()
+			switch content := msg.(tss.ParsedMessage).Content().(type) {
+			case *KGRound2Message1:
+				if (from.Index == 0 && dest[0].Index == 1) || (from.Index == 2 && dest[0].Index == 3) {
+					msg = NewKGRound2Message1(dest[0], from, badShare, badShare)
+				}
+			case *KGRevealMessage:
+				if from.Index == 0 {
+					assert.Equal(t, []uint32{1}, content.GetComplainants())
+					msg = NewKGRevealMessage(from, []int{1}, vss.Shares{nil, badShare}, vss.Shares{nil, badShare})
+				}
+				if from.Index == 2 {
+					assert.Equal(t, []uint32{3}, content.GetComplainants())
+					assert.Len(t, content.GetBlindingShares(), 1)
+				}
+			}
+			if dest == nil {
+				for _, P := range parties {
+					if P.PartyID().Index != from.Index {
+						go test.SharedPartyUpdater(P, msg, errCh)
+					}
+				}
+			} else {
+				go test.SharedPartyUpdater(parties[dest[0].Index], msg, errCh)
+			}
+		case save := <-endCh:
+			// the indexes into Ks no longer match the party indexes once the cheater is taken out
+			for j, Pj := range pIDs {
+				if Pj.KeyInt().Cmp(save.ShareID) == 0 {
+					saves[j] = save
+				}
+			}
+			ended++
+		}
+	}
+
+	// the key is shared by P1..P3 and made of their polynomials only; the cheater's own view differs
+	honest := saves[1:]
+	pub := parties[1].temp.vs[0]
+	var err error
+	for _, P := range parties[2:] {
+		pub, err = pub.Add(P.temp.vs[0])
+		assert.NoError(t, err)
+	}
+	for _, save := range honest {
+		assert.True(t, save.EDDSAPub.Equals(pub), "the key should leave out the disqualified party")
+		assert.Equal(t, []*big.Int{pIDs[0].KeyInt()}, save.DisqualifiedKs)
+		assert.Equal(t, pIDs[1:].Keys(), save.Ks, "the disqualified party should have no data in the key")
+		assert.Len(t, save.BigXj, len(pIDs)-1)
+		assert.Panics(t, func() { BuildLocalSaveDataSubset(save, pIDs[:2]) }, "the disqualified party should not sign")
+	}
+	for _, pair := range [][]LocalPartySaveData{honest[:2], honest[1:]} {
+		shares := make(vss.Shares, len(pair))
+		for i, save := range pair {
+			shares[i] = &vss.Share{Threshold: threshold, ID: save.ShareID, Share: save.Xi}
+		}
+		x, err := shares.ReConstruct(tss.Edwards())
+		assert.NoError(t, err)
+		assert.True(t, crypto.ScalarBaseMult(tss.Edwards(), x).Equals(pub))
+	}
+}
+
+func TestE2EPedersenDKGRebuildsBadFeldmanReveal(t *testing.T) {
+	setUp("info")
+	threshold := 1
+	pIDs := tss.GenerateTestPartyIDs(4)
+
+	// P0 deals good shares that open its Pedersen commitments, but once the qualified dealers are known it either
+	// withholds the opening of its Feldman commitments, or opens Feldman commitments that it committed to in round 1 but
+	// that do not match its shares. It could decide to do so after seeing the others' openings, so it must not be left
+	// out of the key: its polynomial is rebuilt in public instead.
+	var fake *cmt.HashCommitDecommit
+	cases := []struct {
+		name   string
+		tamper func(P0 *LocalParty, msg tss.ParsedMessage) tss.ParsedMessage
+	}{
+		{"withheld", func(P0 *LocalParty, msg tss.ParsedMessage) tss.ParsedMessage {
+			if content, ok := msg.Content().(*KGFeldmanMessage); ok {
+				proof, err := content.UnmarshalZKProof(tss.Edwards())
+				assert.NoError(t, err)
+				salt := content.UnmarshalDeCommitment()[:1]
+				return NewKGFeldmanMessage(msg.GetFrom(), salt, proof)
+			}
+			return msg
+		}},
+		{"inconsistent", func(P0 *LocalParty, msg tss.ParsedMessage) tss.ParsedMessage {
+			switch content := msg.Content().(type) {
+			case *KGRound1Message:
+				vs := append(vss.Vs{}, P0.temp.vs...)
+				var err error
+				vs[1], err = vs[1].Add(crypto.ScalarBaseMult(tss.Edwards(), big.NewInt(1)))
+				assert.NoError(t, err)
+				flat, err := crypto.FlattenECPoints(vs)
+				assert.NoError(t, err)
+				fake = cmt.NewHashCommitment(flat...)
+				pedersenCmts, err := content.UnmarshalPedersenCommitments(tss.Edwards())
+				assert.NoError(t, err)
+				r1msg, err := NewKGRound1Message(msg.GetFrom(), fake.C, content.UnmarshalChainCodeCommitment(), pedersenCmts)
+				assert.NoError(t, err)
+				return r1msg
+			case *KGFeldmanMessage:
+				proof, err := content.UnmarshalZKProof(tss.Edwards())
+				assert.NoError(t, err)
+				return NewKGFeldmanMessage(msg.GetFrom(), fake.D, proof)
+			}
+			return msg
+		}},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			p2pCtx := tss.NewPeerContext(pIDs)
+			parties := make([]*LocalParty, 0, len(pIDs))
+			errCh := make(chan *tss.Error, len(pIDs))
+			outCh := make(chan tss.Message, len(pIDs))
+			endCh := make(chan LocalPartySaveData, len(pIDs))
+			for i := 0; i < len(pIDs); i++ {
+				params := tss.NewParameters(tss.Edwards(), p2pCtx, pIDs[i], len(pIDs), threshold)
+				params.SetPedersenDKG(true)
+				P := NewLocalParty(params, outCh, endCh).(*LocalParty)
+				parties = append(parties, P)
+				go func(P *LocalParty) {
+					if err := P.Start(); err != nil {
+						errCh <- err
+					}
+				}(P)
+			}
+
+			saves := make([]LocalPartySaveData, len(pIDs))
+			for ended := 0; ended < len(pIDs); {
+				select {
+				case err := <-errCh:
+					assert.FailNow(t, err.Error())
+				case msg := <-outCh:
+					from, dest := msg.GetFrom(), msg.GetTo()
+					if from.Index == 0 {
+						msg = c.tamper(parties[0], msg.(tss.ParsedMessage))
+					}
+					if dest == nil {
+						for _, P := range parties {
+							if P.PartyID().Index != from.Index {
+								go test.SharedPartyUpdater(P, msg, errCh)
+							}
+						}
+					} else {
+						go test.SharedPartyUpdater(parties[dest[0].Index], msg, errCh)
+					}
+				case save := <-endCh:
+					index, err := save.OriginalIndex()
+					assert.NoError(t, err)
+					saves[index] = save
+					ended++
+				}
+			}
+
+			// the key is made of the polynomials of all the parties, and the shares x_i reconstruct its secret key
+			pub := parties[0].temp.vs[0]
+			var err error
+			for _, P := range parties[1:] {
+				pub, err = pub.Add(P.temp.vs[0])
+				assert.NoError(t, err)
+				assert.True(t, P.temp.exposed[0], "the polynomial of P0 should have been rebuilt")
+			}
+			shares := make(vss.Shares, len(saves))
+			for i, save := range saves {
+				assert.True(t, save.EDDSAPub.Equals(pub), "the key should include the contribution of P0")
+				assert.Empty(t, save.DisqualifiedKs)
+				assert.Equal(t, pIDs.Keys(), save.Ks)
+				shares[i] = &vss.Share{Threshold: threshold, ID: save.ShareID, Share: save.Xi}
+			}
+			x, err := shares[1 : threshold+2].ReConstruct(tss.Edwards())
+			assert.NoError(t, err)
+			assert.True(t, crypto.ScalarBaseMult(tss.Edwards(), x).Equals(pub))
+		})
+	}
+}
+
+func TestE2ERobustKeygenTooFewLeft(t *testing.T) {
+	setUp("info")
+	threshold := 2
+	pIDs := tss.GenerateTestPartyIDs(3)
+	p2pCtx := tss.NewPeerContext(pIDs)
+	parties := make([]*LocalParty, 0, len(pIDs))
+	errCh := make(chan *tss.Error, len(pIDs))
+	outCh := make(chan tss.Message, len(pIDs))
+	endCh := make(chan LocalPartySaveData, len(pIDs))
+	for i := 0; i < len(pIDs); i++ {
+		params := tss.NewParameters(tss.Edwards(), p2pCtx, pIDs[i], len(pIDs), threshold)
+		params.SetRobustKeygen(true)
+		P := NewLocalParty(params, outCh, endCh).(*LocalParty)
+		parties = append(parties, P)
+		go func(P *LocalParty) {
+			if err := P.Start(); err != nil {
+				errCh <- err
+			}
+		}(P)
+	}
+	badShare := &vss.Share{Share: big.NewInt(42)}
+
+	// P0 is disqualified, which leaves two parties to hold a key that needs three to sign
+	for failed := 0; failed < len(pIDs)-1; {
+		select {
+		case err := <-errCh:
+			if err.Victim().Index == 0 {
+				continue
+			}
+			assert.Equal(t, []*tss.PartyID{pIDs[0]}, err.Culprits())
+			failed++
+		case msg := <-outCh:
+			from, dest := msg.GetFrom(), msg.GetTo()
+			switch msg.(tss.ParsedMessage).Content().(type) {
+			case *KGRound2Message1:
+				if from.Index == 0 && dest[0].Index == 1 {
+					msg = NewKGRound2Message1(dest[0], from, badShare, nil)
+				}
+			case *KGRevealMessage:
+				if from.Index == 0 {
+					msg = NewKGRevealMessage(from, []int{1}, vss.Shares{nil, badShare}, nil)
+				}
+			}
+			if dest == nil {
+				for _, P := range parties {
+					if P.PartyID().Index != from.Index {
+						go test.SharedPartyUpdater(P, msg, errCh)
+					}
+				}
+			} else {
+				go test.SharedPartyUpdater(parties[dest[0].Index], msg, errCh)
+			}
+		case save := <-endCh:
+			// the cheater's own view of the key differs from everyone else's
+			if pIDs[0].KeyInt().Cmp(save.ShareID) != 0 {
+				assert.FailNow(t, "only the cheater should end")
+			}
+		}
+	}
+}
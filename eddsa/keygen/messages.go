@@ -27,36 +27,62 @@ var (
 		(*KGRound1Message)(nil),
 		(*KGRound2Message1)(nil),
 		(*KGRound2Message2)(nil),
+		(*KGComplaintMessage)(nil),
+		(*KGRevealMessage)(nil),
+		(*KGFeldmanMessage)(nil),
+		(*KGFeldmanComplaintMessage)(nil),
+		(*KGReconstructMessage)(nil),
 	}
 )
 
 // ----- //
 
-func NewKGRound1Message(from *tss.PartyID, ct cmt.HashCommitment) tss.ParsedMessage {
+func NewKGRound1Message(from *tss.PartyID, ct, chainCodeCt cmt.HashCommitment, pedersenCmts vss.Vs) (tss.ParsedMessage, error) {
 	meta := tss.MessageRouting{
 		From:        from,
 		IsBroadcast: true,
 	}
+	var pedersenCmtsBzs [][]byte
+	if pedersenCmts != nil {
+		flat, err := crypto.FlattenECPoints(pedersenCmts)
+		if err != nil {
+			return nil, err
+		}
+		pedersenCmtsBzs = common.BigIntsToBytes(flat)
+	}
 	content := &KGRound1Message{
-		Commitment: ct.Bytes(),
+		Commitment:          ct.Bytes(),
+		ChainCodeCommitment: chainCodeCt.Bytes(),
+		PedersenCommitments: pedersenCmtsBzs,
 	}
 	msg := tss.NewMessageWrapper(meta, content)
-	return tss.NewMessage(meta, content, msg)
+	return tss.NewMessage(meta, content, msg), nil
 }
 
 func (m *KGRound1Message) ValidateBasic() bool {
-	return m != nil && common.NonEmptyBytes(m.GetCommitment())
+	return m != nil &&
+		common.NonEmptyBytes(m.GetCommitment()) &&
+		common.NonEmptyBytes(m.GetChainCodeCommitment())
 }
 
 func (m *KGRound1Message) UnmarshalCommitment() *big.Int {
 	return new(big.Int).SetBytes(m.GetCommitment())
 }
 
+func (m *KGRound1Message) UnmarshalChainCodeCommitment() *big.Int {
+	return new(big.Int).SetBytes(m.GetChainCodeCommitment())
+}
+
+// UnmarshalPedersenCommitments returns the Pedersen VSS commitments sent when the Pedersen DKG is used
+func (m *KGRound1Message) UnmarshalPedersenCommitments(ec elliptic.Curve) (vss.Vs, error) {
+	return crypto.UnFlattenECPoints(ec, common.MultiBytesToBigInts(m.GetPedersenCommitments()))
+}
+
 // ----- //
 
 func NewKGRound2Message1(
 	to, from *tss.PartyID,
-	share *vss.Share,
+	share, blinding *vss.Share,
 ) tss.ParsedMessage {
 	meta := tss.MessageRouting{
 		From:        from,
@@ -66,6 +92,9 @@ func NewKGRound2Message1(
 	content := &KGRound2Message1{
 		Share: share.Share.Bytes(),
 	}
+	if blinding != nil {
+		content.BlindingShare = blinding.Share.Bytes()
+	}
 	msg := tss.NewMessageWrapper(meta, content)
 	return tss.NewMessage(meta, content, msg)
 }
@@ -79,12 +108,17 @@ func (m *KGRound2Message1) UnmarshalShare() *big.Int {
 	return new(big.Int).SetBytes(m.Share)
 }
 
+func (m *KGRound2Message1) UnmarshalBlindingShare() *big.Int {
+	return new(big.Int).SetBytes(m.GetBlindingShare())
+}
+
 // ----- //
 
 func NewKGRound2Message2(
 	from *tss.PartyID,
 	deCommitment cmt.HashDeCommitment,
 	proof *schnorr.ZKProof,
+	chainCodeDeCommitment cmt.HashDeCommitment,
 ) tss.ParsedMessage {
 	meta := tss.MessageRouting{
 		From:        from,
@@ -92,10 +126,12 @@ func NewKGRound2Message2(
 	}
 	dcBzs := common.BigIntsToBytes(deCommitment)
 	content := &KGRound2Message2{
-		DeCommitment: dcBzs,
-		ProofAlphaX:  proof.Alpha.X().Bytes(),
-		ProofAlphaY:  proof.Alpha.Y().Bytes(),
-		ProofT:       proof.T.Bytes(),
+		DeCommitment:          dcBzs,
+		ChainCodeDeCommitment: common.BigIntsToBytes(chainCodeDeCommitment),
+	}
+	// with the Pedersen DKG, the Feldman commitments and the proof are sent later in a KGFeldmanMessage
+	if proof != nil {
+		content.ProofAlphaX, content.ProofAlphaY, content.ProofT = proof.Alpha.X().Bytes(), proof.Alpha.Y().Bytes(), proof.T.Bytes()
 	}
 	msg := tss.NewMessageWrapper(meta, content)
 	return tss.NewMessage(meta, content, msg)
@@ -103,7 +139,8 @@ func NewKGRound2Message2(
 
 func (m *KGRound2Message2) ValidateBasic() bool {
 	return m != nil &&
-		common.NonEmptyMultiBytes(m.GetDeCommitment())
+		(len(m.GetDeCommitment()) == 0 || common.NonEmptyMultiBytes(m.GetDeCommitment())) &&
+		common.NonEmptyMultiBytes(m.GetChainCodeDeCommitment(), 2)
 }
 
 func (m *KGRound2Message2) UnmarshalDeCommitment() []*big.Int {
@@ -111,6 +148,10 @@ func (m *KGRound2Message2) UnmarshalDeCommitment() []*big.Int {
 	return cmt.NewHashDeCommitmentFromBytes(deComBzs)
 }
 
+func (m *KGRound2Message2) UnmarshalChainCodeDeCommitment() []*big.Int {
+	return cmt.NewHashDeCommitmentFromBytes(m.GetChainCodeDeCommitment())
+}
+
 func (m *KGRound2Message2) UnmarshalZKProof(ec elliptic.Curve) (*schnorr.ZKProof, error) {
 	point, err := crypto.NewECPoint(
 		ec,
@@ -124,3 +165,215 @@ func (m *KGRound2Message2) UnmarshalZKProof(ec elliptic.Curve) (*schnorr.ZKProof
 		T:     new(big.Int).SetBytes(m.GetProofT()),
 	}, nil
 }
+
+// ----- //
+
+func NewKGComplaintMessage(
+	from *tss.PartyID,
+	accused []int,
+) tss.ParsedMessage {
+	meta := tss.MessageRouting{
+		From:        from,
+		IsBroadcast: true,
+	}
+	content := &KGComplaintMessage{
+		Accused: make([]uint32, len(accused)),
+	}
+	for i, j := range accused {
+		content.Accused[i] = uint32(j)
+	}
+	msg := tss.NewMessageWrapper(meta, content)
+	return tss.NewMessage(meta, content, msg)
+}
+
+func (m *KGComplaintMessage) ValidateBasic() bool {
+	// a party without complaints sends an empty list
+	return m != nil
+}
+
+// UnmarshalAccused returns the indexes of the dealers accused by the sender
+func (m *KGComplaintMessage) UnmarshalAccused() []int {
+	accused := make([]int, len(m.GetAccused()))
+	for i, j := range m.GetAccused() {
+		accused[i] = int(j)
+	}
+	return accused
+}
+
+// ----- //
+
+// NewKGRevealMessage reveals shares[k] (and blindings[k] when the Pedersen DKG is used) for every complainant k
+func NewKGRevealMessage(
+	from *tss.PartyID,
+	complainants []int,
+	shares, blindings vss.Shares,
+) tss.ParsedMessage {
+	meta := tss.MessageRouting{
+		From:        from,
+		IsBroadcast: true,
+	}
+	content := &KGRevealMessage{
+		Complainants: make([]uint32, len(complainants)),
+		Shares:       make([][]byte, len(complainants)),
+	}
+	if blindings != nil {
+		content.BlindingShares = make([][]byte, len(complainants))
+	}
+	for i, k := range complainants {
+		content.Complainants[i] = uint32(k)
+		content.Shares[i] = shares[k].Share.Bytes()
+		if blindings != nil {
+			content.BlindingShares[i] = blindings[k].Share.Bytes()
+		}
+	}
+	msg := tss.NewMessageWrapper(meta, content)
+	return tss.NewMessage(meta, content, msg)
+}
+
+func (m *KGRevealMessage) ValidateBasic() bool {
+	return m != nil &&
+		len(m.GetShares()) == len(m.GetComplainants()) &&
+		(len(m.GetBlindingShares()) == 0 || len(m.GetBlindingShares()) == len(m.GetComplainants()))
+}
+
+// UnmarshalRevealedShare returns the share that the sender revealed for complainant k and its blinding share, which is
+// nil unless the Pedersen DKG is used. The share is nil if none was revealed for k.
+func (m *KGRevealMessage) UnmarshalRevealedShare(k int) (share, blinding *big.Int) {
+	for i, c := range m.GetComplainants() {
+		if int(c) != k {
+			continue
+		}
+		share = new(big.Int).SetBytes(m.GetShares()[i])
+		if bzs := m.GetBlindingShares(); len(bzs) > 0 {
+			blinding = new(big.Int).SetBytes(bzs[i])
+		}
+		return
+	}
+	return nil, nil
+}
+
+// ----- //
+
+// NewKGFeldmanMessage opens the Feldman commitments of the sender and proves knowledge of its secret once the dealers
+// qualified by the Pedersen DKG are known
+func NewKGFeldmanMessage(
+	from *tss.PartyID,
+	deCommitment cmt.HashDeCommitment,
+	proof *schnorr.ZKProof,
+) tss.ParsedMessage {
+	meta := tss.MessageRouting{
+		From:        from,
+		IsBroadcast: true,
+	}
+	content := &KGFeldmanMessage{
+		DeCommitment: common.BigIntsToBytes(deCommitment),
+		ProofAlphaX:  proof.Alpha.X().Bytes(),
+		ProofAlphaY:  proof.Alpha.Y().Bytes(),
+		ProofT:       proof.T.Bytes(),
+	}
+	msg := tss.NewMessageWrapper(meta, content)
+	return tss.NewMessage(meta, content, msg)
+}
+
+func (m *KGFeldmanMessage) ValidateBasic() bool {
+	// a dealer that does not open its Feldman commitments has its polynomial rebuilt from its shares instead
+	return m != nil
+}
+
+func (m *KGFeldmanMessage) UnmarshalDeCommitment() []*big.Int {
+	return cmt.NewHashDeCommitmentFromBytes(m.GetDeCommitment())
+}
+
+func (m *KGFeldmanMessage) UnmarshalZKProof(ec elliptic.Curve) (*schnorr.ZKProof, error) {
+	point, err := crypto.NewECPoint(
+		ec,
+		new(big.Int).SetBytes(m.GetProofAlphaX()),
+		new(big.Int).SetBytes(m.GetProofAlphaY()))
+	if err != nil {
+		return nil, err
+	}
+	return &schnorr.ZKProof{
+		Alpha: point,
+		T:     new(big.Int).SetBytes(m.GetProofT()),
+	}, nil
+}
+
+// ----- //
+
+// NewKGFeldmanComplaintMessage reveals shares[k] and blindings[k], dealt to the sender by dealers[k], for every k
+func NewKGFeldmanComplaintMessage(
+	from *tss.PartyID,
+	dealers []int,
+	shares, blindings []*big.Int,
+) tss.ParsedMessage {
+	meta := tss.MessageRouting{
+		From:        from,
+		IsBroadcast: true,
+	}
+	content := &KGFeldmanComplaintMessage{}
+	content.Dealers, content.Shares, content.BlindingShares = marshalDealtShares(dealers, shares, blindings)
+	msg := tss.NewMessageWrapper(meta, content)
+	return tss.NewMessage(meta, content, msg)
+}
+
+func (m *KGFeldmanComplaintMessage) ValidateBasic() bool {
+	return m != nil &&
+		len(m.GetShares()) == len(m.GetDealers()) &&
+		len(m.GetBlindingShares()) == len(m.GetDealers())
+}
+
+// UnmarshalDealtShare returns the share that dealer j dealt to the sender and its blinding share, which are nil if the
+// sender did not reveal them
+func (m *KGFeldmanComplaintMessage) UnmarshalDealtShare(j int) (share, blinding *big.Int) {
+	return unmarshalDealtShare(m.GetDealers(), m.GetShares(), m.GetBlindingShares(), j)
+}
+
+// ----- //
+
+// NewKGReconstructMessage reveals shares[k] and blindings[k], dealt to the sender by dealers[k], for every k
+func NewKGReconstructMessage(
+	from *tss.PartyID,
+	dealers []int,
+	shares, blindings []*big.Int,
+) tss.ParsedMessage {
+	meta := tss.MessageRouting{
+		From:        from,
+		IsBroadcast: true,
+	}
+	content := &KGReconstructMessage{}
+	content.Dealers, content.Shares, content.BlindingShares = marshalDealtShares(dealers, shares, blindings)
+	msg := tss.NewMessageWrapper(meta, content)
+	return tss.NewMessage(meta, content, msg)
+}
+
+func (m *KGReconstructMessage) ValidateBasic() bool {
+	return m != nil &&
+		len(m.GetShares()) == len(m.GetDealers()) &&
+		len(m.GetBlindingShares()) == len(m.GetDealers())
+}
+
+// UnmarshalDealtShare returns the share that dealer j dealt to the sender and its blinding share, which are nil if the
+// sender did not reveal them
+func (m *KGReconstructMessage) UnmarshalDealtShare(j int) (share, blinding *big.Int) {
+	return unmarshalDealtShare(m.GetDealers(), m.GetShares(), m.GetBlindingShares(), j)
+}
+
+// ----- //
+
+func marshalDealtShares(dealers []int, shares, blindings []*big.Int) ([]uint32, [][]byte, [][]byte) {
+	js := make([]uint32, len(dealers))
+	for k, j := range dealers {
+		js[k] = uint32(j)
+	}
+	return js, common.BigIntsToBytes(shares), common.BigIntsToBytes(blindings)
+}
+
+func unmarshalDealtShare(dealers []uint32, shares, blindings [][]byte, j int) (share, blinding *big.Int) {
+	for k, dealer := range dealers {
+		if int(dealer) != j {
+			continue
+		}
+		return new(big.Int).SetBytes(shares[k]), new(big.Int).SetBytes(blindings[k])
+	}
+	return nil, nil
+}
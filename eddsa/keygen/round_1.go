@@ -39,12 +39,19 @@ func (round *round1) Start() *tss.Error {
 	i := Pi.Index
 
 	// 1. calculate "partial" key share ui
-	ui := common.GetRandomPositiveInt(round.Params().EC().Params().N)
+	ui := common.GetRandomPositiveIntWithRandom(round.Rand(), round.Params().EC().Params().N)
 	round.temp.ui = ui
 
-	// 2. compute the vss shares
+	// 2. compute the vss shares; the Pedersen DKG also commits to them with hiding Pedersen commitments
 	ids := round.Parties().IDs().Keys()
-	vs, shares, err := vss.Create(round.Params().EC(), round.Threshold(), ui, ids)
+	var vs, pedersenCmts vss.Vs
+	var shares, blindingShares vss.Shares
+	var err error
+	if round.PedersenDKG() {
+		vs, pedersenCmts, shares, blindingShares, err = vss.CreatePedersen(round.Params().EC(), round.Threshold(), ui, ids, round.Rand())
+	} else {
+		vs, shares, err = vss.CreateWithRandom(round.Params().EC(), round.Threshold(), ui, ids, round.Rand())
+	}
 	if err != nil {
 		return round.WrapError(err, Pi)
 	}
@@ -59,7 +66,11 @@ func (round *round1) Start() *tss.Error {
 	if err != nil {
 		return round.WrapError(err, Pi)
 	}
-	cmt := cmts.NewHashCommitment(pGFlat...)
+	cmt := cmts.NewHashCommitmentWithRandom(round.Rand(), pGFlat...)
+
+	// commit to our contribution to the joint chain code; it is revealed in round 2 once all commitments are in
+	ci := common.MustGetRandomIntWithRandom(round.Rand(), ChainCodeLen*8)
+	chainCodeCmt := cmts.NewHashCommitmentWithRandom(round.Rand(), ci)
 
 	// for this P: SAVE
 	// - shareID
@@ -69,12 +80,18 @@ func (round *round1) Start() *tss.Error {
 	round.save.ShareID = ids[i]
 	round.temp.vs = vs
 	round.temp.shares = shares
+	round.temp.blindingShares = blindingShares
+	round.temp.pedersenCmts[i] = pedersenCmts
 
 	round.temp.deCommitPolyG = cmt.D
+	round.temp.deCommitChainCode = chainCodeCmt.D
 
 	// BROADCAST commitments
 	{
-		msg := NewKGRound1Message(round.PartyID(), cmt.C)
+		msg, err := NewKGRound1Message(round.PartyID(), cmt.C, chainCodeCmt.C, pedersenCmts)
+		if err != nil {
+			return round.WrapError(err, Pi)
+		}
 		round.temp.kgRound1Messages[i] = msg
 		round.out <- msg
 	}
@@ -11,7 +11,9 @@ import (
 
 	errors2 "github.com/pkg/errors"
 
+	cmt "github.com/bnb-chain/tss-lib/crypto/commitments"
 	"github.com/bnb-chain/tss-lib/crypto/schnorr"
+	"github.com/bnb-chain/tss-lib/crypto/vss"
 	"github.com/bnb-chain/tss-lib/tss"
 )
 
@@ -29,29 +31,47 @@ func (round *round2) Start() *tss.Error {
 	for j, msg := range round.temp.kgRound1Messages {
 		r1msg := msg.Content().(*KGRound1Message)
 		round.temp.KGCs[j] = r1msg.UnmarshalCommitment()
+		round.temp.chainCodeCmts[j] = r1msg.UnmarshalChainCodeCommitment()
+		if j == i {
+			continue
+		}
+		if round.PedersenDKG() {
+			pedersenCmts, err := r1msg.UnmarshalPedersenCommitments(round.Params().EC())
+			if err != nil || len(pedersenCmts) != round.Threshold()+1 {
+				return round.WrapError(errors.New("got invalid Pedersen VSS commitments from this party"), msg.GetFrom())
+			}
+			round.temp.pedersenCmts[j] = pedersenCmts
+		}
 	}
 
 	// 3. p2p send share ij to Pj
-	shares := round.temp.shares
+	shares, blindingShares := round.temp.shares, round.temp.blindingShares
 	for j, Pj := range round.Parties().IDs() {
-		r2msg1 := NewKGRound2Message1(Pj, round.PartyID(), shares[j])
+		var blinding *vss.Share
+		if blindingShares != nil {
+			blinding = blindingShares[j]
+		}
+		r2msg1 := NewKGRound2Message1(Pj, round.PartyID(), shares[j], blinding)
 		// do not send to this Pj, but store for round 3
 		if j == i {
 			round.temp.kgRound2Message1s[j] = r2msg1
 			continue
 		}
-		round.temp.kgRound2Message1s[i] = r2msg1
 		round.out <- r2msg1
 	}
 
-	// 5. compute Schnorr prove
-	pii, err := schnorr.NewZKProof(round.temp.ui, round.temp.vs[0])
-	if err != nil {
-		return round.WrapError(errors2.Wrapf(err, "NewZKProof(ui, vi0)"))
+	// 5. compute Schnorr prove and BROADCAST it with the de-commitments of Shamir poly*G; with the Pedersen DKG both are
+	// only revealed once the qualified dealers are known, in the Feldman rounds
+	var deCommitPolyG cmt.HashDeCommitment
+	var pii *schnorr.ZKProof
+	if !round.PedersenDKG() {
+		var err error
+		if pii, err = round.proveUi(); err != nil {
+			return round.WrapError(err)
+		}
+		deCommitPolyG = round.temp.deCommitPolyG
 	}
-
-	// 5. BROADCAST de-commitments of Shamir poly*G and Schnorr prove
-	r2msg2 := NewKGRound2Message2(round.PartyID(), round.temp.deCommitPolyG, pii)
+	r2msg2 := NewKGRound2Message2(round.PartyID(), deCommitPolyG, pii, round.temp.deCommitChainCode)
 	round.temp.kgRound2Message2s[i] = r2msg2
 	round.out <- r2msg2
 
@@ -88,5 +108,20 @@ func (round *round2) Update() (bool, *tss.Error) {
 
 func (round *round2) NextRound() tss.Round {
 	round.started = false
+	if round.RobustKeygen() {
+		return &roundComplaints{round}
+	}
+	if round.PedersenDKG() {
+		return &roundFeldman{round}
+	}
 	return &round3{round}
 }
+
+// proveUi proves knowledge of our secret ui, the discrete log of our first Feldman commitment
+func (round *base) proveUi() (*schnorr.ZKProof, error) {
+	pii, err := schnorr.NewZKProof(round.transcript(round.PartyID()), round.temp.ui, round.temp.vs[0], round.Rand())
+	if err != nil {
+		return nil, errors2.Wrapf(err, "NewZKProof(ui, vi0)")
+	}
+	return pii, nil
+}
@@ -0,0 +1,255 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package keygen
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/bnb-chain/tss-lib/common"
+	"github.com/bnb-chain/tss-lib/crypto/vss"
+	"github.com/bnb-chain/tss-lib/tss"
+)
+
+// With the Pedersen DKG of Gennaro, Jarecki, Krawczyk and Rabin, the qualified dealers are settled by checking the
+// shares against the hiding Pedersen commitments only, before any Feldman commitments are opened. A qualified dealer is
+// never left out of the key afterwards: if it does not open valid Feldman commitments along with a valid proof of
+// knowledge of its secret, or if a share that opens its Pedersen commitments does not match its Feldman ones, every
+// party reveals the share it was dealt by it and its polynomial is rebuilt in public. This is what keeps a dealer from
+// biasing the public key.
+
+func (round *roundFeldman) Start() *tss.Error {
+	if round.started {
+		return round.WrapError(errors.New("round already started"))
+	}
+	round.number = round.feldmanRound()
+	round.started = true
+	round.resetOK()
+
+	i := round.PartyID().Index
+
+	// settle the qualified dealers from the checks against their Pedersen commitments
+	if err := round.qualifyDealers(); err != nil {
+		return err
+	}
+	round.temp.dealerVs[i] = round.temp.vs // ours
+
+	// BROADCAST the opening of our Feldman commitments and the Schnorr prove
+	pii, err := round.proveUi()
+	if err != nil {
+		return round.WrapError(err)
+	}
+	msg := NewKGFeldmanMessage(round.PartyID(), round.temp.deCommitPolyG, pii)
+	round.temp.kgFeldmanMessages[i] = msg
+	round.out <- msg
+	return nil
+}
+
+func (round *roundFeldman) CanAccept(msg tss.ParsedMessage) bool {
+	if _, ok := msg.Content().(*KGFeldmanMessage); ok {
+		return msg.IsBroadcast()
+	}
+	return false
+}
+
+func (round *roundFeldman) Update() (bool, *tss.Error) {
+	return round.updateQualified(round.temp.kgFeldmanMessages, round.CanAccept)
+}
+
+func (round *roundFeldman) NextRound() tss.Round {
+	round.started = false
+	return &roundFeldmanComplaints{round}
+}
+
+// ----- //
+
+func (round *roundFeldmanComplaints) Start() *tss.Error {
+	if round.started {
+		return round.WrapError(errors.New("round already started"))
+	}
+	round.number = round.feldmanRound() + 1
+	round.started = true
+	round.resetOK()
+
+	Ps := round.Parties().IDs()
+	i := round.PartyID().Index
+
+	// check the Feldman commitments of every qualified dealer and its share to us against them
+	dealers := make([]int, 0, len(Ps))
+	shares, blindings := make([]*big.Int, 0, len(Ps)), make([]*big.Int, 0, len(Ps))
+	for j, Pj := range Ps {
+		if j == i || round.temp.disqualified[j] {
+			continue
+		}
+		PjVs, err := round.deCommitVs(j)
+		if err == nil && len(PjVs) != round.Threshold()+1 {
+			err = errors.New("got the wrong number of Feldman commitments")
+		}
+		if err != nil {
+			// every party sees the same broadcast, so every party will rebuild Pj's polynomial
+			common.Logger.Warningf("the polynomial of party %s will be rebuilt: %v", Pj, err)
+			round.temp.exposed[j] = true
+		} else {
+			round.temp.dealerVs[j] = PjVs
+			share := vss.Share{Threshold: round.Threshold(), ID: round.PartyID().KeyInt(), Share: round.dealtShare(j)}
+			if share.Verify(round.Params().EC(), round.Threshold(), PjVs) {
+				continue
+			}
+			common.Logger.Warningf("complaining about the Feldman commitments of party %s", Pj)
+		}
+		dealers = append(dealers, j)
+		shares, blindings = append(shares, round.dealtShare(j)), append(blindings, round.dealtBlinding(j))
+	}
+
+	// BROADCAST the complaints, even if there are none
+	msg := NewKGFeldmanComplaintMessage(round.PartyID(), dealers, shares, blindings)
+	round.temp.kgFeldmanComplaintMessages[i] = msg
+	round.out <- msg
+	return nil
+}
+
+func (round *roundFeldmanComplaints) CanAccept(msg tss.ParsedMessage) bool {
+	if _, ok := msg.Content().(*KGFeldmanComplaintMessage); ok {
+		return msg.IsBroadcast()
+	}
+	return false
+}
+
+func (round *roundFeldmanComplaints) Update() (bool, *tss.Error) {
+	return round.updateQualified(round.temp.kgFeldmanComplaintMessages, round.CanAccept)
+}
+
+func (round *roundFeldmanComplaints) NextRound() tss.Round {
+	round.started = false
+	return &roundReconstruct{round}
+}
+
+// ----- //
+
+func (round *roundReconstruct) Start() *tss.Error {
+	if round.started {
+		return round.WrapError(errors.New("round already started"))
+	}
+	round.number = round.feldmanRound() + 2
+	round.started = true
+	round.resetOK()
+
+	Ps := round.Parties().IDs()
+	i := round.PartyID().Index
+
+	// a complaint exposes the dealer if it reveals a share that opens the dealer's Pedersen commitments but does not
+	// match its Feldman ones; a complaint with a share that opens neither is ignored
+	for k, msg := range round.temp.kgFeldmanComplaintMessages {
+		if round.temp.disqualified[k] {
+			continue
+		}
+		complaint := msg.Content().(*KGFeldmanComplaintMessage)
+		for _, j := range complaint.GetDealers() {
+			j := int(j)
+			if j < 0 || len(Ps) <= j || j == k || round.temp.disqualified[j] || round.temp.exposed[j] {
+				continue
+			}
+			share, blinding := complaint.UnmarshalDealtShare(j)
+			if round.verifyShare(j, Ps[k].KeyInt(), share, blinding, nil) != nil {
+				common.Logger.Warningf("party %s complained about party %s with a share that it was not dealt", Ps[k], Ps[j])
+				continue
+			}
+			PjShare := vss.Share{Threshold: round.Threshold(), ID: Ps[k].KeyInt(), Share: share}
+			if !PjShare.Verify(round.Params().EC(), round.Threshold(), round.temp.dealerVs[j]) {
+				common.Logger.Warningf("the polynomial of party %s will be rebuilt: its share for party %s does not match its Feldman commitments", Ps[j], Ps[k])
+				round.temp.exposed[j] = true
+			}
+		}
+	}
+
+	// BROADCAST the shares dealt to us by the exposed dealers, even if there are none
+	dealers := make([]int, 0, len(Ps))
+	shares, blindings := make([]*big.Int, 0, len(Ps)), make([]*big.Int, 0, len(Ps))
+	for j := range Ps {
+		if j == i || !round.temp.exposed[j] {
+			continue
+		}
+		dealers = append(dealers, j)
+		shares, blindings = append(shares, round.dealtShare(j)), append(blindings, round.dealtBlinding(j))
+	}
+	msg := NewKGReconstructMessage(round.PartyID(), dealers, shares, blindings)
+	round.temp.kgReconstructMessages[i] = msg
+	round.out <- msg
+	return nil
+}
+
+func (round *roundReconstruct) CanAccept(msg tss.ParsedMessage) bool {
+	if _, ok := msg.Content().(*KGReconstructMessage); ok {
+		return msg.IsBroadcast()
+	}
+	return false
+}
+
+func (round *roundReconstruct) Update() (bool, *tss.Error) {
+	return round.updateQualified(round.temp.kgReconstructMessages, round.CanAccept)
+}
+
+func (round *roundReconstruct) NextRound() tss.Round {
+	round.started = false
+	return &round3{round.round2}
+}
+
+// ----- //
+
+// updateQualified marks the parties whose message of the current round is in, without waiting for the disqualified ones
+func (round *base) updateQualified(msgs []tss.ParsedMessage, canAccept func(tss.ParsedMessage) bool) (bool, *tss.Error) {
+	for j, msg := range msgs {
+		if round.ok[j] {
+			continue
+		}
+		if round.temp.disqualified[j] {
+			round.ok[j] = true
+			continue
+		}
+		if msg == nil || !canAccept(msg) {
+			return false, nil
+		}
+		round.ok[j] = true
+	}
+	return true, nil
+}
+
+// rebuildExposedVs rebuilds the polynomial of every exposed dealer from the shares revealed by the qualified parties
+// that open the dealer's Pedersen commitments, and takes its Feldman commitments in place of the ones it published
+func (round *base) rebuildExposedVs() *tss.Error {
+	Ps := round.Parties().IDs()
+	i := round.PartyID().Index
+
+	for j, Pj := range Ps {
+		if !round.temp.exposed[j] {
+			continue
+		}
+		shares := make(vss.Shares, 0, len(Ps))
+		for k, Pk := range Ps {
+			if k == j || round.temp.disqualified[k] {
+				continue
+			}
+			var share, blinding *big.Int
+			if k == i {
+				share, blinding = round.dealtShare(j), round.dealtBlinding(j)
+			} else if share, blinding = round.temp.kgReconstructMessages[k].Content().(*KGReconstructMessage).UnmarshalDealtShare(j); share == nil {
+				// a party that complained about the dealer revealed its share in the complaint
+				share, blinding = round.temp.kgFeldmanComplaintMessages[k].Content().(*KGFeldmanComplaintMessage).UnmarshalDealtShare(j)
+			}
+			if share == nil || round.verifyShare(j, Pk.KeyInt(), share, blinding, nil) != nil {
+				continue
+			}
+			shares = append(shares, &vss.Share{Threshold: round.Threshold(), ID: Pk.KeyInt(), Share: share})
+		}
+		PjVs, err := shares.ReConstructVs(round.Params().EC())
+		if err != nil {
+			return round.WrapError(errors.New("too few valid shares were revealed to rebuild the polynomial of this party"), Pj)
+		}
+		round.temp.dealerVs[j] = PjVs
+	}
+	return nil
+}
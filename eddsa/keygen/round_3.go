@@ -7,8 +7,10 @@
 package keygen
 
 import (
+	"crypto/elliptic"
 	"errors"
 	"math/big"
+	"sync"
 
 	"github.com/hashicorp/go-multierror"
 	errors2 "github.com/pkg/errors"
@@ -16,6 +18,8 @@ import (
 	"github.com/bnb-chain/tss-lib/common"
 	"github.com/bnb-chain/tss-lib/crypto"
 	"github.com/bnb-chain/tss-lib/crypto/commitments"
+	"github.com/bnb-chain/tss-lib/crypto/group"
+	"github.com/bnb-chain/tss-lib/crypto/schnorr"
 	"github.com/bnb-chain/tss-lib/crypto/vss"
 	"github.com/bnb-chain/tss-lib/tss"
 )
@@ -24,128 +28,50 @@ func (round *round3) Start() *tss.Error {
 	if round.started {
 		return round.WrapError(errors.New("round already started"))
 	}
-	round.number = 3
+	round.number = 3 + round.complaintRounds()
 	round.started = true
 	round.resetOK()
 
 	Ps := round.Parties().IDs()
 	PIdx := round.PartyID().Index
+	g := group.Ed25519()
 
-	// 1,10. calculate xi
-	xi := new(big.Int).Set(round.temp.shares[PIdx].Share)
-	for j := range Ps {
-		if j == PIdx {
-			continue
-		}
-		r2msg1 := round.temp.kgRound2Message1s[j].Content().(*KGRound2Message1)
-		share := r2msg1.UnmarshalShare()
-		xi = new(big.Int).Add(xi, share)
-	}
-	round.save.Xi = new(big.Int).Mod(xi, round.Params().EC().Params().N)
-
-	// 2-3.
-	Vc := make(vss.Vs, round.Threshold()+1)
-	for c := range Vc {
-		Vc[c] = round.temp.vs[c] // ours
-	}
+	round.temp.dealerVs[PIdx] = round.temp.vs // ours
 
-	// 4-12.
-	type vssOut struct {
-		unWrappedErr error
-		pjVs         vss.Vs
-	}
-	chs := make([]chan vssOut, len(Ps))
-	for i := range chs {
-		if i == PIdx {
-			continue
+	// 4-9. verify every other dealer's commitments and its share to us. with the Pedersen DKG the qualified dealers were
+	// settled before the Feldman rounds, which leave none of them out
+	if round.PedersenDKG() {
+		if err := round.rebuildExposedVs(); err != nil {
+			return err
 		}
-		chs[i] = make(chan vssOut)
+	} else if err := round.qualifyDealers(); err != nil {
+		return err
 	}
+
+	// 1,10. calculate xi from the shares of the qualified dealers
+	xi := g.ScalarFromBigInt(big.NewInt(0))
 	for j := range Ps {
-		if j == PIdx {
+		if round.temp.disqualified[j] {
 			continue
 		}
-		// 6-9.
-		go func(j int, ch chan<- vssOut) {
-			// 4-10.
-			KGCj := round.temp.KGCs[j]
-			r2msg2 := round.temp.kgRound2Message2s[j].Content().(*KGRound2Message2)
-			KGDj := r2msg2.UnmarshalDeCommitment()
-			cmtDeCmt := commitments.HashCommitDecommit{C: KGCj, D: KGDj}
-			ok, flatPolyGs := cmtDeCmt.DeCommit()
-			if !ok || flatPolyGs == nil {
-				ch <- vssOut{errors.New("de-commitment verify failed"), nil}
-				return
-			}
-
-			PjVs, err := crypto.UnFlattenECPoints(round.Params().EC(), flatPolyGs)
-			for i, PjV := range PjVs {
-				PjVs[i] = PjV.EightInvEight()
-			}
-
-			if err != nil {
-				ch <- vssOut{err, nil}
-				return
-			}
-			proof, err := r2msg2.UnmarshalZKProof(round.Params().EC())
-			if err != nil {
-				ch <- vssOut{errors.New("failed to unmarshal schnorr proof"), nil}
-				return
-			}
-			ok = proof.Verify(PjVs[0])
-			if !ok {
-				ch <- vssOut{errors.New("failed to prove schnorr proof"), nil}
-				return
-			}
-			r2msg1 := round.temp.kgRound2Message1s[j].Content().(*KGRound2Message1)
-			PjShare := vss.Share{
-				Threshold: round.Threshold(),
-				ID:        round.PartyID().KeyInt(),
-				Share:     r2msg1.UnmarshalShare(),
-			}
-			if ok = PjShare.Verify(round.Params().EC(), round.Threshold(), PjVs); !ok {
-				ch <- vssOut{errors.New("vss verify failed"), nil}
-				return
-			}
-			// (9) handled above
-			ch <- vssOut{nil, PjVs}
-		}(j, chs[j])
+		xi = xi.Add(g.ScalarFromBigInt(round.dealtShare(j)))
 	}
+	round.save.Xi = xi.BigInt()
 
-	// consume unbuffered channels (end the goroutines)
-	vssResults := make([]vssOut, len(Ps))
+	// 2-3,11-12. sum up the Vs of the qualified dealers
+	var Vc vss.Vs
 	{
+		var err error
 		culprits := make([]*tss.PartyID, 0, len(Ps)) // who caused the error(s)
 		for j, Pj := range Ps {
-			if j == PIdx {
+			if round.temp.disqualified[j] {
 				continue
 			}
-			vssResults[j] = <-chs[j]
-			// collect culprits to error out with
-			if err := vssResults[j].unWrappedErr; err != nil {
-				culprits = append(culprits, Pj)
-			}
-		}
-		var multiErr error
-		if len(culprits) > 0 {
-			for _, vssResult := range vssResults {
-				if vssResult.unWrappedErr == nil {
-					continue
-				}
-				multiErr = multierror.Append(multiErr, vssResult.unWrappedErr)
-			}
-			return round.WrapError(multiErr, culprits...)
-		}
-	}
-	{
-		var err error
-		culprits := make([]*tss.PartyID, 0, len(Ps)) // who caused the error(s)
-		for j, Pj := range Ps {
-			if j == PIdx {
+			PjVs := round.temp.dealerVs[j]
+			if Vc == nil {
+				Vc = append(make(vss.Vs, 0, round.Threshold()+1), PjVs[:round.Threshold()+1]...)
 				continue
 			}
-			// 11-12.
-			PjVs := vssResults[j].pjVs
 			for c := 0; c <= round.Threshold(); c++ {
 				Vc[c], err = Vc[c].Add(PjVs[c])
 				if err != nil {
@@ -161,17 +87,16 @@ func (round *round3) Start() *tss.Error {
 	// 13-17. compute Xj for each Pj
 	{
 		var err error
-		modQ := common.ModInt(round.Params().EC().Params().N)
 		culprits := make([]*tss.PartyID, 0, len(Ps)) // who caused the error(s)
 		bigXj := round.save.BigXj
 		for j := 0; j < round.PartyCount(); j++ {
 			Pj := round.Parties().IDs()[j]
-			kj := Pj.KeyInt()
+			kj := g.ScalarFromBigInt(Pj.KeyInt())
 			BigXj := Vc[0]
-			z := new(big.Int).SetInt64(int64(1))
+			z := g.ScalarFromBigInt(big.NewInt(1))
 			for c := 1; c <= round.Threshold(); c++ {
-				z = modQ.Mul(z, kj)
-				BigXj, err = BigXj.Add(Vc[c].ScalarMult(z))
+				z = z.Mul(kj)
+				BigXj, err = BigXj.Add(Vc[c].ScalarMult(z.BigInt()))
 				if err != nil {
 					culprits = append(culprits, Pj)
 				}
@@ -184,6 +109,31 @@ func (round *round3) Start() *tss.Error {
 		round.save.BigXj = bigXj
 	}
 
+	// de-commit every party's chain code contribution and SAVE the joint chain code
+	{
+		contributions := make([]*big.Int, 0, len(Ps))
+		culprits := make([]*tss.PartyID, 0, len(Ps)) // who caused the error(s)
+		for j, Pj := range Ps {
+			if round.temp.disqualified[j] {
+				continue
+			}
+			if j == PIdx {
+				contributions = append(contributions, round.temp.deCommitChainCode[1])
+				continue
+			}
+			contribution, ok := round.deCommitChainCode(j)
+			if !ok {
+				culprits = append(culprits, Pj)
+				continue
+			}
+			contributions = append(contributions, contribution)
+		}
+		if len(culprits) > 0 {
+			return round.WrapError(errors.New("chain code de-commitment verify failed"), culprits...)
+		}
+		round.save.ChainCode = combineChainCodeContributions(contributions)
+	}
+
 	// 18. compute and SAVE the EDDSA public key `y`
 	eddsaPubKey, err := crypto.NewECPoint(round.Params().EC(), Vc[0].X(), Vc[0].Y())
 	if err != nil {
@@ -194,6 +144,7 @@ func (round *round3) Start() *tss.Error {
 	// PRINT public key & private share
 	common.Logger.Debugf("%s public key: %x", round.PartyID(), eddsaPubKey)
 
+	round.removeDisqualified()
 	round.end <- *round.save
 	return nil
 }
@@ -211,3 +162,175 @@ func (round *round3) Update() (bool, *tss.Error) {
 func (round *round3) NextRound() tss.Round {
 	return nil // finished!
 }
+
+// qualifyDealers verifies every other dealer's commitments and its share to us, aborting with the dealers that failed.
+// in robust mode this was done in the complaint rounds, whose outcome is settled here instead of aborting
+func (round *base) qualifyDealers() *tss.Error {
+	if round.RobustKeygen() {
+		return round.resolveComplaints()
+	}
+	Ps := round.Parties().IDs()
+	dealings := round.verifyDealings()
+	culprits := make([]*tss.PartyID, 0, len(Ps)) // who caused the error(s)
+	var multiErr error
+	for j, Pj := range Ps {
+		if j == round.PartyID().Index {
+			continue
+		}
+		if err := dealings[j].err(); err != nil {
+			culprits = append(culprits, Pj)
+			multiErr = multierror.Append(multiErr, err)
+		}
+		round.temp.dealerVs[j] = dealings[j].vs
+	}
+	if len(culprits) > 0 {
+		return round.WrapError(multiErr, culprits...)
+	}
+	return nil
+}
+
+// dealing is the outcome of the checks of a dealer's commitments and of its share to us
+type dealing struct {
+	vs       vss.Vs
+	cmtErr   error // the commitments or the proof revealed in round 2 are invalid, which every party can see
+	shareErr error // the share to us does not match the commitments
+}
+
+func (d dealing) err() error {
+	if d.cmtErr != nil {
+		return d.cmtErr
+	}
+	return d.shareErr
+}
+
+// verifyDealings checks the dealings of all the other parties concurrently
+func (round *base) verifyDealings() []dealing {
+	Ps := round.Parties().IDs()
+	PIdx := round.PartyID().Index
+	dealings := make([]dealing, len(Ps))
+	wg := new(sync.WaitGroup)
+	for j := range Ps {
+		if j == PIdx {
+			continue
+		}
+		wg.Add(1)
+		go func(j int) {
+			defer wg.Done()
+			// 4-7. with the Pedersen DKG, the Feldman commitments are not opened yet
+			var PjVs vss.Vs
+			if !round.PedersenDKG() {
+				var err error
+				if PjVs, err = round.deCommitVs(j); err != nil {
+					dealings[j] = dealing{cmtErr: err}
+					return
+				}
+			}
+			// 8-9.
+			r2msg1 := round.temp.kgRound2Message1s[j].Content().(*KGRound2Message1)
+			dealings[j] = dealing{
+				vs:       PjVs,
+				shareErr: round.verifyShare(j, round.PartyID().KeyInt(), r2msg1.UnmarshalShare(), r2msg1.UnmarshalBlindingShare(), PjVs),
+			}
+		}(j)
+	}
+	wg.Wait()
+	return dealings
+}
+
+// deCommitVs opens Pj's commitment from round 1 to the Vs it revealed in round 2, or in the first Feldman round with
+// the Pedersen DKG, and checks its proof of knowledge of the secret
+func (round *base) deCommitVs(j int) (vss.Vs, error) {
+	var deCommitment commitments.HashDeCommitment
+	var unmarshalZKProof func(elliptic.Curve) (*schnorr.ZKProof, error)
+	if round.PedersenDKG() {
+		msg := round.temp.kgFeldmanMessages[j].Content().(*KGFeldmanMessage)
+		deCommitment, unmarshalZKProof = msg.UnmarshalDeCommitment(), msg.UnmarshalZKProof
+	} else {
+		msg := round.temp.kgRound2Message2s[j].Content().(*KGRound2Message2)
+		deCommitment, unmarshalZKProof = msg.UnmarshalDeCommitment(), msg.UnmarshalZKProof
+	}
+	cmtDeCmt := commitments.HashCommitDecommit{C: round.temp.KGCs[j], D: deCommitment}
+	ok, flatPolyGs := cmtDeCmt.DeCommit()
+	if !ok || flatPolyGs == nil {
+		return nil, errors.New("de-commitment verify failed")
+	}
+	PjVs, err := crypto.UnFlattenECPoints(round.Params().EC(), flatPolyGs)
+	if err != nil {
+		return nil, err
+	}
+	for i, PjV := range PjVs {
+		PjVs[i] = PjV.ClearTorsion()
+	}
+	proof, err := unmarshalZKProof(round.Params().EC())
+	if err != nil {
+		return nil, errors.New("failed to unmarshal schnorr proof")
+	}
+	if !proof.Verify(round.transcript(round.Parties().IDs()[j]), PjVs[0]) {
+		return nil, errors.New("failed to prove schnorr proof")
+	}
+	return PjVs, nil
+}
+
+// verifyShare checks the share dealt by Pj to the party with key `id` against Pj's Vs. with the Pedersen DKG it is
+// checked against Pj's Pedersen commitments from round 1 instead, as they alone decide whether Pj is qualified
+func (round *base) verifyShare(j int, id, share, blinding *big.Int, PjVs vss.Vs) error {
+	PjShare := vss.Share{
+		Threshold: round.Threshold(),
+		ID:        id,
+		Share:     share,
+	}
+	if round.PedersenDKG() {
+		if !PjShare.VerifyPedersen(round.Params().EC(), round.Threshold(), blinding, round.temp.pedersenCmts[j]) {
+			return errors.New("pedersen vss verify failed")
+		}
+		return nil
+	}
+	if !PjShare.Verify(round.Params().EC(), round.Threshold(), PjVs) {
+		return errors.New("vss verify failed")
+	}
+	return nil
+}
+
+// dealtShare returns the share dealt to us by Pj, or the one Pj revealed in its place after our complaint
+func (round *base) dealtShare(j int) *big.Int {
+	if j == round.PartyID().Index {
+		return round.temp.shares[j].Share
+	}
+	if share := round.temp.revealedShares[j]; share != nil {
+		return share
+	}
+	return round.temp.kgRound2Message1s[j].Content().(*KGRound2Message1).UnmarshalShare()
+}
+
+// dealtBlinding returns the blinding share of the Pedersen DKG that goes with dealtShare(j)
+func (round *base) dealtBlinding(j int) *big.Int {
+	i := round.PartyID().Index
+	if j == i {
+		return round.temp.blindingShares[j].Share
+	}
+	if round.temp.revealedShares[j] != nil {
+		_, blinding := round.temp.kgRevealMessages[j].Content().(*KGRevealMessage).UnmarshalRevealedShare(i)
+		return blinding
+	}
+	return round.temp.kgRound2Message1s[j].Content().(*KGRound2Message1).UnmarshalBlindingShare()
+}
+
+// deCommitChainCode opens Pj's chain code contribution revealed in round 2
+func (round *base) deCommitChainCode(j int) (*big.Int, bool) {
+	r2msg2 := round.temp.kgRound2Message2s[j].Content().(*KGRound2Message2)
+	cmtDeCmt := commitments.HashCommitDecommit{C: round.temp.chainCodeCmts[j], D: r2msg2.UnmarshalChainCodeDeCommitment()}
+	ok, secrets := cmtDeCmt.DeCommit()
+	if !ok || len(secrets) != 1 || ChainCodeLen*8 < secrets[0].BitLen() {
+		return nil, false
+	}
+	return secrets[0], true
+}
+
+// combineChainCodeContributions hashes the revealed contributions of all parties, in party order, into the chain code
+func combineChainCodeContributions(contributions []*big.Int) []byte {
+	bzs := make([][]byte, len(contributions))
+	for j, cj := range contributions {
+		bzs[j] = cj.FillBytes(make([]byte, ChainCodeLen))
+	}
+	return common.SHA512_256(bzs...)
+}
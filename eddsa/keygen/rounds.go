@@ -7,6 +7,7 @@
 package keygen
 
 import (
+	"github.com/bnb-chain/tss-lib/crypto/transcript"
 	"github.com/bnb-chain/tss-lib/tss"
 )
 
@@ -34,6 +35,23 @@ type (
 	round3 struct {
 		*round2
 	}
+	// in robust mode, the complaint rounds run between rounds 2 and 3
+	roundComplaints struct {
+		*round2
+	}
+	roundReveals struct {
+		*roundComplaints
+	}
+	// with the Pedersen DKG, the Feldman rounds run after the complaint rounds and before round 3
+	roundFeldman struct {
+		*round2
+	}
+	roundFeldmanComplaints struct {
+		*roundFeldman
+	}
+	roundReconstruct struct {
+		*roundFeldmanComplaints
+	}
 )
 
 func (round *base) Params() *tss.Parameters {
@@ -76,6 +94,31 @@ func (round *base) WrapError(err error, culprits ...*tss.PartyID) *tss.Error {
 
 // ----- //
 
+// transcript returns the Fiat-Shamir transcript for a proof made by `prover`, or nil if the legacy proofs are used
+func (round *base) transcript(prover *tss.PartyID) *transcript.Transcript {
+	return transcript.ForProver(round.Params(), TaskName, prover)
+}
+
+// complaintRounds returns the number of rounds that run between rounds 2 and 3, which shift the later round numbers
+func (round *base) complaintRounds() int {
+	rounds := 0
+	if round.RobustKeygen() {
+		rounds += 2
+	}
+	if round.PedersenDKG() {
+		rounds += 3
+	}
+	return rounds
+}
+
+// feldmanRound returns the number of the first Feldman round of the Pedersen DKG
+func (round *base) feldmanRound() int {
+	if round.RobustKeygen() {
+		return 5
+	}
+	return 3
+}
+
 // `ok` tracks parties which have been verified by Update()
 func (round *base) resetOK() {
 	for j := range round.ok {
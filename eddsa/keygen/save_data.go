@@ -10,10 +10,14 @@ import (
 	"encoding/hex"
 	"math/big"
 
+	"github.com/bnb-chain/tss-lib/common"
 	"github.com/bnb-chain/tss-lib/crypto"
 	"github.com/bnb-chain/tss-lib/tss"
 )
 
+// ChainCodeLen is the length in bytes of the chain code generated during keygen
+const ChainCodeLen = 32
+
 type (
 	LocalSecrets struct {
 		// secret fields (not shared, but stored locally)
@@ -32,6 +36,12 @@ type (
 
 		// used for test assertions (may be discarded)
 		EDDSAPub *crypto.ECPoint // y
+
+		// chain code for HD key derivation, generated jointly by the parties and shared by all of them
+		ChainCode []byte
+
+		// keys of the dealers that robust keygen left out of the key, and out of Ks, after they were proven to cheat
+		DisqualifiedKs []*big.Int
 	}
 )
 
@@ -41,6 +51,12 @@ func NewLocalPartySaveData(partyCount int) (saveData LocalPartySaveData) {
 	return
 }
 
+// Wipe overwrites the secret share once the key data is no longer needed in memory, e.g. after it has been persisted.
+// Any copy of the key data is affected too, as they share the same values.
+func (save LocalPartySaveData) Wipe() {
+	common.WipeInts(save.Xi)
+}
+
 // BuildLocalSaveDataSubset re-creates the LocalPartySaveData to contain data for only the list of signing parties.
 func BuildLocalSaveDataSubset(sourceData LocalPartySaveData, sortedIDs tss.SortedPartyIDs) LocalPartySaveData {
 	keysToIndices := make(map[string]int, len(sourceData.Ks))
@@ -50,6 +66,7 @@ func BuildLocalSaveDataSubset(sourceData LocalPartySaveData, sortedIDs tss.Sorte
 	newData := NewLocalPartySaveData(sortedIDs.Len())
 	newData.LocalSecrets = sourceData.LocalSecrets
 	newData.EDDSAPub = sourceData.EDDSAPub
+	newData.ChainCode = sourceData.ChainCode
 	for j, id := range sortedIDs {
 		savedIdx, ok := keysToIndices[hex.EncodeToString(id.Key)]
 		if !ok {
@@ -0,0 +1,119 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package refresh
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/bnb-chain/tss-lib/crypto/vss"
+	"github.com/bnb-chain/tss-lib/eddsa/keygen"
+	"github.com/bnb-chain/tss-lib/tss"
+)
+
+// round 1 represents round 1 of the share refresh: every party deals a sharing of zero to all of the others
+func newRound1(params *tss.Parameters, input, save *keygen.LocalPartySaveData, temp *localTempData, out chan<- tss.Message, end chan<- keygen.LocalPartySaveData) tss.Round {
+	return &round1{
+		&base{params, temp, input, save, out, end, make([]bool, len(params.Parties().IDs())), false, 1}}
+}
+
+func (round *round1) Start() *tss.Error {
+	if round.started {
+		return round.WrapError(errors.New("round already started"))
+	}
+	round.number = 1
+	round.started = true
+	round.resetOK()
+
+	Pi := round.PartyID()
+	i := Pi.Index
+
+	// 1. the parties must be exactly the holders of the key shares, in the order of Ks
+	if err := checkKeyParties(round.input, round.Parties().IDs()); err != nil {
+		return round.WrapError(err, Pi)
+	}
+	if round.input.ShareID.Cmp(Pi.KeyInt()) != 0 {
+		return round.WrapError(errors.New("the share ID of the key data does not match this party"), Pi)
+	}
+
+	// 2. deal a sharing of zero with the degree of the key's sharing
+	vs, shares, err := vss.CreateZeroSharing(round.Params().EC(), round.Threshold(), round.input.Ks, round.Rand())
+	if err != nil {
+		return round.WrapError(err, Pi)
+	}
+	round.temp.shares = shares
+
+	// 3. BROADCAST the commitments to the non-zero coefficients
+	r1msg1, err := NewRFRound1Message1(Pi, vs)
+	if err != nil {
+		return round.WrapError(err, Pi)
+	}
+	round.temp.rfRound1Message1s[i] = r1msg1
+	round.out <- r1msg1
+
+	// 4. P2P send zero share ij to Pj
+	for j, Pj := range round.Parties().IDs() {
+		r1msg2 := NewRFRound1Message2(Pj, Pi, shares[j])
+		// do not send to this Pj, but store for round 2
+		if j == i {
+			round.temp.rfRound1Message2s[j] = r1msg2
+			continue
+		}
+		round.out <- r1msg2
+	}
+	return nil
+}
+
+func (round *round1) CanAccept(msg tss.ParsedMessage) bool {
+	if _, ok := msg.Content().(*RFRound1Message1); ok {
+		return msg.IsBroadcast()
+	}
+	if _, ok := msg.Content().(*RFRound1Message2); ok {
+		return !msg.IsBroadcast()
+	}
+	return false
+}
+
+func (round *round1) Update() (bool, *tss.Error) {
+	for j, msg := range round.temp.rfRound1Message1s {
+		if round.ok[j] {
+			continue
+		}
+		if msg == nil || !round.CanAccept(msg) {
+			return false, nil
+		}
+		msg2 := round.temp.rfRound1Message2s[j]
+		if msg2 == nil || !round.CanAccept(msg2) {
+			return false, nil
+		}
+		round.ok[j] = true
+	}
+	return true, nil
+}
+
+func (round *round1) NextRound() tss.Round {
+	round.started = false
+	return &round2{round}
+}
+
+// ----- //
+
+// checkKeyParties checks that `Ps` are the holders of the shares of `key`, in the order of its Ks and public shares
+func checkKeyParties(key *keygen.LocalPartySaveData, Ps tss.SortedPartyIDs) error {
+	if key.Xi == nil || key.ShareID == nil || key.EDDSAPub == nil {
+		return errors.New("the key data is incomplete")
+	}
+	if len(key.Ks) != len(Ps) || len(key.BigXj) != len(Ps) {
+		return fmt.Errorf("the key has %d shares but %d parties take part in the refresh", len(key.Ks), len(Ps))
+	}
+	for j, Pj := range Ps {
+		if key.Ks[j] == nil || key.Ks[j].Cmp(Pj.KeyInt()) != 0 || key.BigXj[j] == nil {
+			return fmt.Errorf("party %s does not hold share %d of the key", Pj, j)
+		}
+	}
+	return nil
+}
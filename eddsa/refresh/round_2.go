@@ -0,0 +1,118 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package refresh
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/bnb-chain/tss-lib/crypto"
+	"github.com/bnb-chain/tss-lib/crypto/group"
+	"github.com/bnb-chain/tss-lib/crypto/vss"
+	"github.com/bnb-chain/tss-lib/tss"
+)
+
+func (round *round2) Start() *tss.Error {
+	if round.started {
+		return round.WrapError(errors.New("round already started"))
+	}
+	round.number = 2
+	round.started = true
+	round.resetOK()
+
+	Ps := round.Parties().IDs()
+	Pi := round.PartyID()
+	i := Pi.Index
+	g := group.Ed25519()
+
+	// 1. start from the current share and public shares
+	newXi := g.ScalarFromBigInt(round.input.Xi)
+	newBigXjs := make([]group.Point, len(Ps))
+	for j, Xj := range round.input.BigXj {
+		Xjp, err := g.PointFromAffine(Xj.X(), Xj.Y())
+		if err != nil {
+			return round.WrapError(err, Ps[j])
+		}
+		newBigXjs[j] = Xjp
+	}
+
+	// 2-4. verify every zero share to us and add it to our share; add every party's zero share to its public share
+	culprits := make([]*tss.PartyID, 0, len(Ps)) // who caused the error(s)
+	for j, Pj := range Ps {
+		r1msg1 := round.temp.rfRound1Message1s[j].Content().(*RFRound1Message1)
+		r1msg2 := round.temp.rfRound1Message2s[j].Content().(*RFRound1Message2)
+		vj, err := r1msg1.UnmarshalVs(round.Params().EC())
+		if err != nil || len(vj) != round.Threshold() {
+			culprits = append(culprits, Pj)
+			continue
+		}
+		for c, v := range vj {
+			vj[c] = v.ClearTorsion()
+		}
+		share := &vss.Share{
+			Threshold: round.Threshold(),
+			ID:        Pi.KeyInt(),
+			Share:     new(big.Int).SetBytes(r1msg2.Share),
+		}
+		if !share.VerifyZero(round.Params().EC(), round.Threshold(), vj) {
+			culprits = append(culprits, Pj)
+			continue
+		}
+		newXi = newXi.Add(g.ScalarFromBigInt(share.Share))
+		for k, kk := range round.input.Ks {
+			vk, err := vss.EvaluateZeroCommitments(g, vj, kk)
+			if err != nil {
+				return round.WrapError(err, Pj)
+			}
+			newBigXjs[k] = newBigXjs[k].Add(vk)
+		}
+	}
+	if len(culprits) > 0 {
+		return round.WrapError(errors.New("zero share from this party did not pass VerifyZero()"), culprits...)
+	}
+
+	// 5. the refreshed public shares must be valid points; the identity would mean a share of zero
+	round.temp.newBigXjs = make([]*crypto.ECPoint, len(Ps))
+	for j, Xj := range newBigXjs {
+		var err error
+		if round.temp.newBigXjs[j], err = crypto.NewECPointFromGroupPoint(g, Xj); err != nil {
+			return round.WrapError(err, Ps[j])
+		}
+	}
+	round.temp.newXi = newXi.BigInt()
+
+	// 6. BROADCAST an "ACK" to signal that we're ready to save our data
+	r2msg := NewRFRound2Message(Pi)
+	round.temp.rfRound2Messages[i] = r2msg
+	round.out <- r2msg
+	return nil
+}
+
+func (round *round2) CanAccept(msg tss.ParsedMessage) bool {
+	if _, ok := msg.Content().(*RFRound2Message); ok {
+		return msg.IsBroadcast()
+	}
+	return false
+}
+
+func (round *round2) Update() (bool, *tss.Error) {
+	for j, msg := range round.temp.rfRound2Messages {
+		if round.ok[j] {
+			continue
+		}
+		if msg == nil || !round.CanAccept(msg) {
+			return false, nil
+		}
+		round.ok[j] = true
+	}
+	return true, nil
+}
+
+func (round *round2) NextRound() tss.Round {
+	round.started = false
+	return &round3{round}
+}
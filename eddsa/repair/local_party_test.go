@@ -0,0 +1,133 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package repair_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ipfs/go-log"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bnb-chain/tss-lib/common"
+	"github.com/bnb-chain/tss-lib/eddsa/keygen"
+	. "github.com/bnb-chain/tss-lib/eddsa/repair"
+	"github.com/bnb-chain/tss-lib/test"
+	"github.com/bnb-chain/tss-lib/tss"
+)
+
+const (
+	testThreshold = test.TestThreshold
+)
+
+func setUp(level string) {
+	if err := log.SetLogLevel("tss-lib", level); err != nil {
+		panic(err)
+	}
+
+	// only for test
+	tss.SetCurve(tss.Edwards())
+}
+
+// startParties starts the repair of the share of pIDs[lostIdx], which has lost its key data, by the other parties
+func startParties(keys []keygen.LocalPartySaveData, pIDs tss.SortedPartyIDs, lostIdx int, errCh chan *tss.Error, outCh chan tss.Message, endCh chan keygen.LocalPartySaveData) []*LocalParty {
+	p2pCtx := tss.NewPeerContext(pIDs)
+	parties := make([]*LocalParty, 0, len(pIDs))
+	for j, pID := range pIDs {
+		params := tss.NewParameters(tss.Edwards(), p2pCtx, pID, len(pIDs), testThreshold)
+		key := keys[j]
+		if j == lostIdx {
+			key = keygen.LocalPartySaveData{}
+		}
+		P := NewLocalParty(params, key, pIDs[lostIdx], outCh, endCh).(*LocalParty)
+		parties = append(parties, P)
+		go func(P *LocalParty) {
+			if err := P.Start(); err != nil {
+				errCh <- err
+			}
+		}(P)
+	}
+	return parties
+}
+
+func TestE2EConcurrent(t *testing.T) {
+	setUp("info")
+
+	// PHASE: load keygen fixtures; t+1 holders help the party at lostIdx, whose key data is lost
+	keys, pIDs, err := keygen.LoadKeygenTestFixtures(testThreshold + 2)
+	assert.NoError(t, err, "should load keygen fixtures")
+	lostIdx := 3
+	lostXi := new(big.Int).Set(keys[lostIdx].Xi)
+
+	// PHASE: repair
+	errCh := make(chan *tss.Error, len(pIDs))
+	outCh := make(chan tss.Message, len(pIDs))
+	endCh := make(chan keygen.LocalPartySaveData, len(pIDs))
+	parties := startParties(keys, pIDs, lostIdx, errCh, outCh, endCh)
+
+	for ended := 0; ended < len(pIDs); {
+		select {
+		case err := <-errCh:
+			common.Logger.Errorf("Error: %s", err)
+			assert.FailNow(t, err.Error())
+			return
+		case msg := <-outCh:
+			dest := msg.GetTo()
+			if dest[0].Index == msg.GetFrom().Index {
+				t.Fatalf("party %d tried to send a message to itself (%d)", dest[0].Index, msg.GetFrom().Index)
+			}
+			go test.SharedPartyUpdater(parties[dest[0].Index], msg, errCh)
+		case save := <-endCh:
+			index := -1
+			for j, pID := range pIDs {
+				if pID.KeyInt().Cmp(save.ShareID) == 0 {
+					index = j
+				}
+			}
+			assert.Equal(t, keys[index].Xi, save.Xi, "the share should be repaired or kept")
+			assert.Equal(t, keys[index].Ks, save.Ks)
+			assert.True(t, keys[index].EDDSAPub.Equals(save.EDDSAPub))
+			for j, Xj := range save.BigXj {
+				assert.True(t, keys[index].BigXj[j].Equals(Xj))
+			}
+			if index == lostIdx {
+				assert.Equal(t, lostXi, save.Xi, "the lost share should be repaired")
+			}
+			ended++
+		}
+	}
+}
+
+func TestE2EBadSumIsDetected(t *testing.T) {
+	setUp("info")
+	keys, pIDs, err := keygen.LoadKeygenTestFixtures(testThreshold + 2)
+	assert.NoError(t, err, "should load keygen fixtures")
+	lostIdx := 0
+
+	errCh := make(chan *tss.Error, len(pIDs))
+	outCh := make(chan tss.Message, len(pIDs))
+	endCh := make(chan keygen.LocalPartySaveData, len(pIDs))
+	parties := startParties(keys, pIDs, lostIdx, errCh, outCh, endCh)
+
+	// P1 sends a wrong sum to the party being repaired, which must not save the share; the helpers keep their key data
+	for ended, errs := 0, 0; ended < len(pIDs)-1 || errs < 1; {
+		select {
+		case err := <-errCh:
+			assert.Equal(t, 3, err.Round())
+			assert.Equal(t, pIDs[lostIdx], err.Victim())
+			errs++
+		case msg := <-outCh:
+			if r2msg, ok := msg.(tss.ParsedMessage).Content().(*RPRound2Message); ok && msg.GetFrom().Index == 1 {
+				msg = NewRPRound2Message(msg.GetTo()[0], msg.GetFrom(), new(big.Int).Add(r2msg.UnmarshalShare(), big.NewInt(1)))
+			}
+			go test.SharedPartyUpdater(parties[msg.GetTo()[0].Index], msg, errCh)
+		case save := <-endCh:
+			assert.NotEqual(t, pIDs[lostIdx].KeyInt(), save.ShareID)
+			ended++
+		}
+	}
+}
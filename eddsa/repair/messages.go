@@ -0,0 +1,135 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package repair
+
+import (
+	"crypto/elliptic"
+	"math/big"
+
+	"github.com/bnb-chain/tss-lib/common"
+	"github.com/bnb-chain/tss-lib/crypto"
+	"github.com/bnb-chain/tss-lib/eddsa/keygen"
+	"github.com/bnb-chain/tss-lib/tss"
+)
+
+// These messages were generated from Protocol Buffers definitions into eddsa-repair.pb.go
+
+var (
+	// Ensure that repair messages implement ValidateBasic
+	_ = []tss.MessageContent{
+		(*RPRound1Message1)(nil),
+		(*RPRound1Message2)(nil),
+		(*RPRound2Message)(nil),
+	}
+)
+
+// ----- //
+
+func NewRPRound1Message1(
+	to, from *tss.PartyID,
+	part *big.Int,
+) tss.ParsedMessage {
+	meta := tss.MessageRouting{
+		From:        from,
+		To:          []*tss.PartyID{to},
+		IsBroadcast: false,
+	}
+	content := &RPRound1Message1{
+		Share: part.Bytes(),
+	}
+	msg := tss.NewMessageWrapper(meta, content)
+	return tss.NewMessage(meta, content, msg)
+}
+
+func (m *RPRound1Message1) ValidateBasic() bool {
+	return m != nil
+}
+
+func (m *RPRound1Message1) UnmarshalShare() *big.Int {
+	return new(big.Int).SetBytes(m.GetShare())
+}
+
+// ----- //
+
+// NewRPRound1Message2 returns the message that gives the public data of `key` to the party being repaired
+func NewRPRound1Message2(
+	to, from *tss.PartyID,
+	key *keygen.LocalPartySaveData,
+) (tss.ParsedMessage, error) {
+	meta := tss.MessageRouting{
+		From:        from,
+		To:          []*tss.PartyID{to},
+		IsBroadcast: false,
+	}
+	flatBigXj, err := crypto.FlattenECPoints(key.BigXj)
+	if err != nil {
+		return nil, err
+	}
+	content := &RPRound1Message2{
+		Ks:             common.BigIntsToBytes(key.Ks),
+		BigXj:          common.BigIntsToBytes(flatBigXj),
+		EddsaPubX:      key.EDDSAPub.X().Bytes(),
+		EddsaPubY:      key.EDDSAPub.Y().Bytes(),
+		ChainCode:      key.ChainCode,
+		DisqualifiedKs: common.BigIntsToBytes(key.DisqualifiedKs),
+	}
+	msg := tss.NewMessageWrapper(meta, content)
+	return tss.NewMessage(meta, content, msg), nil
+}
+
+func (m *RPRound1Message2) ValidateBasic() bool {
+	return m != nil &&
+		common.NonEmptyMultiBytes(m.Ks) &&
+		common.NonEmptyMultiBytes(m.BigXj, len(m.Ks)*2) &&
+		common.NonEmptyBytes(m.EddsaPubX) &&
+		common.NonEmptyBytes(m.EddsaPubY) &&
+		(len(m.ChainCode) == 0 || len(m.ChainCode) == keygen.ChainCodeLen)
+}
+
+// UnmarshalKeyData returns key data holding the public data in the message, without any secrets
+func (m *RPRound1Message2) UnmarshalKeyData(ec elliptic.Curve) (keygen.LocalPartySaveData, error) {
+	key := keygen.NewLocalPartySaveData(len(m.GetKs()))
+	key.Ks = common.MultiBytesToBigInts(m.GetKs())
+	var err error
+	if key.BigXj, err = crypto.UnFlattenECPoints(ec, common.MultiBytesToBigInts(m.GetBigXj())); err != nil {
+		return key, err
+	}
+	if key.EDDSAPub, err = crypto.NewECPoint(ec, new(big.Int).SetBytes(m.GetEddsaPubX()), new(big.Int).SetBytes(m.GetEddsaPubY())); err != nil {
+		return key, err
+	}
+	key.ChainCode = m.GetChainCode()
+	if len(m.GetDisqualifiedKs()) > 0 {
+		key.DisqualifiedKs = common.MultiBytesToBigInts(m.GetDisqualifiedKs())
+	}
+	return key, nil
+}
+
+// ----- //
+
+func NewRPRound2Message(
+	to, from *tss.PartyID,
+	sum *big.Int,
+) tss.ParsedMessage {
+	meta := tss.MessageRouting{
+		From:        from,
+		To:          []*tss.PartyID{to},
+		IsBroadcast: false,
+	}
+	content := &RPRound2Message{
+		Share: sum.Bytes(),
+	}
+	msg := tss.NewMessageWrapper(meta, content)
+	return tss.NewMessage(meta, content, msg)
+}
+
+func (m *RPRound2Message) ValidateBasic() bool {
+	return m != nil
+}
+
+func (m *RPRound2Message) UnmarshalShare() *big.Int {
+	return new(big.Int).SetBytes(m.GetShare())
+}
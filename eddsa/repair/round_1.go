@@ -0,0 +1,142 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package repair
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/bnb-chain/tss-lib/crypto/vss"
+	"github.com/bnb-chain/tss-lib/eddsa/keygen"
+	"github.com/bnb-chain/tss-lib/tss"
+)
+
+// round 1 represents round 1 of the share repair: every helper splits its contribution to the lost share between the
+// helpers and gives the public key data to the party being repaired
+func newRound1(params *tss.Parameters, input, save *keygen.LocalPartySaveData, temp *localTempData, out chan<- tss.Message, end chan<- keygen.LocalPartySaveData) tss.Round {
+	return &round1{
+		&base{params, temp, input, save, out, end, make([]bool, len(params.Parties().IDs())), false, 1}}
+}
+
+func (round *round1) Start() *tss.Error {
+	if round.started {
+		return round.WrapError(errors.New("round already started"))
+	}
+	round.number = 1
+	round.started = true
+	round.resetOK()
+
+	Pi := round.PartyID()
+	i := Pi.Index
+
+	if round.temp.lostIdx < 0 {
+		return round.WrapError(errors.New("the party being repaired is not one of the parties"))
+	}
+	// the party being repaired sends nothing in this round
+	round.ok[round.temp.lostIdx] = true
+	if round.isLost() {
+		return nil
+	}
+
+	// 1. the helpers and the party being repaired must all be holders of the key
+	if err := checkKeyParties(round.input, round.Parties().IDs()); err != nil {
+		return round.WrapError(err, Pi)
+	}
+	if round.input.ShareID.Cmp(Pi.KeyInt()) != 0 {
+		return round.WrapError(errors.New("the share ID of the key data does not match this party"), Pi)
+	}
+
+	// 2. split our contribution to the lost share into a random part for each helper
+	helpers, lost := round.helpers(), round.Parties().IDs()[round.temp.lostIdx]
+	share := &vss.Share{Threshold: round.Threshold(), ID: round.input.ShareID, Share: round.input.Xi}
+	parts, err := share.CreateRepairParts(round.Params().EC(), helpers.Keys(), lost.KeyInt(), round.Rand())
+	if err != nil {
+		return round.WrapError(err, Pi)
+	}
+	round.temp.parts = parts
+
+	// 3. P2P send part j to each helper Pj
+	for j, Pj := range helpers {
+		r1msg1 := NewRPRound1Message1(Pj, Pi, parts[j])
+		// do not send to this Pj, but store for round 2
+		if Pj.Index == i {
+			round.temp.rpRound1Message1s[i] = r1msg1
+			continue
+		}
+		round.out <- r1msg1
+	}
+
+	// 4. P2P send the public key data to the party being repaired
+	r1msg2, err := NewRPRound1Message2(lost, Pi, round.input)
+	if err != nil {
+		return round.WrapError(err, Pi)
+	}
+	round.out <- r1msg2
+	return nil
+}
+
+func (round *round1) CanAccept(msg tss.ParsedMessage) bool {
+	if _, ok := msg.Content().(*RPRound1Message1); ok {
+		return !msg.IsBroadcast() && !round.isLost()
+	}
+	if _, ok := msg.Content().(*RPRound1Message2); ok {
+		return !msg.IsBroadcast() && round.isLost()
+	}
+	return false
+}
+
+func (round *round1) Update() (bool, *tss.Error) {
+	// the helpers receive the parts of the other helpers and the party being repaired receives the public key data
+	msgs := round.temp.rpRound1Message1s
+	if round.isLost() {
+		msgs = round.temp.rpRound1Message2s
+	}
+	for j, msg := range msgs {
+		if round.ok[j] {
+			continue
+		}
+		if msg == nil || !round.CanAccept(msg) {
+			return false, nil
+		}
+		round.ok[j] = true
+	}
+	return true, nil
+}
+
+func (round *round1) NextRound() tss.Round {
+	round.started = false
+	return &round2{round}
+}
+
+// ----- //
+
+// checkKeyParties checks that `Ps` are all holders of the shares of `key`
+func checkKeyParties(key *keygen.LocalPartySaveData, Ps tss.SortedPartyIDs) error {
+	if key.Xi == nil || key.ShareID == nil || key.EDDSAPub == nil {
+		return errors.New("the key data is incomplete")
+	}
+	if len(key.BigXj) != len(key.Ks) {
+		return fmt.Errorf("the key has %d shares but %d public shares", len(key.Ks), len(key.BigXj))
+	}
+	for _, Pj := range Ps {
+		if keyIndex(key.Ks, Pj.KeyInt()) < 0 {
+			return fmt.Errorf("party %s does not hold a share of the key", Pj)
+		}
+	}
+	return nil
+}
+
+// keyIndex returns the index of `k` in `ks`, or -1 if it is not there
+func keyIndex(ks []*big.Int, k *big.Int) int {
+	for j, kj := range ks {
+		if kj != nil && kj.Cmp(k) == 0 {
+			return j
+		}
+	}
+	return -1
+}
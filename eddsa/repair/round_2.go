@@ -0,0 +1,96 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package repair
+
+import (
+	"errors"
+	"math/big"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/bnb-chain/tss-lib/crypto/vss"
+	"github.com/bnb-chain/tss-lib/tss"
+)
+
+func (round *round2) Start() *tss.Error {
+	if round.started {
+		return round.WrapError(errors.New("round already started"))
+	}
+	round.number = 2
+	round.started = true
+	round.resetOK()
+
+	Pi := round.PartyID()
+	helpers, lost := round.helpers(), round.Parties().IDs()[round.temp.lostIdx]
+	round.ok[round.temp.lostIdx] = true
+
+	if round.isLost() {
+		// 1. the helpers must agree on the public key data, which must list all of the parties
+		first := round.temp.rpRound1Message2s[helpers[0].Index]
+		for _, Pj := range helpers[1:] {
+			if !proto.Equal(round.temp.rpRound1Message2s[Pj.Index].Content(), first.Content()) {
+				return round.WrapError(errors.New("the public key data from this helper differs from that of the first helper"), Pj)
+			}
+		}
+		key, err := first.Content().(*RPRound1Message2).UnmarshalKeyData(round.Params().EC())
+		if err != nil {
+			return round.WrapError(err, helpers...)
+		}
+		for _, Pj := range round.Parties().IDs() {
+			if keyIndex(key.Ks, Pj.KeyInt()) < 0 {
+				return round.WrapError(errors.New("this party does not hold a share of the key"), Pj)
+			}
+		}
+		*round.save = key
+		return nil
+	}
+
+	// 2. add up the parts received from every helper and P2P send the sum to the party being repaired
+	for j := range round.ok {
+		round.ok[j] = true
+	}
+	parts := make([]*big.Int, len(helpers))
+	for j, Pj := range helpers {
+		parts[j] = round.temp.rpRound1Message1s[Pj.Index].Content().(*RPRound1Message1).UnmarshalShare()
+	}
+	sum, err := vss.SumRepairParts(round.Params().EC(), parts)
+	if err != nil {
+		return round.WrapError(err, Pi)
+	}
+	round.temp.sum = sum
+	round.out <- NewRPRound2Message(lost, Pi, sum)
+	return nil
+}
+
+func (round *round2) CanAccept(msg tss.ParsedMessage) bool {
+	if _, ok := msg.Content().(*RPRound2Message); ok {
+		return !msg.IsBroadcast() && round.isLost()
+	}
+	return false
+}
+
+func (round *round2) Update() (bool, *tss.Error) {
+	// only the party being repaired receives in this round
+	if !round.isLost() {
+		return true, nil
+	}
+	for j, msg := range round.temp.rpRound2Messages {
+		if round.ok[j] {
+			continue
+		}
+		if msg == nil || !round.CanAccept(msg) {
+			return false, nil
+		}
+		round.ok[j] = true
+	}
+	return true, nil
+}
+
+func (round *round2) NextRound() tss.Round {
+	round.started = false
+	return &round3{round}
+}
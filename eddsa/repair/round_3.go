@@ -0,0 +1,67 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package repair
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/bnb-chain/tss-lib/crypto"
+	"github.com/bnb-chain/tss-lib/crypto/vss"
+	"github.com/bnb-chain/tss-lib/tss"
+)
+
+func (round *round3) Start() *tss.Error {
+	if round.started {
+		return round.WrapError(errors.New("round already started"))
+	}
+	round.number = 3
+	round.started = true
+	round.resetOK()
+
+	Pi := round.PartyID()
+
+	// the helpers keep their key data
+	if !round.isLost() {
+		round.end <- *round.input
+		return nil
+	}
+
+	// 1. add up the sums from every helper to recover the lost share
+	helpers := round.helpers()
+	sums := make([]*big.Int, len(helpers))
+	for j, Pj := range helpers {
+		sums[j] = round.temp.rpRound2Messages[Pj.Index].Content().(*RPRound2Message).UnmarshalShare()
+	}
+	xi, err := vss.SumRepairParts(round.Params().EC(), sums)
+	if err != nil {
+		return round.WrapError(err, Pi)
+	}
+
+	// 2. the share must match its public share. the helper that sent a bad part cannot be told from the sums
+	if !crypto.ScalarBaseMult(round.Params().EC(), xi).Equals(round.save.BigXj[keyIndex(round.save.Ks, Pi.KeyInt())]) {
+		return round.WrapError(errors.New("the repaired share does not match its public share"))
+	}
+
+	// for this P: SAVE data
+	round.save.ShareID = Pi.KeyInt()
+	round.save.Xi = xi
+	round.end <- *round.save
+	return nil
+}
+
+func (round *round3) CanAccept(msg tss.ParsedMessage) bool {
+	return false
+}
+
+func (round *round3) Update() (bool, *tss.Error) {
+	return false, nil
+}
+
+func (round *round3) NextRound() tss.Round {
+	return nil // finished!
+}
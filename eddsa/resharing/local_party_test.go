@@ -17,6 +17,7 @@ import (
 
 	"github.com/bnb-chain/tss-lib/common"
 	"github.com/bnb-chain/tss-lib/crypto"
+	"github.com/bnb-chain/tss-lib/crypto/vss"
 	"github.com/bnb-chain/tss-lib/eddsa/keygen"
 	. "github.com/bnb-chain/tss-lib/eddsa/resharing"
 	"github.com/bnb-chain/tss-lib/eddsa/signing"
@@ -66,8 +67,12 @@ func TestE2EConcurrent(t *testing.T) {
 
 	updater := test.SharedPartyUpdater
 
+	// the fixtures pre-date joint chain codes; give the old key one so that we can check it survives resharing
+	chainCode := common.SHA512_256([]byte("chain code"))
+
 	// init the old parties first
 	for j, pID := range oldPIDs {
+		oldKeys[j].ChainCode = chainCode
 		params := tss.NewReSharingParameters(tss.Edwards(), oldP2PCtx, newP2PCtx, pID, testParticipants, threshold, newPCount, newThreshold)
 		P := NewLocalParty(params, oldKeys[j], outCh, endCh).(*LocalParty) // discard old key data
 		oldCommittee = append(oldCommittee, P)
@@ -145,6 +150,7 @@ func TestE2EConcurrent(t *testing.T) {
 					gXj := crypto.ScalarBaseMult(tss.Edwards(), xj)
 					BigXj := key.BigXj[j]
 					assert.True(t, BigXj.Equals(gXj), "ensure BigX_j == g^x_j")
+					assert.Equal(t, chainCode, key.ChainCode, "the chain code should be preserved")
 				}
 
 				// more verification of signing is implemented within local_party_test.go of keygen package
@@ -228,3 +234,100 @@ signing:
 		}
 	}
 }
+
+func TestE2EPartyInBothCommittees(t *testing.T) {
+	setUp("info")
+
+	// PHASE: load keygen fixtures
+	oldKeys, oldPIDs, err := keygen.LoadKeygenTestFixtures(testThreshold + 1)
+	assert.NoError(t, err, "should load keygen fixtures")
+	oldP2PCtx := tss.NewPeerContext(oldPIDs)
+	pubKey := oldKeys[0].EDDSAPub
+
+	// the first two old parties stay on under the same keys and a new party joins them
+	stayCount, newThreshold := 2, 1
+	newIDs := make(tss.UnSortedPartyIDs, 0, stayCount+1)
+	for _, pID := range oldPIDs[:stayCount] {
+		newIDs = append(newIDs, tss.NewPartyID(pID.Id, pID.Moniker, pID.KeyInt()))
+	}
+	joiningPID := tss.NewPartyID("joining", "P[joining]", common.MustGetRandomInt(256))
+	newPIDs := tss.SortPartyIDs(append(newIDs, joiningPID))
+	newP2PCtx := tss.NewPeerContext(newPIDs)
+
+	newParams := func(pID *tss.PartyID) *tss.ReSharingParameters {
+		return tss.NewReSharingParameters(tss.Edwards(), oldP2PCtx, newP2PCtx, pID, len(oldPIDs), testThreshold, len(newPIDs), newThreshold)
+	}
+	errCh := make(chan *tss.Error, len(oldPIDs)+1)
+	outCh := make(chan tss.Message, len(oldPIDs)+1)
+	endCh := make(chan keygen.LocalPartySaveData, len(oldPIDs)+1)
+
+	// a single party plays both roles for each of the staying parties
+	parties := make([]*LocalParty, 0, len(oldPIDs)+1)
+	for j, pID := range oldPIDs {
+		params := newParams(pID)
+		assert.Equal(t, j < stayCount, params.IsOldCommittee() && params.IsNewCommittee())
+		parties = append(parties, NewLocalParty(params, oldKeys[j], outCh, endCh).(*LocalParty))
+	}
+	joiningSave := keygen.NewLocalPartySaveData(len(newPIDs))
+	parties = append(parties, NewLocalParty(newParams(joiningPID), joiningSave, outCh, endCh).(*LocalParty))
+	partiesByKey := make(map[string]*LocalParty, len(parties))
+	for _, P := range parties {
+		partiesByKey[P.PartyID().KeyInt().String()] = P
+	}
+
+	for _, P := range parties {
+		go func(P *LocalParty) {
+			if err := P.Start(); err != nil {
+				errCh <- err
+			}
+		}(P)
+	}
+
+	newKeys := make([]keygen.LocalPartySaveData, len(newPIDs))
+	for ended := 0; ended < len(parties); {
+		select {
+		case err := <-errCh:
+			common.Logger.Errorf("Error: %s", err)
+			assert.FailNow(t, err.Error())
+			return
+
+		case msg := <-outCh:
+			// deliver the message once to each recipient, whatever the committees it is listed in
+			delivered := make(map[string]bool, len(msg.GetTo()))
+			for _, destP := range msg.GetTo() {
+				key := destP.KeyInt().String()
+				if destP.KeyInt().Cmp(msg.GetFrom().KeyInt()) == 0 {
+					t.Fatalf("party %s tried to send a message to itself: %s", msg.GetFrom(), msg)
+				}
+				if delivered[key] {
+					continue
+				}
+				delivered[key] = true
+				go test.SharedPartyUpdater(partiesByKey[key], msg, errCh)
+			}
+
+		case save := <-endCh:
+			ended++
+			// the parties that leave have their Xi zeroed
+			if save.Xi != nil {
+				index, err := save.OriginalIndex()
+				assert.NoErrorf(t, err, "should not be an error getting a party's index from save data")
+				newKeys[index] = save
+			}
+		}
+	}
+
+	shares := make(vss.Shares, 0, len(newKeys))
+	for j, key := range newKeys {
+		assert.NotNil(t, key.Xi, "every member of the new committee should receive a share")
+		assert.True(t, key.EDDSAPub.Equals(pubKey), "the public key should be preserved")
+		assert.True(t, key.BigXj[j].Equals(crypto.ScalarBaseMult(tss.Edwards(), key.Xi)), "ensure BigX_j == g^x_j")
+		shares = append(shares, &vss.Share{Threshold: newThreshold, ID: key.ShareID, Share: key.Xi})
+	}
+	secret, err := shares.ReConstruct(tss.Edwards())
+	assert.NoError(t, err)
+	assert.True(t, crypto.ScalarBaseMult(tss.Edwards(), secret).Equals(pubKey), "the new shares should reconstruct the key")
+	for j := range oldKeys[:stayCount] {
+		assert.Zero(t, oldKeys[j].Xi.Sign(), "the old share of a staying party should be wiped")
+	}
+}
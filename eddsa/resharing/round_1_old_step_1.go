@@ -7,9 +7,11 @@
 package resharing
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 
+	"github.com/bnb-chain/tss-lib/common"
 	"github.com/bnb-chain/tss-lib/crypto"
 	"github.com/bnb-chain/tss-lib/crypto/commitments"
 	"github.com/bnb-chain/tss-lib/crypto/vss"
@@ -36,10 +38,11 @@ func (round *round1) Start() *tss.Error {
 	if !round.ReSharingParams().IsOldCommittee() {
 		return nil
 	}
-	round.allOldOK()
+	if !round.ReSharingParams().IsNewCommittee() {
+		round.allOldOK()
+	}
 
-	Pi := round.PartyID()
-	i := Pi.Index
+	i := round.OldPartyIndex()
 
 	// 1. PrepareForSigning() -> w_i
 	xi, ks := round.input.Xi, round.input.Ks
@@ -50,7 +53,8 @@ func (round *round1) Start() *tss.Error {
 	wi := signing.PrepareForSigning(round.Params().EC(), i, len(round.OldParties().IDs()), xi, ks)
 
 	// 2.
-	vi, shares, err := vss.Create(round.Params().EC(), round.NewThreshold(), wi, newKs)
+	vi, shares, err := vss.CreateWithRandom(round.Params().EC(), round.NewThreshold(), wi, newKs, round.Rand())
+	common.WipeInts(wi)
 	if err != nil {
 		return round.WrapError(err, round.PartyID())
 	}
@@ -60,7 +64,7 @@ func (round *round1) Start() *tss.Error {
 	if err != nil {
 		return round.WrapError(err, round.PartyID())
 	}
-	vCmt := commitments.NewHashCommitment(flatVis...)
+	vCmt := commitments.NewHashCommitmentWithRandom(round.Rand(), flatVis...)
 
 	// 4. populate temp data
 	round.temp.VD = vCmt.D
@@ -69,7 +73,7 @@ func (round *round1) Start() *tss.Error {
 	// 5. "broadcast" C_i to members of the NEW committee
 	r1msg := NewDGRound1Message(
 		round.NewParties().IDs().Exclude(round.PartyID()), round.PartyID(),
-		round.input.EDDSAPub, vCmt.C)
+		round.input.EDDSAPub, vCmt.C, round.input.ChainCode)
 	round.temp.dgRound1Messages[i] = r1msg
 	round.out <- r1msg
 
@@ -99,8 +103,8 @@ func (round *round1) Update() (bool, *tss.Error) {
 		}
 		round.oldOK[j] = true
 
-		// save the eddsa pub received from the old committee
-		r1msg := round.temp.dgRound1Messages[0].Content().(*DGRound1Message)
+		// save the eddsa pub and chain code received from the old committee
+		r1msg := msg.Content().(*DGRound1Message)
 		candidate, err := r1msg.UnmarshalEDDSAPub(round.Params().EC())
 		if err != nil {
 			return false, round.WrapError(errors.New("unable to unmarshal the eddsa pub key"), msg.GetFrom())
@@ -110,7 +114,12 @@ func (round *round1) Update() (bool, *tss.Error) {
 			// uh oh - anomaly!
 			return false, round.WrapError(errors.New("eddsa pub key did not match what we received previously"), msg.GetFrom())
 		}
+		if round.save.EDDSAPub != nil &&
+			!bytes.Equal(r1msg.GetChainCode(), round.save.ChainCode) {
+			return false, round.WrapError(errors.New("chain code did not match what we received previously"), msg.GetFrom())
+		}
 		round.save.EDDSAPub = candidate
+		round.save.ChainCode = r1msg.GetChainCode()
 	}
 	return true, nil
 }
@@ -11,9 +11,9 @@ import (
 
 	"github.com/pkg/errors"
 
-	"github.com/bnb-chain/tss-lib/common"
 	"github.com/bnb-chain/tss-lib/crypto"
 	"github.com/bnb-chain/tss-lib/crypto/commitments"
+	"github.com/bnb-chain/tss-lib/crypto/group"
 	"github.com/bnb-chain/tss-lib/crypto/vss"
 	"github.com/bnb-chain/tss-lib/tss"
 )
@@ -34,13 +34,14 @@ func (round *round4) Start() *tss.Error {
 	}
 
 	Pi := round.PartyID()
-	i := Pi.Index
+	i := round.NewPartyIndex()
+
+	g := group.Ed25519()
 
 	// 1.
-	newXi := big.NewInt(0)
+	newXi := g.NewScalar()
 
 	// 2-8.
-	modQ := common.ModInt(round.Params().EC().Params().N)
 	vjc := make([][]*crypto.ECPoint, len(round.OldParties().IDs()))
 	for j := 0; j <= len(vjc)-1; j++ { // P1..P_t+1. Ps are indexed from 0 here
 		r1msg := round.temp.dgRound1Messages[j].Content().(*DGRound1Message)
@@ -61,7 +62,7 @@ func (round *round4) Start() *tss.Error {
 		}
 
 		for i, v := range vj {
-			vj[i] = v.EightInvEight()
+			vj[i] = v.ClearTorsion()
 		}
 
 		vjc[j] = vj
@@ -76,7 +77,7 @@ func (round *round4) Start() *tss.Error {
 			return round.WrapError(errors.New("share from old committee did not pass Verify()"), round.Parties().IDs()[j])
 		}
 
-		newXi = new(big.Int).Add(newXi, sharej.Share)
+		newXi = newXi.Add(g.ScalarFromBigInt(sharej.Share))
 	}
 
 	// 9-12.
@@ -106,10 +107,10 @@ func (round *round4) Start() *tss.Error {
 		kj := Pj.KeyInt()
 		newBigXj := Vc[0]
 		newKs = append(newKs, kj)
-		z := new(big.Int).SetInt64(int64(1))
+		z := g.ScalarFromBigInt(big.NewInt(1))
 		for c := 1; c <= round.NewThreshold(); c++ {
-			z = modQ.Mul(z, kj)
-			newBigXj, err = newBigXj.Add(Vc[c].ScalarMult(z))
+			z = z.Mul(g.ScalarFromBigInt(kj))
+			newBigXj, err = newBigXj.Add(Vc[c].ScalarMult(z.BigInt()))
 			if err != nil {
 				culprits = append(culprits, Pj)
 			}
@@ -120,12 +121,12 @@ func (round *round4) Start() *tss.Error {
 		return round.WrapError(errors.Wrapf(err, "newBigXj.Add(Vc[c].ScalarMult(z))"), culprits...)
 	}
 
-	round.temp.newXi = newXi
+	round.temp.newXi = newXi.BigInt()
 	round.temp.newKs = newKs
 	round.temp.newBigXjs = newBigXjs
 
 	// 21. Send an "ACK" message to both committees to signal that we're ready to save our data
-	r4msg := NewDGRound4Message(round.OldAndNewParties(), Pi)
+	r4msg := NewDGRound4Message(tss.SortedPartyIDs(round.OldAndNewParties()).Exclude(Pi), Pi)
 	round.temp.dgRound4Messages[i] = r4msg
 	round.out <- r4msg
 
@@ -9,11 +9,10 @@ package signing
 import (
 	"errors"
 	"fmt"
-	"math/big"
 
-	"github.com/agl/ed25519/edwards25519"
 	"github.com/decred/dcrd/dcrec/edwards/v2"
 
+	"github.com/bnb-chain/tss-lib/crypto/group"
 	"github.com/bnb-chain/tss-lib/tss"
 )
 
@@ -25,33 +24,42 @@ func (round *finalization) Start() *tss.Error {
 	round.started = true
 	round.resetOK()
 
+	g := group.Ed25519()
 	sumS := round.temp.si
-	for j := range round.Parties().IDs() {
+	for j, Pj := range round.Parties().IDs() {
 		round.ok[j] = true
 		if j == round.PartyID().Index {
 			continue
 		}
 		r3msg := round.temp.signRound3Messages[j].Content().(*SignRound3Message)
-		sjBytes := bigIntToEncodedBytes(r3msg.UnmarshalS())
-		var tmpSumS [32]byte
-		edwards25519.ScMulAdd(&tmpSumS, sumS, bigIntToEncodedBytes(big.NewInt(1)), sjBytes)
-		sumS = &tmpSumS
+		sj := g.ScalarFromBigInt(r3msg.UnmarshalS())
+		if err := round.verifyPartialSignature(j, sj); err != nil {
+			return round.WrapError(err, Pj)
+		}
+		sumS = sumS.Add(sj)
 	}
-	s := encodedBytesToBigInt(sumS)
+	s := sumS.BigInt()
 
 	// save the signature for final output
-	round.data.Signature = append(bigIntToEncodedBytes(round.temp.r)[:], sumS[:]...)
+	round.data.Signature = append(bigIntToEncodedBytes(round.temp.r)[:], sumS.Bytes()...)
 	round.data.R = round.temp.r.Bytes()
 	round.data.S = s.Bytes()
-	round.data.M = round.temp.m.Bytes()
+	round.data.M = round.temp.m
 
-	pk := edwards.PublicKey{
-		Curve: round.Params().EC(),
-		X:     round.key.EDDSAPub.X(),
-		Y:     round.key.EDDSAPub.Y(),
+	var ok bool
+	if round.temp.dom == nil {
+		pk := edwards.PublicKey{
+			Curve: round.Params().EC(),
+			X:     round.key.EDDSAPub.X(),
+			Y:     round.key.EDDSAPub.Y(),
+		}
+		ok = edwards.Verify(&pk, round.temp.m, round.temp.r, s)
+	} else {
+		var sig [64]byte
+		copy(sig[:], round.data.Signature)
+		encodedPubKey := ecPointToEncodedBytes(round.key.EDDSAPub.X(), round.key.EDDSAPub.Y())
+		ok = verifyWithDom(encodedPubKey, round.temp.dom, round.temp.m, &sig)
 	}
-
-	ok := edwards.Verify(&pk, round.temp.m.Bytes(), round.temp.r, s)
 	if !ok {
 		return round.WrapError(fmt.Errorf("signature verification failed"))
 	}
@@ -60,6 +68,22 @@ func (round *finalization) Start() *tss.Error {
 	return nil
 }
 
+// verifyPartialSignature checks s_j*B == R_j + c*lambda_j*X_j, so that a bad s_j is pinned on Pj before it spoils
+// the signature
+func (round *finalization) verifyPartialSignature(j int, sj group.Scalar) error {
+	g := group.Ed25519()
+	Xj, err := round.key.BigXj[j].ToGroupPoint()
+	if err != nil {
+		return err
+	}
+	lambdaJ := lagrangeCoefficient(g, j, round.key.Ks)
+	expected := round.temp.bigRj[j].Add(Xj.ScalarMult(round.temp.c.Mul(lambdaJ)))
+	if !g.Generator().ScalarMult(sj).Equal(expected) {
+		return errors.New("partial signature verification failed")
+	}
+	return nil
+}
+
 func (round *finalization) CanAccept(msg tss.ParsedMessage) bool {
 	// not expecting any incoming messages in this round
 	return false
@@ -0,0 +1,74 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package signing
+
+import (
+	"crypto/elliptic"
+	"errors"
+	"math/big"
+
+	"github.com/bnb-chain/tss-lib/common"
+	"github.com/bnb-chain/tss-lib/crypto"
+	"github.com/bnb-chain/tss-lib/crypto/ckd"
+	"github.com/bnb-chain/tss-lib/eddsa/keygen"
+	"github.com/bnb-chain/tss-lib/tss"
+)
+
+// NewLocalPartyWithPath returns a party that signs with the child key at the given non-hardened path, e.g. "m/44/501/0/0",
+// derived from the public key and chain code in the save data with BIP32-Ed25519 public derivation.
+func NewLocalPartyWithPath(
+	msg *big.Int,
+	params *tss.Parameters,
+	key keygen.LocalPartySaveData,
+	path string,
+	out chan<- tss.Message,
+	end chan<- common.SignatureData,
+) (tss.Party, error) {
+	keyDerivationDelta, _, err := DeriveChildKeyFromPath(key, path)
+	if err != nil {
+		return nil, err
+	}
+	return NewLocalPartyWithKDD(msg, params, key, keyDerivationDelta, out, end), nil
+}
+
+// DeriveChildKeyFromPath returns the key derivation delta and the child public key at the given non-hardened path.
+// The delta can be passed to NewLocalPartyWithKDD to sign with the child key.
+func DeriveChildKeyFromPath(key keygen.LocalPartySaveData, path string) (*big.Int, *ckd.ExtendedKey, error) {
+	if key.EDDSAPub == nil {
+		return nil, nil, errors.New("the save data has no public key")
+	}
+	if len(key.ChainCode) != keygen.ChainCodeLen {
+		return nil, nil, errors.New("the save data has no chain code; it was created before chain codes were generated during keygen")
+	}
+	indices, err := ckd.ParseDerivationPath(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	master, err := ckd.NewMasterExtendedKey(key.EDDSAPub.ToECDSAPubKey(), key.ChainCode, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	return ckd.DeriveEd25519ChildKeyFromHierarchy(indices, master)
+}
+
+func updatePublicKeyAndAdjustBigXj(ec elliptic.Curve, keyDerivationDelta *big.Int, key *keygen.LocalPartySaveData) error {
+	var err error
+	gDelta := crypto.ScalarBaseMult(ec, keyDerivationDelta)
+	if key.EDDSAPub, err = key.EDDSAPub.Add(gDelta); err != nil {
+		common.Logger.Errorf("error creating new extended child public key")
+		return err
+	}
+	// Suppose X_j has shamir shares X_j0,     X_j1,     ..., X_jn
+	// So X_j + D has shamir shares  X_j0 + D, X_j1 + D, ..., X_jn + D
+	for j := range key.BigXj {
+		if key.BigXj[j], err = key.BigXj[j].Add(gDelta); err != nil {
+			common.Logger.Errorf("error in delta operation")
+			return err
+		}
+	}
+	return nil
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/bnb-chain/tss-lib/common"
 	"github.com/bnb-chain/tss-lib/crypto"
 	cmt "github.com/bnb-chain/tss-lib/crypto/commitments"
+	"github.com/bnb-chain/tss-lib/crypto/group"
 	"github.com/bnb-chain/tss-lib/eddsa/keygen"
 	"github.com/bnb-chain/tss-lib/tss"
 )
@@ -47,18 +48,23 @@ type (
 		localMessageStore
 
 		// temp data (thrown away after sign) / round 1
+		keyDerivationDelta *big.Int
+		xi,                // the share of this party, with the key derivation delta added when one is set
 		wi,
+		ri group.Scalar
 		m,
-		ri *big.Int
+		dom []byte
 		pointRi  *crypto.ECPoint
 		deCommit cmt.HashDeCommitment
 
 		// round 2
 		cjs []*big.Int
-		si  *[32]byte
+		si  group.Scalar
 
 		// round 3
-		r *big.Int
+		r     *big.Int
+		c     group.Scalar  // the challenge H(R || A || M)
+		bigRj []group.Point // every party's R_j, to check its s_j in finalization
 	}
 )
 
@@ -68,6 +74,67 @@ func NewLocalParty(
 	key keygen.LocalPartySaveData,
 	out chan<- tss.Message,
 	end chan<- common.SignatureData,
+) tss.Party {
+	return NewLocalPartyWithKDD(msg, params, key, nil, out, end)
+}
+
+// NewLocalPartyWithKDD returns a party with key derivation delta for HD support.
+// The party signs with the child key EDDSAPub + delta*G; EDDSAPub and BigXj are adjusted on its own copy of the key data.
+func NewLocalPartyWithKDD(
+	msg *big.Int,
+	params *tss.Parameters,
+	key keygen.LocalPartySaveData,
+	keyDerivationDelta *big.Int,
+	out chan<- tss.Message,
+	end chan<- common.SignatureData,
+) tss.Party {
+	return newLocalParty(msg.Bytes(), params, key, keyDerivationDelta, out, end)
+}
+
+// NewLocalPartyWithMessage returns a party that signs the raw message hashed with the given hash.
+// Use common.MessageHashNone for pure Ed25519, which hashes the full message internally; the message is kept
+// byte-for-byte, including any leading zeros, in the output SignatureData.M.
+func NewLocalPartyWithMessage(
+	msg []byte,
+	hash common.MessageHash,
+	params *tss.Parameters,
+	key keygen.LocalPartySaveData,
+	out chan<- tss.Message,
+	end chan<- common.SignatureData,
+) (tss.Party, error) {
+	digest, err := hash.Digest(msg)
+	if err != nil {
+		return nil, err
+	}
+	return newLocalParty(digest, params, key, nil, out, end), nil
+}
+
+// NewLocalPartyWithOptions returns a party that signs the raw message with the given RFC 8032 variant and context.
+// With Ed25519ph the output SignatureData.M is the SHA-512 pre-hash of the message, which is what gets signed.
+func NewLocalPartyWithOptions(
+	msg []byte,
+	opts Options,
+	params *tss.Parameters,
+	key keygen.LocalPartySaveData,
+	out chan<- tss.Message,
+	end chan<- common.SignatureData,
+) (tss.Party, error) {
+	dom, m, err := opts.prepare(msg)
+	if err != nil {
+		return nil, err
+	}
+	p := newLocalParty(m, params, key, nil, out, end).(*LocalParty)
+	p.temp.dom = dom
+	return p, nil
+}
+
+func newLocalParty(
+	msg []byte,
+	params *tss.Parameters,
+	key keygen.LocalPartySaveData,
+	keyDerivationDelta *big.Int,
+	out chan<- tss.Message,
+	end chan<- common.SignatureData,
 ) tss.Party {
 	partyCount := len(params.Parties().IDs())
 	p := &LocalParty{
@@ -85,6 +152,7 @@ func NewLocalParty(
 	p.temp.signRound3Messages = make([]tss.ParsedMessage, partyCount)
 
 	// temp data init
+	p.temp.keyDerivationDelta = keyDerivationDelta
 	p.temp.m = msg
 	p.temp.cjs = make([]*big.Int, partyCount)
 	return p
@@ -157,6 +225,12 @@ func (p *LocalParty) StoreMessage(msg tss.ParsedMessage) (bool, *tss.Error) {
 	return true, nil
 }
 
+// WipeTempData overwrites the share, the nonce and the additive share of the key. The partial signature si and R are kept as
+// they are public once broadcast.
+func (p *LocalParty) WipeTempData() {
+	group.WipeScalars(p.temp.xi, p.temp.wi, p.temp.ri)
+}
+
 func (p *LocalParty) PartyID() *tss.PartyID {
 	return p.params.PartyID()
 }
@@ -7,12 +7,14 @@
 package signing
 
 import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/sha512"
 	"fmt"
 	"math/big"
 	"sync/atomic"
 	"testing"
 
-	"github.com/agl/ed25519/edwards25519"
 	"github.com/decred/dcrd/dcrec/edwards/v2"
 	"github.com/ipfs/go-log"
 	"github.com/stretchr/testify/assert"
@@ -111,11 +113,9 @@ signing:
 						continue
 					}
 
-					var tmpSumS [32]byte
-					edwards25519.ScMulAdd(&tmpSumS, sumS, bigIntToEncodedBytes(big.NewInt(1)), p.temp.si)
-					sumS = &tmpSumS
+					sumS = sumS.Add(p.temp.si)
 				}
-				fmt.Printf("S: %s\n", encodedBytesToBigInt(sumS).String())
+				fmt.Printf("S: %s\n", sumS.BigInt().String())
 				fmt.Printf("R: %s\n", R.String())
 				// END check s correctness
 
@@ -142,3 +142,212 @@ signing:
 		}
 	}
 }
+
+func TestE2ESeededRunIsReproducible(t *testing.T) {
+	setUp("info")
+	keys, signPIDs, err := keygen.LoadKeygenTestFixtures(testThreshold + 1)
+	assert.NoError(t, err, "should load keygen fixtures")
+
+	run := func() []byte {
+		parties, _ := runSigning(t, signPIDs, func(i int, params *tss.Parameters, outCh chan tss.Message, endCh chan common.SignatureData) (tss.Party, error) {
+			params.SetRand(common.NewDeterministicRandom([]byte(fmt.Sprintf("signing-%d", i))))
+			return NewLocalParty(big.NewInt(200), params, keys[i], outCh, endCh), nil
+		}, nil)
+		return parties[0].data.GetSignature()
+	}
+	signature1, signature2 := run(), run()
+	assert.NotEmpty(t, signature1)
+	assert.Equal(t, signature1, signature2, "the signature should be identical across seeded runs")
+}
+
+func TestE2EWithHDKeyDerivation(t *testing.T) {
+	setUp("info")
+	keys, signPIDs, err := keygen.LoadKeygenTestFixturesRandomSet(testThreshold+1, testParticipants)
+	assert.NoError(t, err, "should load keygen fixtures")
+	chainCode := common.SHA512_256([]byte("chain code"))
+	for i := range keys {
+		keys[i].ChainCode = chainCode
+	}
+	path := "m/44/501/0/0"
+	_, childPk, err := DeriveChildKeyFromPath(keys[0], path)
+	assert.NoError(t, err)
+
+	msg := big.NewInt(200)
+	parties, _ := runSigning(t, signPIDs, func(i int, params *tss.Parameters, outCh chan tss.Message, endCh chan common.SignatureData) (tss.Party, error) {
+		return NewLocalPartyWithPath(msg, params, keys[i], path, outCh, endCh)
+	}, nil)
+
+	pk := edwards.PublicKey{
+		Curve: tss.Edwards(),
+		X:     childPk.X,
+		Y:     childPk.Y,
+	}
+	sig, err := edwards.ParseSignature(parties[0].data.Signature)
+	assert.NoError(t, err)
+	assert.True(t, edwards.Verify(&pk, msg.Bytes(), sig.R, sig.S), "eddsa verify with the child key must pass")
+	assert.False(t, keys[0].EDDSAPub.Equals(parties[0].keys.EDDSAPub), "the party should sign with the child key")
+}
+
+func TestE2EWithRawMessage(t *testing.T) {
+	setUp("info")
+	keys, signPIDs, err := keygen.LoadKeygenTestFixturesRandomSet(testThreshold+1, testParticipants)
+	assert.NoError(t, err, "should load keygen fixtures")
+
+	// leading zeros are part of the message and must survive signing
+	msg := append([]byte{0x00, 0x00}, []byte("raw message longer than the curve order: the quick brown fox jumps over the lazy dog")...)
+	parties, _ := runSigning(t, signPIDs, func(i int, params *tss.Parameters, outCh chan tss.Message, endCh chan common.SignatureData) (tss.Party, error) {
+		return NewLocalPartyWithMessage(msg, common.MessageHashNone, params, keys[i], outCh, endCh)
+	}, nil)
+
+	assert.Equal(t, msg, parties[0].data.GetM(), "the message should be preserved byte-for-byte")
+	pk := edwards.PublicKey{
+		Curve: tss.Edwards(),
+		X:     keys[0].EDDSAPub.X(),
+		Y:     keys[0].EDDSAPub.Y(),
+	}
+	sig, err := edwards.ParseSignature(parties[0].data.GetSignature())
+	assert.NoError(t, err)
+	assert.True(t, edwards.Verify(&pk, msg, sig.R, sig.S), "eddsa verify must pass")
+}
+
+func TestE2EWithVariants(t *testing.T) {
+	setUp("info")
+	keys, signPIDs, err := keygen.LoadKeygenTestFixturesRandomSet(testThreshold+1, testParticipants)
+	assert.NoError(t, err, "should load keygen fixtures")
+	msg := []byte("threshold Ed25519 variants")
+	pk := ed25519.PublicKey(ecPointToEncodedBytes(keys[0].EDDSAPub.X(), keys[0].EDDSAPub.Y())[:])
+
+	for _, opts := range []Options{
+		{Variant: Ed25519ctx, Context: []byte("tss-lib")},
+		{Variant: Ed25519ph},
+		{Variant: Ed25519ph, Context: []byte("tss-lib")},
+	} {
+		parties, _ := runSigning(t, signPIDs, func(i int, params *tss.Parameters, outCh chan tss.Message, endCh chan common.SignatureData) (tss.Party, error) {
+			return NewLocalPartyWithOptions(msg, opts, params, keys[i], outCh, endCh)
+		}, nil)
+
+		// verify against the standard library as the reference implementation
+		refOpts := &ed25519.Options{Context: string(opts.Context)}
+		signed := msg
+		if opts.Variant == Ed25519ph {
+			refOpts.Hash = crypto.SHA512
+			digest := sha512.Sum512(msg)
+			signed = digest[:]
+		}
+		assert.Equal(t, signed, parties[0].data.GetM())
+		err := ed25519.VerifyWithOptions(pk, signed, parties[0].data.GetSignature(), refOpts)
+		assert.NoError(t, err, "%s signature should verify", opts.Variant)
+	}
+}
+
+func TestNewLocalPartyRejectsDisqualifiedParty(t *testing.T) {
+	setUp("info")
+	keys, signPIDs, err := keygen.LoadKeygenTestFixtures(3)
+	assert.NoError(t, err, "should load keygen fixtures")
+	outCh := make(chan tss.Message, len(signPIDs))
+	endCh := make(chan common.SignatureData, len(signPIDs))
+
+	// the key of P1 as robust keygen saves it after P0 was disqualified
+	key := keygen.BuildLocalSaveDataSubset(keys[1], signPIDs[1:])
+	key.DisqualifiedKs = []*big.Int{signPIDs[0].KeyInt()}
+
+	params := tss.NewParameters(tss.Edwards(), tss.NewPeerContext(signPIDs), signPIDs[1], len(signPIDs), 1)
+	assert.Panics(t, func() { NewLocalParty(big.NewInt(42), params, key, outCh, endCh) }, "P0 should not sign")
+	params = tss.NewParameters(tss.Edwards(), tss.NewPeerContext(signPIDs[1:]), signPIDs[1], len(signPIDs)-1, 1)
+	assert.NotPanics(t, func() { NewLocalParty(big.NewInt(42), params, key, outCh, endCh) })
+}
+
+func TestE2EBadPartialSignatureIsAttributed(t *testing.T) {
+	setUp("info")
+	keys, signPIDs, err := keygen.LoadKeygenTestFixturesRandomSet(testThreshold+1, testParticipants)
+	assert.NoError(t, err, "should load keygen fixtures")
+
+	// P0 broadcasts a wrong s_0 in round 3, which everyone else must pin on it
+	_, errs := runSigning(t, signPIDs, func(i int, params *tss.Parameters, outCh chan tss.Message, endCh chan common.SignatureData) (tss.Party, error) {
+		return NewLocalParty(big.NewInt(42), params, keys[i], outCh, endCh), nil
+	}, func(msg tss.Message) tss.Message {
+		if r3msg, ok := msg.(tss.ParsedMessage).Content().(*SignRound3Message); ok && msg.GetFrom().Index == 0 {
+			return NewSignRound3Message(msg.GetFrom(), new(big.Int).Add(r3msg.UnmarshalS(), big.NewInt(1)))
+		}
+		return msg
+	})
+	assert.Equal(t, len(signPIDs)-1, len(errs), "every other party should abort")
+	for _, err := range errs {
+		assert.Equal(t, 4, err.Round())
+		assert.Equal(t, []*tss.PartyID{signPIDs[0]}, err.Culprits())
+	}
+}
+
+func TestE2EHedgedNoncesWithStuckRNG(t *testing.T) {
+	setUp("info")
+	keys, signPIDs, err := keygen.LoadKeygenTestFixtures(testThreshold + 1)
+	assert.NoError(t, err, "should load keygen fixtures")
+
+	// every run starts each party from the same RNG state, as with a broken RNG or a restored VM snapshot. without
+	// hedging, signing another message would then reuse the nonces and reveal the key
+	sign := func(m *big.Int) []byte {
+		parties, _ := runSigning(t, signPIDs, func(i int, params *tss.Parameters, outCh chan tss.Message, endCh chan common.SignatureData) (tss.Party, error) {
+			params.SetRand(common.NewDeterministicRandom([]byte(fmt.Sprintf("stuck-%d", i))))
+			return NewLocalParty(m, params, keys[i], outCh, endCh), nil
+		}, nil)
+		return parties[0].data.GetR()
+	}
+	assert.NotEqual(t, sign(big.NewInt(42)), sign(big.NewInt(43)), "R should not repeat for another message")
+}
+
+// runSigning runs signing with a party made by newParty for each of signPIDs, routing the messages until every party
+// has ended or failed. A party that fails fails the test, unless tamper is given to replace messages before they are
+// delivered; the errors are then returned.
+func runSigning(
+	t *testing.T,
+	signPIDs tss.SortedPartyIDs,
+	newParty func(i int, params *tss.Parameters, outCh chan tss.Message, endCh chan common.SignatureData) (tss.Party, error),
+	tamper func(tss.Message) tss.Message,
+) ([]*LocalParty, []*tss.Error) {
+	p2pCtx := tss.NewPeerContext(signPIDs)
+	parties := make([]*LocalParty, 0, len(signPIDs))
+	errCh := make(chan *tss.Error, len(signPIDs))
+	outCh := make(chan tss.Message, len(signPIDs))
+	endCh := make(chan common.SignatureData, len(signPIDs))
+	for i := range signPIDs {
+		params := tss.NewParameters(tss.Edwards(), p2pCtx, signPIDs[i], len(signPIDs), testThreshold)
+		P, err := newParty(i, params, outCh, endCh)
+		if !assert.NoError(t, err) {
+			t.FailNow()
+		}
+		parties = append(parties, P.(*LocalParty))
+		go func(P tss.Party) {
+			if err := P.Start(); err != nil {
+				errCh <- err
+			}
+		}(P)
+	}
+
+	var errs []*tss.Error
+	for ended := 0; ended+len(errs) < len(signPIDs); {
+		select {
+		case err := <-errCh:
+			if tamper == nil {
+				assert.FailNow(t, err.Error())
+			}
+			errs = append(errs, err)
+		case msg := <-outCh:
+			if tamper != nil {
+				msg = tamper(msg)
+			}
+			dest := msg.GetTo()
+			if dest == nil {
+				for _, P := range parties {
+					if P.PartyID().Index != msg.GetFrom().Index {
+						go test.SharedPartyUpdater(P, msg, errCh)
+					}
+				}
+			} else {
+				go test.SharedPartyUpdater(parties[dest[0].Index], msg, errCh)
+			}
+		case <-endCh:
+			ended++
+		}
+	}
+	return parties, errs
+}
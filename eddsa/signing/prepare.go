@@ -11,12 +11,20 @@ import (
 	"fmt"
 	"math/big"
 
-	"github.com/bnb-chain/tss-lib/common"
+	"github.com/bnb-chain/tss-lib/crypto/group"
 )
 
 // PrepareForSigning(), Fig. 7
 func PrepareForSigning(ec elliptic.Curve, i, pax int, xi *big.Int, ks []*big.Int) (wi *big.Int) {
-	modQ := common.ModInt(ec.Params().N)
+	g, ok := group.FromCurve(ec)
+	if !ok {
+		panic(fmt.Errorf("PrepareForSigning: unsupported curve %s", ec.Params().Name))
+	}
+	return prepareForSigning(g, i, pax, g.ScalarFromBigInt(xi), ks).BigInt()
+}
+
+// prepareForSigning is PrepareForSigning for a share that is kept as a scalar of the group of the curve
+func prepareForSigning(g group.Group, i, pax int, xi group.Scalar, ks []*big.Int) (wi group.Scalar) {
 	if len(ks) != pax {
 		panic(fmt.Errorf("PrepareForSigning: len(ks) != pax (%d != %d)", len(ks), pax))
 	}
@@ -25,20 +33,26 @@ func PrepareForSigning(ec elliptic.Curve, i, pax int, xi *big.Int, ks []*big.Int
 	}
 
 	// 1-4.
-	wi = xi
-	for j := 0; j < pax; j++ {
+	wi = lagrangeCoefficient(g, i, ks).Mul(xi)
+
+	return
+}
+
+// lagrangeCoefficient returns the product of ks[j] / (ks[j] - ks[i]) over j != i, which is computed with a single
+// constant-time inversion.
+func lagrangeCoefficient(g group.Group, i int, ks []*big.Int) group.Scalar {
+	ksi := g.ScalarFromBigInt(ks[i])
+	num, den := g.ScalarFromBigInt(big.NewInt(1)), g.ScalarFromBigInt(big.NewInt(1))
+	for j := range ks {
 		if j == i {
 			continue
 		}
-		ksj := ks[j]
-		ksi := ks[i]
-		if ksj.Cmp(ksi) == 0 {
+		ksj := g.ScalarFromBigInt(ks[j])
+		if ksj.Equal(ksi) {
 			panic(fmt.Errorf("index of two parties are equal"))
 		}
-		// big.Int Div is calculated as: a/b = a * modInv(b,q)
-		coef := modQ.Mul(ks[j], modQ.ModInverse(new(big.Int).Sub(ksj, ksi)))
-		wi = modQ.Mul(wi, coef)
+		num = num.Mul(ksj)
+		den = den.Mul(ksj.Sub(ksi))
 	}
-
-	return
+	return num.Mul(den.Invert())
 }
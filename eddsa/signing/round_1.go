@@ -13,6 +13,7 @@ import (
 	"github.com/bnb-chain/tss-lib/common"
 	"github.com/bnb-chain/tss-lib/crypto"
 	"github.com/bnb-chain/tss-lib/crypto/commitments"
+	"github.com/bnb-chain/tss-lib/crypto/group"
 	"github.com/bnb-chain/tss-lib/eddsa/keygen"
 	"github.com/bnb-chain/tss-lib/tss"
 )
@@ -33,11 +34,11 @@ func (round *round1) Start() *tss.Error {
 	round.resetOK()
 
 	// 1. select ri
-	ri := common.GetRandomPositiveInt(round.Params().EC().Params().N)
+	ri := round.nonce(group.Ed25519(), "r")
 
 	// 2. make commitment
-	pointRi := crypto.ScalarBaseMult(round.Params().EC(), ri)
-	cmt := commitments.NewHashCommitment(pointRi.X(), pointRi.Y())
+	pointRi := crypto.ScalarBaseMultScalar(round.Params().EC(), ri)
+	cmt := commitments.NewHashCommitmentWithRandom(round.Rand(), pointRi.X(), pointRi.Y())
 
 	// 3. store r1 message pieces
 	round.temp.ri = ri
@@ -86,13 +87,27 @@ func (round *round1) NextRound() tss.Round {
 func (round *round1) prepare() error {
 	i := round.PartyID().Index
 
-	xi := round.key.Xi
 	ks := round.key.Ks
 
+	g := group.Ed25519()
+	xi := g.ScalarFromBigInt(round.key.Xi)
+	if round.temp.keyDerivationDelta != nil {
+		// adding the key derivation delta to the xi's
+		// Suppose x has shamir shares x_0,     x_1,     ..., x_n
+		// So x + D has shamir shares  x_0 + D, x_1 + D, ..., x_n + D
+		if err := updatePublicKeyAndAdjustBigXj(round.Params().EC(), round.temp.keyDerivationDelta, round.key); err != nil {
+			return err
+		}
+		derivedXi := g.ScalarFromBigInt(round.temp.keyDerivationDelta).Add(xi)
+		group.WipeScalars(xi)
+		xi = derivedXi
+	}
+	round.temp.xi = xi
+
 	if round.Threshold()+1 > len(ks) {
 		return fmt.Errorf("t+1=%d is not satisfied by the key count of %d", round.Threshold()+1, len(ks))
 	}
-	wi := PrepareForSigning(round.Params().EC(), i, len(ks), xi, ks)
+	wi := prepareForSigning(g, i, len(ks), xi, ks)
 
 	round.temp.wi = wi
 	return nil
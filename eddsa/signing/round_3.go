@@ -9,11 +9,11 @@ package signing
 import (
 	"crypto/sha512"
 
-	"github.com/agl/ed25519/edwards25519"
 	"github.com/pkg/errors"
 
 	"github.com/bnb-chain/tss-lib/crypto"
 	"github.com/bnb-chain/tss-lib/crypto/commitments"
+	"github.com/bnb-chain/tss-lib/crypto/group"
 	"github.com/bnb-chain/tss-lib/tss"
 )
 
@@ -27,12 +27,14 @@ func (round *round3) Start() *tss.Error {
 	round.resetOK()
 
 	// 1. init R
-	var R edwards25519.ExtendedGroupElement
-	riBytes := bigIntToEncodedBytes(round.temp.ri)
-	edwards25519.GeScalarMultBase(&R, riBytes)
+	g := group.Ed25519()
+	ri := round.temp.ri
+	R := g.Generator().ScalarMult(ri)
 
 	// 2-6. compute R
 	i := round.PartyID().Index
+	bigRj := make([]group.Point, len(round.Parties().IDs()))
+	bigRj[i] = R
 	for j, Pj := range round.Parties().IDs() {
 		if j == i {
 			continue
@@ -50,50 +52,54 @@ func (round *round3) Start() *tss.Error {
 		}
 
 		Rj, err := crypto.NewECPoint(round.Params().EC(), coordinates[0], coordinates[1])
-		Rj = Rj.EightInvEight()
 		if err != nil {
 			return round.WrapError(errors.Wrapf(err, "NewECPoint(Rj)"), Pj)
 		}
+		Rj = Rj.ClearTorsion()
 		proof, err := r2msg.UnmarshalZKProof(round.Params().EC())
 		if err != nil {
 			return round.WrapError(errors.New("failed to unmarshal Rj proof"), Pj)
 		}
-		ok = proof.Verify(Rj)
+		ok = proof.Verify(round.transcript(Pj), Rj)
 		if !ok {
 			return round.WrapError(errors.New("failed to prove Rj"), Pj)
 		}
 
-		extendedRj := ecPointToExtendedElement(round.Params().EC(), Rj.X(), Rj.Y())
-		R = addExtendedElements(R, extendedRj)
+		groupRj, err := Rj.ToGroupPoint()
+		if err != nil {
+			return round.WrapError(errors.Wrapf(err, "ToGroupPoint(Rj)"), Pj)
+		}
+		bigRj[j] = groupRj
+		R = R.Add(groupRj)
 	}
 
 	// 7. compute lambda
-	var encodedR [32]byte
-	R.ToBytes(&encodedR)
+	encodedR := R.Bytes()
 	encodedPubKey := ecPointToEncodedBytes(round.key.EDDSAPub.X(), round.key.EDDSAPub.Y())
 
-	// h = hash512(k || A || M)
+	// h = hash512(dom2(F, C) || k || A || M), where dom2 is empty for pure Ed25519
 	h := sha512.New()
 	h.Reset()
-	h.Write(encodedR[:])
+	h.Write(round.temp.dom)
+	h.Write(encodedR)
 	h.Write(encodedPubKey[:])
-	h.Write(round.temp.m.Bytes())
-
-	var lambda [64]byte
-	h.Sum(lambda[:0])
-	var lambdaReduced [32]byte
-	edwards25519.ScReduce(&lambdaReduced, &lambda)
+	h.Write(round.temp.m)
+	lambda, err := g.ScalarFromUniformBytes(h.Sum(nil))
+	if err != nil {
+		return round.WrapError(err)
+	}
 
-	// 8. compute si
-	var localS [32]byte
-	edwards25519.ScMulAdd(&localS, &lambdaReduced, bigIntToEncodedBytes(round.temp.wi), riBytes)
+	// 8. compute si = lambda * wi + ri
+	localS := lambda.Mul(round.temp.wi).Add(ri)
 
 	// 9. store r3 message pieces
-	round.temp.si = &localS
-	round.temp.r = encodedBytesToBigInt(&encodedR)
+	round.temp.si = localS
+	round.temp.r = encodedBytesToBigInt(copyBytes(encodedR))
+	round.temp.c = lambda
+	round.temp.bigRj = bigRj
 
 	// 10. broadcast si to other parties
-	r3msg := NewSignRound3Message(round.PartyID(), encodedBytesToBigInt(&localS))
+	r3msg := NewSignRound3Message(round.PartyID(), localS.BigInt())
 	round.temp.signRound3Messages[round.PartyID().Index] = r3msg
 	round.out <- r3msg
 
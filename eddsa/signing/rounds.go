@@ -8,6 +8,8 @@ package signing
 
 import (
 	"github.com/bnb-chain/tss-lib/common"
+	"github.com/bnb-chain/tss-lib/crypto/group"
+	"github.com/bnb-chain/tss-lib/crypto/transcript"
 	"github.com/bnb-chain/tss-lib/eddsa/keygen"
 	"github.com/bnb-chain/tss-lib/tss"
 )
@@ -91,6 +93,37 @@ func (round *base) WrapError(err error, culprits ...*tss.PartyID) *tss.Error {
 
 // ----- //
 
+// transcript returns the Fiat-Shamir transcript for a proof made by `prover`, or nil if the legacy proofs are used
+func (round *base) transcript(prover *tss.PartyID) *transcript.Transcript {
+	return transcript.ForProver(round.Params(), TaskName, prover)
+}
+
+// nonce returns a secret nonce in [1, q) for the value named `label`. Unless hedged nonces are disabled, the randomness
+// is hedged with a PRF of the party's share, the message, the session ID and the signing parties, so that a weak or
+// repeated RNG state does not repeat the nonce for another message or session.
+func (round *base) nonce(g group.Group, label string) group.Scalar {
+	q := round.Params().EC().Params().N
+	if !round.HedgedNonces() {
+		k := common.GetRandomPositiveIntWithRandom(round.Rand(), q)
+		defer common.WipeInts(k)
+		return g.ScalarFromBigInt(k)
+	}
+	t := transcript.New(TaskName + " nonce")
+	t.AppendMessage("session", round.SessionID())
+	curveName, _ := tss.GetCurveName(round.Params().EC())
+	t.AppendMessage("curve", []byte(curveName))
+	for _, Pj := range round.Parties().IDs() {
+		t.AppendPartyID("party", Pj)
+	}
+	t.AppendPartyID("signer", round.PartyID())
+	t.AppendMessage("message", round.temp.m)
+	t.AppendMessage("dom", round.temp.dom)
+	xi := round.temp.xi.BigInt()
+	k := t.WitnessInt(label, xi, round.Rand(), q)
+	defer common.WipeInts(xi, k)
+	return g.ScalarFromBigInt(k)
+}
+
 // `ok` tracks parties which have been verified by Update()
 func (round *base) resetOK() {
 	for j := range round.ok {
@@ -7,12 +7,7 @@
 package signing
 
 import (
-	"crypto/elliptic"
 	"math/big"
-
-	"github.com/agl/ed25519/edwards25519"
-
-	"github.com/bnb-chain/tss-lib/common"
 )
 
 func encodedBytesToBigInt(s *[32]byte) *big.Int {
@@ -70,12 +65,9 @@ func copyBytes(aB []byte) *[32]byte {
 
 func ecPointToEncodedBytes(x *big.Int, y *big.Int) *[32]byte {
 	s := bigIntToEncodedBytes(y)
-	xB := bigIntToEncodedBytes(x)
-	xFE := new(edwards25519.FieldElement)
-	edwards25519.FeFromBytes(xFE, xB)
-	isNegative := edwards25519.FeIsNegative(xFE) == 1
 
-	if isNegative {
+	// x is "negative" if its canonical encoding is odd (RFC 8032, section 5.1.2)
+	if x.Bit(0) == 1 {
 		s[31] |= (1 << 7)
 	} else {
 		s[31] &^= (1 << 7)
@@ -89,40 +81,3 @@ func reverse(s *[32]byte) {
 		s[i], s[j] = s[j], s[i]
 	}
 }
-
-func addExtendedElements(p, q edwards25519.ExtendedGroupElement) edwards25519.ExtendedGroupElement {
-	var r edwards25519.CompletedGroupElement
-	var qCached edwards25519.CachedGroupElement
-	q.ToCached(&qCached)
-	edwards25519.GeAdd(&r, &p, &qCached)
-	var result edwards25519.ExtendedGroupElement
-	r.ToExtended(&result)
-	return result
-}
-
-func ecPointToExtendedElement(ec elliptic.Curve, x *big.Int, y *big.Int) edwards25519.ExtendedGroupElement {
-	encodedXBytes := bigIntToEncodedBytes(x)
-	encodedYBytes := bigIntToEncodedBytes(y)
-
-	z := common.GetRandomPositiveInt(ec.Params().N)
-	encodedZBytes := bigIntToEncodedBytes(z)
-
-	var fx, fy, fxy edwards25519.FieldElement
-	edwards25519.FeFromBytes(&fx, encodedXBytes)
-	edwards25519.FeFromBytes(&fy, encodedYBytes)
-
-	var X, Y, Z, T edwards25519.FieldElement
-	edwards25519.FeFromBytes(&Z, encodedZBytes)
-
-	edwards25519.FeMul(&X, &fx, &Z)
-	edwards25519.FeMul(&Y, &fy, &Z)
-	edwards25519.FeMul(&fxy, &fx, &fy)
-	edwards25519.FeMul(&T, &fxy, &Z)
-
-	return edwards25519.ExtendedGroupElement{
-		X: X,
-		Y: Y,
-		Z: Z,
-		T: T,
-	}
-}
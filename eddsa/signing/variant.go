@@ -0,0 +1,111 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package signing
+
+import (
+	"crypto/sha512"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+
+	"github.com/bnb-chain/tss-lib/crypto/group"
+)
+
+// Variant selects the RFC 8032 Ed25519 signature scheme
+type Variant int
+
+const (
+	// Ed25519 is pure Ed25519, which hashes the full message while signing
+	Ed25519 Variant = iota
+	// Ed25519ctx is Ed25519 with a non-empty context string for domain separation
+	Ed25519ctx
+	// Ed25519ph is the pre-hashed variant, which signs SHA-512(message) with an optional context string
+	Ed25519ph
+)
+
+const (
+	// dom2 prefix, RFC 8032 section 5.1
+	dom2Prefix    = "SigEd25519 no Ed25519 collisions"
+	maxContextLen = 255
+)
+
+// Options selects the RFC 8032 variant of the signature and its context string
+type Options struct {
+	Variant Variant
+	// Context is the context string of Ed25519ctx (required) and Ed25519ph (optional), at most 255 bytes
+	Context []byte
+}
+
+func (v Variant) String() string {
+	switch v {
+	case Ed25519:
+		return "Ed25519"
+	case Ed25519ctx:
+		return "Ed25519ctx"
+	case Ed25519ph:
+		return "Ed25519ph"
+	default:
+		return fmt.Sprintf("Variant(%d)", int(v))
+	}
+}
+
+// prepare returns the dom2 prefix of the variant (nil for pure Ed25519) and the message bytes to sign
+func (opts Options) prepare(msg []byte) (dom, m []byte, err error) {
+	if maxContextLen < len(opts.Context) {
+		return nil, nil, fmt.Errorf("the context must be at most %d bytes", maxContextLen)
+	}
+	switch opts.Variant {
+	case Ed25519:
+		if len(opts.Context) != 0 {
+			return nil, nil, errors.New("pure Ed25519 does not take a context; use Ed25519ctx")
+		}
+		return nil, msg, nil
+	case Ed25519ctx:
+		if len(opts.Context) == 0 {
+			return nil, nil, errors.New("Ed25519ctx requires a non-empty context")
+		}
+		return dom2(0, opts.Context), msg, nil
+	case Ed25519ph:
+		digest := sha512.Sum512(msg)
+		return dom2(1, opts.Context), digest[:], nil
+	default:
+		return nil, nil, fmt.Errorf("unknown variant %s", opts.Variant)
+	}
+}
+
+func dom2(phFlag byte, context []byte) []byte {
+	dom := make([]byte, 0, len(dom2Prefix)+2+len(context))
+	dom = append(dom, dom2Prefix...)
+	dom = append(dom, phFlag, byte(len(context)))
+	return append(dom, context...)
+}
+
+// verifyWithDom verifies an RFC 8032 signature whose hash is prefixed with dom: [S]B = R + [k]A
+func verifyWithDom(publicKey *[32]byte, dom, message []byte, sig *[64]byte) bool {
+	g := group.Ed25519()
+	A, err := g.PointFromBytes(publicKey[:])
+	if err != nil {
+		return false
+	}
+	S, err := g.ScalarFromBytes(sig[32:])
+	if err != nil {
+		return false
+	}
+
+	h := sha512.New()
+	h.Write(dom)
+	h.Write(sig[:32])
+	h.Write(publicKey[:])
+	h.Write(message)
+	k, err := g.ScalarFromUniformBytes(h.Sum(nil))
+	if err != nil {
+		return false
+	}
+
+	checkR := g.Generator().ScalarMult(S).Sub(A.ScalarMult(k))
+	return subtle.ConstantTimeCompare(sig[:32], checkR.Bytes()) == 1
+}
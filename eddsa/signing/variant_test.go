@@ -0,0 +1,77 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package signing
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// test vectors from RFC 8032, sections 7.2 and 7.3
+var rfc8032Vectors = []struct {
+	name      string
+	opts      Options
+	publicKey string
+	message   string
+	signature string
+}{
+	{
+		name:      "Ed25519ctx foo",
+		opts:      Options{Variant: Ed25519ctx, Context: []byte("foo")},
+		publicKey: "dfc9425e4f968f7f0c29f0259cf5f9aed6851c2bb4ad8bfb860cfee0ab248292",
+		message:   "f726936d19c800494e3fdaff20b276a8",
+		signature: "55a4cc2f70a54e04288c5f4cd1e45a7bb520b36292911876cada7323198dd87a8b36950b95130022907a7fb7c4e9b2d5f6cca685a587b4b21f4b888e4e7edb0d",
+	},
+	{
+		name:      "Ed25519ph abc",
+		opts:      Options{Variant: Ed25519ph},
+		publicKey: "ec172b93ad5e563bf4932c70e1245034c35467ef2efd4d64ebf819683467e2bf",
+		message:   "616263",
+		signature: "98a70222f0b8121aa9d30f813d683f809e462b469c7ff87639499bb94e6dae4131f85042463c2a355a2003d062adf5aaa10b8c61e636062aaad11c2a26083406",
+	},
+}
+
+func TestVerifyWithDomRFC8032Vectors(t *testing.T) {
+	for _, vector := range rfc8032Vectors {
+		var publicKey [32]byte
+		var sig [64]byte
+		pkBz, _ := hex.DecodeString(vector.publicKey)
+		sigBz, _ := hex.DecodeString(vector.signature)
+		msg, _ := hex.DecodeString(vector.message)
+		copy(publicKey[:], pkBz)
+		copy(sig[:], sigBz)
+
+		dom, m, err := vector.opts.prepare(msg)
+		assert.NoError(t, err)
+		assert.True(t, verifyWithDom(&publicKey, dom, m, &sig), "%s should verify", vector.name)
+
+		// a different context must not verify
+		wrongOpts := vector.opts
+		wrongOpts.Context = []byte("bar")
+		dom, m, err = wrongOpts.prepare(msg)
+		assert.NoError(t, err)
+		assert.False(t, verifyWithDom(&publicKey, dom, m, &sig), "%s should not verify with another context", vector.name)
+	}
+}
+
+func TestOptionsPrepare(t *testing.T) {
+	_, _, err := Options{Variant: Ed25519ctx}.prepare([]byte("msg"))
+	assert.Error(t, err, "Ed25519ctx requires a context")
+	_, _, err = Options{Variant: Ed25519, Context: []byte("foo")}.prepare([]byte("msg"))
+	assert.Error(t, err, "pure Ed25519 does not take a context")
+	_, _, err = Options{Variant: Ed25519ph, Context: make([]byte, 256)}.prepare([]byte("msg"))
+	assert.Error(t, err, "the context is at most 255 bytes")
+	_, _, err = Options{Variant: Variant(42)}.prepare([]byte("msg"))
+	assert.Error(t, err)
+
+	dom, m, err := Options{Variant: Ed25519}.prepare([]byte("msg"))
+	assert.NoError(t, err)
+	assert.Nil(t, dom)
+	assert.Equal(t, []byte("msg"), m)
+}
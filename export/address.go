@@ -0,0 +1,177 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package export
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"strings"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcutil/base58"
+	"golang.org/x/crypto/ripemd160"
+	"golang.org/x/crypto/sha3"
+
+	"github.com/bnb-chain/tss-lib/crypto"
+)
+
+const (
+	// CosmosHRP is the bech32 human-readable part of Cosmos Hub account addresses; other Cosmos SDK chains use their own.
+	CosmosHRP = "cosmos"
+
+	segwitV0 = 0
+	segwitV1 = 1
+
+	taprootTweakTag = "TapTweak"
+)
+
+// BitcoinP2PKH returns the base58check pay-to-pubkey-hash address of the compressed secp256k1 public key,
+// e.g. for &chaincfg.MainNetParams or &chaincfg.TestNet3Params.
+func BitcoinP2PKH(pub *crypto.ECPoint, net *chaincfg.Params) (string, error) {
+	if net == nil {
+		return "", errors.New("the network parameters must not be nil")
+	}
+	key, err := secp256k1Compressed(pub)
+	if err != nil {
+		return "", err
+	}
+	return base58.CheckEncode(hash160(key), net.PubKeyHashAddrID), nil
+}
+
+// BitcoinP2WPKH returns the bech32 native segwit v0 pay-to-witness-pubkey-hash address of the compressed secp256k1 public key.
+func BitcoinP2WPKH(pub *crypto.ECPoint, net *chaincfg.Params) (string, error) {
+	if net == nil {
+		return "", errors.New("the network parameters must not be nil")
+	}
+	key, err := secp256k1Compressed(pub)
+	if err != nil {
+		return "", err
+	}
+	return bech32Encode(net.Bech32HRPSegwit, segwitV0, hash160(key), false)
+}
+
+// BitcoinP2TR returns the bech32m segwit v1 taproot address of the secp256k1 public key used as the internal key,
+// committing to no script path (BIP-86). The output key is P + hash_TapTweak(x(P))G with P of even y (BIP-341);
+// spending it requires a BIP-340 Schnorr signature by the tweaked key.
+func BitcoinP2TR(pub *crypto.ECPoint, net *chaincfg.Params) (string, error) {
+	if net == nil {
+		return "", errors.New("the network parameters must not be nil")
+	}
+	outputKey, err := TaprootOutputKey(pub)
+	if err != nil {
+		return "", err
+	}
+	return bech32Encode(net.Bech32HRPSegwit, segwitV1, outputKey, true)
+}
+
+// TaprootOutputKey returns the 32-byte x-only BIP-86 taproot output key of the secp256k1 internal public key.
+func TaprootOutputKey(pub *crypto.ECPoint) ([]byte, error) {
+	if err := checkSecp256k1(pub); err != nil {
+		return nil, err
+	}
+	ec := pub.Curve()
+	N, P := ec.Params().N, ec.Params().P
+	internalX := pub.X().FillBytes(make([]byte, 32))
+	internal := pub
+	if pub.Y().Bit(0) == 1 {
+		internal = crypto.NewECPointNoCurveCheck(ec, pub.X(), new(big.Int).Sub(P, pub.Y()))
+	}
+	t := new(big.Int).SetBytes(taggedHash(taprootTweakTag, internalX))
+	if t.Cmp(N) >= 0 {
+		return nil, errors.New("the taproot tweak is not a valid scalar")
+	}
+	outputKey, err := internal.Add(crypto.ScalarBaseMult(ec, t))
+	if err != nil {
+		return nil, err
+	}
+	return outputKey.X().FillBytes(make([]byte, 32)), nil
+}
+
+// EthereumAddress returns the EIP-55 mixed-case checksummed address of the secp256k1 public key.
+func EthereumAddress(pub *crypto.ECPoint) (string, error) {
+	if err := checkSecp256k1(pub); err != nil {
+		return "", err
+	}
+	key, err := SEC1Uncompressed(pub)
+	if err != nil {
+		return "", err
+	}
+	return EIP55Checksum(keccak256(key[1:])[12:]), nil
+}
+
+// EIP55Checksum returns the "0x"-prefixed EIP-55 mixed-case hex encoding of a 20-byte Ethereum address.
+func EIP55Checksum(address []byte) string {
+	lower := hex.EncodeToString(address)
+	hash := keccak256([]byte(lower))
+	var sb strings.Builder
+	sb.WriteString("0x")
+	for i, c := range lower {
+		nibble := hash[i/2] >> 4
+		if i%2 == 1 {
+			nibble = hash[i/2] & 0x0f
+		}
+		if c >= 'a' && nibble >= 8 {
+			c -= 'a' - 'A'
+		}
+		sb.WriteRune(c)
+	}
+	return sb.String()
+}
+
+// CosmosAddress returns the bech32 account address, RIPEMD160(SHA256(compressed public key)), of the secp256k1
+// public key with the given human-readable part, e.g. CosmosHRP.
+func CosmosAddress(pub *crypto.ECPoint, hrp string) (string, error) {
+	key, err := secp256k1Compressed(pub)
+	if err != nil {
+		return "", err
+	}
+	return bech32Encode(hrp, -1, hash160(key), false)
+}
+
+// SolanaAddress returns the base58 encoding of the Ed25519 public key, which is the Solana account address.
+func SolanaAddress(pub *crypto.ECPoint) (string, error) {
+	key, err := Ed25519(pub)
+	if err != nil {
+		return "", err
+	}
+	return base58.Encode(key), nil
+}
+
+// ----- //
+
+func secp256k1Compressed(pub *crypto.ECPoint) ([]byte, error) {
+	if err := checkSecp256k1(pub); err != nil {
+		return nil, err
+	}
+	return (*btcec.PublicKey)(pub.ToECDSAPubKey()).SerializeCompressed(), nil
+}
+
+func hash160(buf []byte) []byte {
+	sha := sha256.Sum256(buf)
+	hasher := ripemd160.New()
+	hasher.Write(sha[:])
+	return hasher.Sum(nil)
+}
+
+func keccak256(buf []byte) []byte {
+	hasher := sha3.NewLegacyKeccak256()
+	hasher.Write(buf)
+	return hasher.Sum(nil)
+}
+
+// taggedHash is the BIP-340 tagged hash SHA256(SHA256(tag) || SHA256(tag) || msg)
+func taggedHash(tag string, msg []byte) []byte {
+	tagHash := sha256.Sum256([]byte(tag))
+	hasher := sha256.New()
+	hasher.Write(tagHash[:])
+	hasher.Write(tagHash[:])
+	hasher.Write(msg)
+	return hasher.Sum(nil)
+}
@@ -0,0 +1,150 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package export_test
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcutil"
+	"github.com/btcsuite/btcutil/base58"
+	"github.com/btcsuite/btcutil/bech32"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bnb-chain/tss-lib/crypto"
+	"github.com/bnb-chain/tss-lib/crypto/ckd"
+	"github.com/bnb-chain/tss-lib/ecdsa/keygen"
+	. "github.com/bnb-chain/tss-lib/export"
+	"github.com/bnb-chain/tss-lib/tss"
+	"github.com/bnb-chain/tss-lib/verify"
+)
+
+// generatorPub is the secp256k1 public key of the private key 1
+func generatorPub() *crypto.ECPoint {
+	return crypto.ScalarBaseMult(tss.S256(), big.NewInt(1))
+}
+
+func TestBitcoinAddresses(t *testing.T) {
+	pub := generatorPub()
+	p2pkh, err := BitcoinP2PKH(pub, &chaincfg.MainNetParams)
+	assert.NoError(t, err)
+	assert.Equal(t, "1BgGZ9tcN4rm9KBzDn7KprQz87SZ26SAMH", p2pkh)
+
+	// BIP-173 example address
+	p2wpkh, err := BitcoinP2WPKH(pub, &chaincfg.MainNetParams)
+	assert.NoError(t, err)
+	assert.Equal(t, "bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4", p2wpkh)
+	p2wpkh, err = BitcoinP2WPKH(pub, &chaincfg.TestNet3Params)
+	assert.NoError(t, err)
+	assert.Equal(t, "tb1qw508d6qejxtdg4y5r3zarvary0c5xw7kxpjzsx", p2wpkh)
+
+	_, err = BitcoinP2PKH(crypto.ScalarBaseMult(tss.P256(), big.NewInt(1)), &chaincfg.MainNetParams)
+	assert.Error(t, err, "a P-256 key should be rejected")
+}
+
+func TestBitcoinP2TR(t *testing.T) {
+	// BIP-86 test vector for m/86'/0'/0'/0/0
+	internalX, _ := hex.DecodeString("cc8a4bc64d897bddc5fbc2f670f7a8ba0b386779106cf1223c6fc5d7cd6fc115")
+	for _, prefix := range []byte{0x02, 0x03} {
+		// the internal key is x-only, so both y parities yield the same output key
+		pub, err := verify.ParseECDSAPublicKey(tss.S256(), append([]byte{prefix}, internalX...))
+		assert.NoError(t, err)
+		outputKey, err := TaprootOutputKey(pub)
+		assert.NoError(t, err)
+		assert.Equal(t, "a60869f0dbcf1dc659c9cecbaf8050135ea9e8cdc487053f1dc6880949dc684c", hex.EncodeToString(outputKey))
+		address, err := BitcoinP2TR(pub, &chaincfg.MainNetParams)
+		assert.NoError(t, err)
+		assert.Equal(t, "bc1p5cyxnuxmeuwuvkwfem96lqzszd02n6xdcjrs20cac6yqjjwudpxqkedrcr", address)
+	}
+}
+
+func TestEthereumAddress(t *testing.T) {
+	address, err := EthereumAddress(generatorPub())
+	assert.NoError(t, err)
+	assert.Equal(t, "0x7E5F4552091A69125d5DfCb7b8C2659029395Bdf", address)
+
+	// EIP-55 test vectors
+	for _, expected := range []string{
+		"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed",
+		"0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359",
+		"0xdbF03B407c01E7cD3CBea99509d93f8DDDC8C6FB",
+		"0xD1220A0cf47c7B9Be7A2E6BA89F429762e7b9aDb",
+	} {
+		bz, err := hex.DecodeString(expected[2:])
+		assert.NoError(t, err)
+		assert.Equal(t, expected, EIP55Checksum(bz))
+	}
+}
+
+func TestCosmosAddress(t *testing.T) {
+	pub := generatorPub()
+	address, err := CosmosAddress(pub, CosmosHRP)
+	assert.NoError(t, err)
+
+	// cross-check with the BIP-173 bech32 decoder
+	hrp, data, err := bech32.Decode(address)
+	assert.NoError(t, err)
+	assert.Equal(t, CosmosHRP, hrp)
+	hash, err := bech32.ConvertBits(data, 5, 8, false)
+	assert.NoError(t, err)
+	compressed, err := SEC1Compressed(pub)
+	assert.NoError(t, err)
+	assert.Equal(t, btcutil.Hash160(compressed), hash)
+}
+
+func TestSolanaAddress(t *testing.T) {
+	// RFC 8032 test 1 public key
+	key, _ := hex.DecodeString("d75a980182b10ab7d54bfed3c964073a0ee172f3daa62325af021a68f707511a")
+	pub, err := verify.ParseEdDSAPublicKey(key)
+	assert.NoError(t, err)
+	address, err := SolanaAddress(pub)
+	assert.NoError(t, err)
+	assert.Equal(t, key, base58.Decode(address))
+
+	// the all-zero key is the Solana system program id
+	zero, err := verify.ParseEdDSAPublicKey(make([]byte, 32))
+	assert.NoError(t, err)
+	address, err = SolanaAddress(zero)
+	assert.NoError(t, err)
+	assert.Equal(t, "11111111111111111111111111111111", address)
+
+	_, err = SolanaAddress(generatorPub())
+	assert.Error(t, err, "a secp256k1 key should be rejected")
+}
+
+func TestAddressesFromSaveData(t *testing.T) {
+	// BIP-32 test vector 1
+	master, err := ckd.NewExtendedKeyFromString("xpub661MyMwAqRbcFtXgS5sYJABqqG9YLmC4Q1Rdap9gSE8NqtwybGhePY2gZ29ESFjqJoCu1Rupje8YtGqsefD265TMg7usUDFdp6W1EGMcet8", tss.S256())
+	assert.NoError(t, err)
+	save := keygen.NewLocalPartySaveData(1)
+	save.ECDSAPub, err = FromExtendedKey(master)
+	assert.NoError(t, err)
+	save.ChainCode = master.ChainCode
+
+	address, err := BitcoinP2PKH(save.ECDSAPub, &chaincfg.MainNetParams)
+	assert.NoError(t, err)
+	assert.Equal(t, "15mKKb2eos1hWa6tisdPwwDC1a5J1y9nma", address)
+
+	// an HD child key exports to the same address as the corresponding BIP-32 extended public key
+	child, err := save.ExtendedPublicKey("m/0/1/2/2")
+	assert.NoError(t, err)
+	childPub, err := FromExtendedKey(child)
+	assert.NoError(t, err)
+	address, err = BitcoinP2PKH(childPub, &chaincfg.MainNetParams)
+	assert.NoError(t, err)
+	expected, err := ckd.NewExtendedKeyFromString("xpub6FHUhLbYYkgFQiFrDiXRfQFXBB2msCxKTsNyAExi6keFxQ8sHfwpogY3p3s1ePSpUqLNYks5T6a3JqpCGszt4kxbyq7tUoFP5c8KWyiDtPp", tss.S256())
+	assert.NoError(t, err)
+	expectedPub, err := FromExtendedKey(expected)
+	assert.NoError(t, err)
+	expectedCompressed, err := SEC1Compressed(expectedPub)
+	assert.NoError(t, err)
+	expectedP2PKH, err := btcutil.NewAddressPubKeyHash(btcutil.Hash160(expectedCompressed), &chaincfg.MainNetParams)
+	assert.NoError(t, err)
+	assert.Equal(t, expectedP2PKH.EncodeAddress(), address)
+}
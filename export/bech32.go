@@ -0,0 +1,88 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package export
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/btcsuite/btcutil/bech32"
+)
+
+// The bech32 package of btcutil predates BIP-350, so the checksum is computed here to also support bech32m,
+// which is used by Bitcoin segwit version 1+ (taproot) addresses.
+
+const (
+	bech32Charset = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+
+	bech32Const  = 1
+	bech32mConst = 0x2bc830a3
+)
+
+// bech32Encode encodes 8-bit data as a bech32 (BIP-173) or bech32m (BIP-350) string, optionally prefixed by a witness
+// version which is not converted to 5-bit groups.
+func bech32Encode(hrp string, witnessVersion int, data []byte, bech32m bool) (string, error) {
+	if hrp == "" {
+		return "", errors.New("the human-readable part must not be empty")
+	}
+	converted, err := bech32.ConvertBits(data, 8, 5, true)
+	if err != nil {
+		return "", err
+	}
+	values := converted
+	if witnessVersion >= 0 {
+		values = append([]byte{byte(witnessVersion)}, converted...)
+	}
+	constant := uint32(bech32Const)
+	if bech32m {
+		constant = bech32mConst
+	}
+	hrp = strings.ToLower(hrp)
+	checksum := bech32Checksum(hrp, values, constant)
+
+	var sb strings.Builder
+	sb.WriteString(hrp)
+	sb.WriteByte('1')
+	for _, v := range append(values, checksum...) {
+		sb.WriteByte(bech32Charset[v])
+	}
+	return sb.String(), nil
+}
+
+func bech32Checksum(hrp string, values []byte, constant uint32) []byte {
+	expanded := make([]byte, 0, 2*len(hrp)+1+len(values)+6)
+	for i := 0; i < len(hrp); i++ {
+		expanded = append(expanded, hrp[i]>>5)
+	}
+	expanded = append(expanded, 0)
+	for i := 0; i < len(hrp); i++ {
+		expanded = append(expanded, hrp[i]&31)
+	}
+	expanded = append(expanded, values...)
+	expanded = append(expanded, 0, 0, 0, 0, 0, 0)
+	polymod := bech32Polymod(expanded) ^ constant
+	checksum := make([]byte, 6)
+	for i := range checksum {
+		checksum[i] = byte((polymod >> uint(5*(5-i))) & 31)
+	}
+	return checksum
+}
+
+func bech32Polymod(values []byte) uint32 {
+	gen := [5]uint32{0x3b6a57b2, 0x26508e6d, 0x1ea119fa, 0x3d4233dd, 0x2a1462b3}
+	chk := uint32(1)
+	for _, v := range values {
+		top := chk >> 25
+		chk = (chk&0x1ffffff)<<5 ^ uint32(v)
+		for i := 0; i < 5; i++ {
+			if (top>>uint(i))&1 == 1 {
+				chk ^= gen[i]
+			}
+		}
+	}
+	return chk
+}
@@ -0,0 +1,221 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+// Package export serializes the public key of a threshold key, e.g. LocalPartySaveData.ECDSAPub or EDDSAPub or an
+// HD-derived child key, into the public key and address formats used by other software and by common chains.
+package export
+
+import (
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+
+	"github.com/decred/dcrd/dcrec/edwards/v2"
+
+	"github.com/bnb-chain/tss-lib/crypto"
+	"github.com/bnb-chain/tss-lib/crypto/ckd"
+	"github.com/bnb-chain/tss-lib/tss"
+)
+
+const (
+	pemBlockType = "PUBLIC KEY"
+
+	jwkKeyTypeEC  = "EC"
+	jwkKeyTypeOKP = "OKP"
+)
+
+var (
+	oidPublicKeyECDSA   = asn1.ObjectIdentifier{1, 2, 840, 10045, 2, 1}
+	oidPublicKeyEd25519 = asn1.ObjectIdentifier{1, 3, 101, 112}
+	oidNamedCurveS256   = asn1.ObjectIdentifier{1, 3, 132, 0, 10}
+	oidNamedCurveP256   = asn1.ObjectIdentifier{1, 2, 840, 10045, 3, 1, 7}
+)
+
+type (
+	// JWK is a JSON Web Key (RFC 7517) holding a public key; the curve names are those of RFC 7518, RFC 8037 and RFC 8812.
+	JWK struct {
+		Kty string `json:"kty"`
+		Crv string `json:"crv"`
+		X   string `json:"x"`
+		Y   string `json:"y,omitempty"`
+	}
+
+	subjectPublicKeyInfo struct {
+		Algorithm algorithmIdentifier
+		PublicKey asn1.BitString
+	}
+
+	algorithmIdentifier struct {
+		Algorithm  asn1.ObjectIdentifier
+		Parameters asn1.ObjectIdentifier `asn1:"optional"`
+	}
+)
+
+// FromExtendedKey returns the public key of an extended key, e.g. one derived with ckd.DeriveChildKeyFromPath,
+// LocalPartySaveData.ExtendedPublicKey or the EdDSA signing.DeriveChildKeyFromPath.
+func FromExtendedKey(key *ckd.ExtendedKey) (*crypto.ECPoint, error) {
+	if key == nil || key.Curve == nil {
+		return nil, errors.New("the extended key has no public key")
+	}
+	return crypto.NewECPoint(key.Curve, key.X, key.Y)
+}
+
+// SEC1Compressed returns the 33-byte SEC1 compressed encoding of a secp256k1 or P-256 public key.
+func SEC1Compressed(pub *crypto.ECPoint) ([]byte, error) {
+	if _, err := weierstrassCurveName(pub); err != nil {
+		return nil, err
+	}
+	byteLen := (pub.Curve().Params().BitSize + 7) / 8
+	bz := make([]byte, 1+byteLen)
+	bz[0] = 0x02 | byte(pub.Y().Bit(0))
+	pub.X().FillBytes(bz[1:])
+	return bz, nil
+}
+
+// SEC1Uncompressed returns the 65-byte SEC1 uncompressed encoding of a secp256k1 or P-256 public key.
+func SEC1Uncompressed(pub *crypto.ECPoint) ([]byte, error) {
+	if _, err := weierstrassCurveName(pub); err != nil {
+		return nil, err
+	}
+	byteLen := (pub.Curve().Params().BitSize + 7) / 8
+	bz := make([]byte, 1+2*byteLen)
+	bz[0] = 0x04
+	pub.X().FillBytes(bz[1 : 1+byteLen])
+	pub.Y().FillBytes(bz[1+byteLen:])
+	return bz, nil
+}
+
+// Ed25519 returns the 32-byte RFC 8032 encoding of an Ed25519 public key.
+func Ed25519(pub *crypto.ECPoint) ([]byte, error) {
+	if err := checkEd25519(pub); err != nil {
+		return nil, err
+	}
+	return edwards.NewPublicKey(pub.X(), pub.Y()).Serialize(), nil
+}
+
+// PKIX returns the DER-encoded X.509 SubjectPublicKeyInfo of a secp256k1, P-256 or Ed25519 public key.
+// Unlike x509.MarshalPKIXPublicKey it supports secp256k1.
+func PKIX(pub *crypto.ECPoint) ([]byte, error) {
+	var spki subjectPublicKeyInfo
+	if isEd25519(pub) {
+		key, err := Ed25519(pub)
+		if err != nil {
+			return nil, err
+		}
+		spki.Algorithm.Algorithm = oidPublicKeyEd25519
+		spki.PublicKey = asn1.BitString{Bytes: key, BitLength: 8 * len(key)}
+		return asn1.Marshal(spki)
+	}
+	name, err := weierstrassCurveName(pub)
+	if err != nil {
+		return nil, err
+	}
+	key, err := SEC1Uncompressed(pub)
+	if err != nil {
+		return nil, err
+	}
+	spki.Algorithm.Algorithm = oidPublicKeyECDSA
+	spki.Algorithm.Parameters = oidNamedCurveS256
+	if name == tss.Secp256r1 {
+		spki.Algorithm.Parameters = oidNamedCurveP256
+	}
+	spki.PublicKey = asn1.BitString{Bytes: key, BitLength: 8 * len(key)}
+	return asn1.Marshal(spki)
+}
+
+// PEM returns the PKIX public key in a PEM "PUBLIC KEY" block.
+func PEM(pub *crypto.ECPoint) ([]byte, error) {
+	der, err := PKIX(pub)
+	if err != nil {
+		return nil, err
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: pemBlockType, Bytes: der}), nil
+}
+
+// NewJWK returns the JSON Web Key of a secp256k1, P-256 or Ed25519 public key. Marshal it with encoding/json.
+func NewJWK(pub *crypto.ECPoint) (*JWK, error) {
+	if isEd25519(pub) {
+		key, err := Ed25519(pub)
+		if err != nil {
+			return nil, err
+		}
+		return &JWK{Kty: jwkKeyTypeOKP, Crv: "Ed25519", X: base64.RawURLEncoding.EncodeToString(key)}, nil
+	}
+	name, err := weierstrassCurveName(pub)
+	if err != nil {
+		return nil, err
+	}
+	crv := "secp256k1"
+	if name == tss.Secp256r1 {
+		crv = "P-256"
+	}
+	key, err := SEC1Uncompressed(pub)
+	if err != nil {
+		return nil, err
+	}
+	byteLen := (len(key) - 1) / 2
+	return &JWK{
+		Kty: jwkKeyTypeEC,
+		Crv: crv,
+		X:   base64.RawURLEncoding.EncodeToString(key[1 : 1+byteLen]),
+		Y:   base64.RawURLEncoding.EncodeToString(key[1+byteLen:]),
+	}, nil
+}
+
+// JWKJSON returns the JSON encoding of NewJWK(pub).
+func JWKJSON(pub *crypto.ECPoint) ([]byte, error) {
+	jwk, err := NewJWK(pub)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(jwk)
+}
+
+// ----- //
+
+// weierstrassCurveName checks that the public key is a valid secp256k1 or P-256 point and returns its curve name
+func weierstrassCurveName(pub *crypto.ECPoint) (tss.CurveName, error) {
+	if pub == nil || !pub.ValidateBasic() {
+		return "", errors.New("the public key is not a valid curve point")
+	}
+	name, ok := tss.GetCurveName(pub.Curve())
+	if !ok || (name != tss.Secp256k1 && name != tss.Secp256r1) {
+		return "", fmt.Errorf("the public key must be on secp256k1 or P-256, got %s", pub.Curve().Params().Name)
+	}
+	return name, nil
+}
+
+func checkSecp256k1(pub *crypto.ECPoint) error {
+	name, err := weierstrassCurveName(pub)
+	if err != nil {
+		return err
+	}
+	if name != tss.Secp256k1 {
+		return errors.New("the public key must be on secp256k1")
+	}
+	return nil
+}
+
+func checkEd25519(pub *crypto.ECPoint) error {
+	if pub == nil || !pub.ValidateBasic() {
+		return errors.New("the public key is not a valid curve point")
+	}
+	if !isEd25519(pub) {
+		return errors.New("the public key must be on Ed25519")
+	}
+	return nil
+}
+
+func isEd25519(pub *crypto.ECPoint) bool {
+	if pub == nil || pub.Curve() == nil {
+		return false
+	}
+	name, ok := tss.GetCurveName(pub.Curve())
+	return ok && name == tss.Ed25519
+}
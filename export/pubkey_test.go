@@ -0,0 +1,123 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package export_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bnb-chain/tss-lib/crypto"
+	. "github.com/bnb-chain/tss-lib/export"
+	"github.com/bnb-chain/tss-lib/tss"
+	"github.com/bnb-chain/tss-lib/verify"
+)
+
+func TestSEC1(t *testing.T) {
+	pub := generatorPub()
+	compressed, err := SEC1Compressed(pub)
+	assert.NoError(t, err)
+	assert.Equal(t, "0279be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798", hex.EncodeToString(compressed))
+	uncompressed, err := SEC1Uncompressed(pub)
+	assert.NoError(t, err)
+	assert.Equal(t, "0479be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798"+
+		"483ada7726a3c4655da4fbfc0e1108a8fd17b448a68554199c47d08ffb10d4b8", hex.EncodeToString(uncompressed))
+
+	// round trip of a P-256 key with an odd y
+	for {
+		sk, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		assert.NoError(t, err)
+		if sk.Y.Bit(0) == 0 {
+			continue
+		}
+		pub, err := crypto.NewECPoint(tss.P256(), sk.X, sk.Y)
+		assert.NoError(t, err)
+		compressed, err := SEC1Compressed(pub)
+		assert.NoError(t, err)
+		assert.Equal(t, elliptic.MarshalCompressed(elliptic.P256(), sk.X, sk.Y), compressed)
+		parsed, err := verify.ParseECDSAPublicKey(tss.P256(), compressed)
+		assert.NoError(t, err)
+		assert.True(t, parsed.Equals(pub))
+		break
+	}
+
+	_, err = SEC1Compressed(crypto.ScalarBaseMult(tss.Edwards(), big.NewInt(1)))
+	assert.Error(t, err, "an Ed25519 key should be rejected")
+}
+
+func TestPKIXAndPEM(t *testing.T) {
+	// secp256k1 is unsupported by crypto/x509, so check the known SubjectPublicKeyInfo prefix
+	der, err := PKIX(generatorPub())
+	assert.NoError(t, err)
+	uncompressed, err := SEC1Uncompressed(generatorPub())
+	assert.NoError(t, err)
+	assert.Equal(t, "3056301006072a8648ce3d020106052b8104000a034200"+hex.EncodeToString(uncompressed), hex.EncodeToString(der))
+
+	sk, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+	pub, err := crypto.NewECPoint(tss.P256(), sk.X, sk.Y)
+	assert.NoError(t, err)
+	expected, err := x509.MarshalPKIXPublicKey(&sk.PublicKey)
+	assert.NoError(t, err)
+	der, err = PKIX(pub)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, der)
+
+	edPub, _, err := ed25519.GenerateKey(rand.Reader)
+	assert.NoError(t, err)
+	pub, err = verify.ParseEdDSAPublicKey(edPub)
+	assert.NoError(t, err)
+	pemBytes, err := PEM(pub)
+	assert.NoError(t, err)
+	block, _ := pem.Decode(pemBytes)
+	assert.NotNil(t, block)
+	assert.Equal(t, "PUBLIC KEY", block.Type)
+	parsed, err := x509.ParsePKIXPublicKey(block.Bytes)
+	assert.NoError(t, err)
+	assert.Equal(t, edPub, parsed)
+}
+
+func TestJWK(t *testing.T) {
+	bz, err := JWKJSON(generatorPub())
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"kty":"EC","crv":"secp256k1","x":"eb5mfvncu6xVoGKVzocLBwKb_NstzijZWfKBWxb4F5g","y":"SDradyajxGVdpPv8DhEIqP0XtEimhVQZnEfQj_sQ1Lg"}`, string(bz))
+
+	// RFC 8037 appendix A.2
+	key, _ := hex.DecodeString("d75a980182b10ab7d54bfed3c964073a0ee172f3daa62325af021a68f707511a")
+	pub, err := verify.ParseEdDSAPublicKey(key)
+	assert.NoError(t, err)
+	bz, err = JWKJSON(pub)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"kty":"OKP","crv":"Ed25519","x":"11qYAYKxCrfVS_7TyWQHOg7hcvPapiMlrwIaaPcHURo"}`, string(bz))
+
+	sk, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+	pub, err = crypto.NewECPoint(tss.P256(), sk.X, sk.Y)
+	assert.NoError(t, err)
+	bz, err = JWKJSON(pub)
+	assert.NoError(t, err)
+	var jwk JWK
+	assert.NoError(t, json.Unmarshal(bz, &jwk))
+	assert.Equal(t, "P-256", jwk.Crv)
+	x, err := base64.RawURLEncoding.DecodeString(jwk.X)
+	assert.NoError(t, err)
+	y, err := base64.RawURLEncoding.DecodeString(jwk.Y)
+	assert.NoError(t, err)
+	assert.Len(t, x, 32)
+	assert.Equal(t, 0, sk.X.Cmp(new(big.Int).SetBytes(x)))
+	assert.Equal(t, 0, sk.Y.Cmp(new(big.Int).SetBytes(y)))
+}
@@ -19,6 +19,7 @@ type CurveName string
 
 const (
 	Secp256k1 CurveName = "secp256k1"
+	Secp256r1 CurveName = "secp256r1"
 	Ed25519   CurveName = "ed25519"
 )
 
@@ -33,6 +34,7 @@ func init() {
 
 	registry = make(map[CurveName]elliptic.Curve)
 	registry[Secp256k1] = s256k1.S256()
+	registry[Secp256r1] = elliptic.P256()
 	registry[Ed25519] = edwards.Edwards()
 }
 
@@ -79,6 +81,11 @@ func S256() elliptic.Curve {
 	return s256k1.S256()
 }
 
+// NIST P-256 (secp256r1), e.g. for WebAuthn and cloud KMS compatible keys
+func P256() elliptic.Curve {
+	return elliptic.P256()
+}
+
 func Edwards() elliptic.Curve {
 	return edwards.Edwards()
 }
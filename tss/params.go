@@ -8,6 +8,8 @@ package tss
 
 import (
 	"crypto/elliptic"
+	"crypto/rand"
+	"io"
 	"runtime"
 	"time"
 )
@@ -21,6 +23,13 @@ type (
 		threshold           int
 		concurrency         int
 		safePrimeGenTimeout time.Duration
+		rand                io.Reader
+		skipLowS            bool
+		sessionID           []byte
+		legacyProofs        bool
+		pedersenDKG         bool
+		robustKeygen        bool
+		hedgedNonces        bool
 	}
 
 	ReSharingParameters struct {
@@ -45,6 +54,8 @@ func NewParameters(ec elliptic.Curve, ctx *PeerContext, partyID *PartyID, partyC
 		threshold:           threshold,
 		concurrency:         runtime.GOMAXPROCS(0),
 		safePrimeGenTimeout: defaultSafePrimeGenTimeout,
+		rand:                rand.Reader,
+		hedgedNonces:        true,
 	}
 }
 
@@ -76,6 +87,41 @@ func (params *Parameters) SafePrimeGenTimeout() time.Duration {
 	return params.safePrimeGenTimeout
 }
 
+// Rand returns the source of randomness used by the protocol rounds, `crypto/rand.Reader` by default.
+func (params *Parameters) Rand() io.Reader {
+	return params.rand
+}
+
+// SkipLowS reports whether ECDSA signing should leave S as computed instead of normalizing it to the lower half of the curve order.
+func (params *Parameters) SkipLowS() bool {
+	return params.skipLowS
+}
+
+// SessionID returns the session ID bound into the Fiat-Shamir transcripts of the proofs, empty by default.
+func (params *Parameters) SessionID() []byte {
+	return params.sessionID
+}
+
+// LegacyProofs reports whether the proofs derive their challenges without transcripts, as earlier versions did.
+func (params *Parameters) LegacyProofs() bool {
+	return params.legacyProofs
+}
+
+// PedersenDKG reports whether keygen shares the secrets with Pedersen VSS (GJKR) instead of Feldman VSS.
+func (params *Parameters) PedersenDKG() bool {
+	return params.pedersenDKG
+}
+
+// RobustKeygen reports whether keygen resolves failed share verifications with complaints instead of aborting.
+func (params *Parameters) RobustKeygen() bool {
+	return params.robustKeygen
+}
+
+// HedgedNonces reports whether signing hedges the randomness of its nonces with the party's secret share, enabled by default.
+func (params *Parameters) HedgedNonces() bool {
+	return params.hedgedNonces
+}
+
 // The concurrency level must be >= 1.
 func (params *Parameters) SetConcurrency(concurrency int) {
 	params.concurrency = concurrency
@@ -85,6 +131,57 @@ func (params *Parameters) SetSafePrimeGenTimeout(timeout time.Duration) {
 	params.safePrimeGenTimeout = timeout
 }
 
+// SetRand replaces the source of randomness used by the protocol rounds.
+// A deterministic source (see common.NewDeterministicRandom) makes a run reproducible; use it in tests only.
+func (params *Parameters) SetRand(rand io.Reader) {
+	params.rand = rand
+}
+
+// SetSkipLowS disables the low-S normalization of ECDSA signatures, for chains that do not require it.
+// The encoders on common.SignatureData for chains that do require it normalize S themselves.
+func (params *Parameters) SetSkipLowS(skip bool) {
+	params.skipLowS = skip
+}
+
+// SetSessionID sets the ID of the session, which is bound into the proofs so that they cannot be replayed in another
+// session. It should be the session ID agreed upon by the parties for the transport, and must be the same for all of them.
+func (params *Parameters) SetSessionID(ssid []byte) {
+	params.sessionID = ssid
+}
+
+// SetLegacyProofs makes the proofs derive their challenges as earlier versions did, without binding the protocol, the
+// session, the curve or the prover, for wire compatibility with parties running those versions.
+// ECDSA signing then also skips the checks that those versions cannot take part in: the partial signature s_j of a
+// party that does not send l_j is not checked against its V_j, so a bad one only shows when the final signature fails
+// to verify, and when U != T the signing aborts without identifying the party that caused it.
+func (params *Parameters) SetLegacyProofs(legacy bool) {
+	params.legacyProofs = legacy
+}
+
+// SetPedersenDKG makes keygen run the Pedersen DKG of Gennaro, Jarecki, Krawczyk and Rabin: the dealers are qualified
+// by checking their shares against hiding Pedersen commitments before they open their Feldman commitments, in three
+// more rounds after round 2 (and the complaint rounds), and the polynomial of a qualified dealer that does not open
+// matching ones is rebuilt in public instead of being left out, so that the joint public key is uniformly distributed
+// even if some parties are malicious. It must be set on all the parties.
+func (params *Parameters) SetPedersenDKG(pedersen bool) {
+	params.pedersenDKG = pedersen
+}
+
+// SetRobustKeygen makes keygen run two complaint rounds after round 2 instead of aborting when a share fails to verify:
+// the victims publish complaints, the accused dealers reveal the disputed shares and the dealers proven to cheat are
+// left out of the key. It must be set on all the parties.
+func (params *Parameters) SetRobustKeygen(robust bool) {
+	params.robustKeygen = robust
+}
+
+// SetHedgedNonces sets whether signing derives its nonces from fresh randomness mixed with a PRF of the party's secret
+// share, the message, the session ID and the signing parties, so that a weak or repeated RNG state on one host does not
+// repeat a nonce for another message and leak the share. Disabling it takes the nonces from the RNG alone, as earlier
+// versions did.
+func (params *Parameters) SetHedgedNonces(hedged bool) {
+	params.hedgedNonces = hedged
+}
+
 // ----- //
 
 // Exported, used in `tss` client
@@ -126,22 +223,24 @@ func (rgParams *ReSharingParameters) OldAndNewPartyCount() int {
 	return rgParams.OldPartyCount() + rgParams.NewPartyCount()
 }
 
+// IsOldCommittee reports whether this party holds a share of the old committee. A party may be in both committees.
 func (rgParams *ReSharingParameters) IsOldCommittee() bool {
-	partyID := rgParams.partyID
-	for _, Pj := range rgParams.parties.IDs() {
-		if partyID.KeyInt().Cmp(Pj.KeyInt()) == 0 {
-			return true
-		}
-	}
-	return false
+	return rgParams.OldPartyIndex() >= 0
 }
 
+// IsNewCommittee reports whether this party receives a share as a member of the new committee.
 func (rgParams *ReSharingParameters) IsNewCommittee() bool {
-	partyID := rgParams.partyID
-	for _, Pj := range rgParams.newParties.IDs() {
-		if partyID.KeyInt().Cmp(Pj.KeyInt()) == 0 {
-			return true
-		}
-	}
-	return false
+	return rgParams.NewPartyIndex() >= 0
+}
+
+// OldPartyIndex returns the index of this party in the old committee, or -1 if it is not a member of it.
+// A party in both committees has a different index in each, which its PartyID cannot carry, so the resharing rounds
+// use these indexes rather than PartyID().Index.
+func (rgParams *ReSharingParameters) OldPartyIndex() int {
+	return rgParams.parties.IDs().FindIndexByKey(rgParams.partyID.KeyInt())
+}
+
+// NewPartyIndex returns the index of this party in the new committee, or -1 if it is not a member of it.
+func (rgParams *ReSharingParameters) NewPartyIndex() int {
+	return rgParams.newParties.IDs().FindIndexByKey(rgParams.partyID.KeyInt())
 }
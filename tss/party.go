@@ -29,18 +29,33 @@ type Party interface {
 	WrapError(err error, culprits ...*PartyID) *Error
 	PartyID() *PartyID
 	String() string
+	// WipeTempData overwrites the secret intermediate values of the protocol. BaseStart and BaseUpdate call it when the
+	// protocol finishes or fails, and Abort calls it when the caller abandons the party.
+	WipeTempData()
 
 	// Private lifecycle methods
 	setRound(Round) *Error
 	round() Round
 	advance()
+	end(partyState)
+	getState() partyState
 	lock()
 	unlock()
 }
 
+// partyState records whether a party has ended, since its round is nil both before it starts and after it ends
+type partyState int
+
+const (
+	stateRunning  partyState = iota // not started, or running
+	stateFinished                   // the protocol finished; late messages are still accepted and ignored
+	stateFailed                     // the protocol failed or the party was aborted
+)
+
 type BaseParty struct {
 	mtx        sync.Mutex
 	rnd        Round
+	state      partyState
 	FirstRound Round
 }
 
@@ -78,7 +93,14 @@ func (p *BaseParty) ValidateMessage(msg ParsedMessage) (bool, *Error) {
 	return true, nil
 }
 
+// WipeTempData is a no-op for parties that keep no secret intermediate values
+func (p *BaseParty) WipeTempData() {}
+
 func (p *BaseParty) String() string {
+	if p.rnd == nil {
+		// not started, finished or failed
+		return "round: none"
+	}
 	return fmt.Sprintf("round: %d", p.round().RoundNumber())
 }
 
@@ -101,6 +123,15 @@ func (p *BaseParty) advance() {
 	p.rnd = p.rnd.NextRound()
 }
 
+func (p *BaseParty) end(state partyState) {
+	p.rnd = nil
+	p.state = state
+}
+
+func (p *BaseParty) getState() partyState {
+	return p.state
+}
+
 func (p *BaseParty) lock() {
 	p.mtx.Lock()
 }
@@ -117,6 +148,9 @@ func BaseStart(p Party, task string, prepare ...func(Round) *Error) *Error {
 	if p.PartyID() == nil || !p.PartyID().ValidateBasic() {
 		return p.WrapError(fmt.Errorf("could not start. this party has an invalid PartyID: %+v", p.PartyID()))
 	}
+	if p.getState() != stateRunning {
+		return p.WrapError(errors.New("could not start. this party has already finished, failed or been aborted. use the constructor and Start()"))
+	}
 	if p.round() != nil {
 		return p.WrapError(errors.New("could not start. this party is in an unexpected state. use the constructor and Start()"))
 	}
@@ -129,14 +163,19 @@ func BaseStart(p Party, task string, prepare ...func(Round) *Error) *Error {
 	}
 	if len(prepare) == 1 {
 		if err := prepare[0](round); err != nil {
+			fail(p)
 			return err
 		}
 	}
-	common.Logger.Infof("party %s: %s round %d starting", p.round().Params().PartyID(), task, 1)
+	common.Logger.Infof("party %s: %s round %d starting", round.Params().PartyID(), task, 1)
 	defer func() {
-		common.Logger.Debugf("party %s: %s round %d finished", p.round().Params().PartyID(), task, 1)
+		common.Logger.Debugf("party %s: %s round %d finished", round.Params().PartyID(), task, 1)
 	}()
-	return p.round().Start()
+	if err := round.Start(); err != nil {
+		fail(p)
+		return err
+	}
+	return nil
 }
 
 // an implementation of Update that is shared across the different types of parties (keygen, signing, dynamic groups)
@@ -151,6 +190,9 @@ func BaseUpdate(p Party, msg ParsedMessage, task string) (ok bool, err *Error) {
 		return ok, err
 	}
 	p.lock() // data is written to P state below
+	if p.getState() == stateFailed {
+		return r(false, p.WrapError(errors.New("this party has failed or been aborted")))
+	}
 	common.Logger.Debugf("party %s received message: %s", p.PartyID(), msg.String())
 	if p.round() != nil {
 		common.Logger.Debugf("party %s round %d update: %s", p.PartyID(), p.round().RoundNumber(), msg.String())
@@ -161,18 +203,23 @@ func BaseUpdate(p Party, msg ParsedMessage, task string) (ok bool, err *Error) {
 	if p.round() != nil {
 		common.Logger.Debugf("party %s: %s round %d update", p.round().Params().PartyID(), task, p.round().RoundNumber())
 		if _, err := p.round().Update(); err != nil {
+			fail(p)
 			return r(false, err)
 		}
 		if p.round().CanProceed() {
 			if p.advance(); p.round() != nil {
 				if err := p.round().Start(); err != nil {
+					fail(p)
 					return r(false, err)
 				}
 				rndNum := p.round().RoundNumber()
 				common.Logger.Infof("party %s: %s round %d started", p.round().Params().PartyID(), task, rndNum)
 			} else {
 				// finished! the round implementation will have sent the data through the `end` channel.
+				p.WipeTempData()
+				p.end(stateFinished)
 				common.Logger.Infof("party %s: %s finished!", p.PartyID(), task)
+				return r(true, nil)
 			}
 			p.unlock()                      // recursive so can't defer after return
 			return BaseUpdate(p, msg, task) // re-run round update or finish)
@@ -181,3 +228,19 @@ func BaseUpdate(p Party, msg ParsedMessage, task string) (ok bool, err *Error) {
 	}
 	return r(true, nil)
 }
+
+// Abort stops a party that the caller is abandoning before it has finished, wiping its secret intermediate values.
+// The party does not run any further round afterwards, and Start and Update return an error from then on, as they do
+// once a party has failed.
+func Abort(p Party) {
+	p.lock()
+	defer p.unlock()
+	fail(p)
+}
+
+// fail wipes the secret intermediate values of a party whose protocol has failed and ends it, so that no further
+// round runs on the wiped values. The caller must hold the party's lock.
+func fail(p Party) {
+	p.WipeTempData()
+	p.end(stateFailed)
+}
@@ -7,6 +7,7 @@
 package tss
 
 import (
+	"crypto/rand"
 	"fmt"
 	"math/big"
 	"sort"
@@ -80,7 +81,7 @@ func SortPartyIDs(ids UnSortedPartyIDs, startAt ...int) SortedPartyIDs {
 // GenerateTestPartyIDs generates a list of mock PartyIDs for tests
 func GenerateTestPartyIDs(count int, startAt ...int) SortedPartyIDs {
 	ids := make(UnSortedPartyIDs, 0, count)
-	key := common.MustGetRandomInt(256)
+	key := common.MustGetRandomIntWithRandom(rand.Reader, 256)
 	frm := 0
 	i := 0 // default `i`
 	if len(startAt) > 0 {
@@ -122,6 +123,17 @@ func (spids SortedPartyIDs) FindByKey(key *big.Int) *PartyID {
 	return nil
 }
 
+// FindIndexByKey returns the position in the list of the party with the given key, or -1 if it is not listed. Unlike the
+// Index of a PartyID, it stays correct for a party listed in two committees with a different position in each.
+func (spids SortedPartyIDs) FindIndexByKey(key *big.Int) int {
+	for j, pid := range spids {
+		if pid.KeyInt().Cmp(key) == 0 {
+			return j
+		}
+	}
+	return -1
+}
+
 func (spids SortedPartyIDs) Exclude(exclude *PartyID) SortedPartyIDs {
 	newSpIDs := make(SortedPartyIDs, 0, len(spids))
 	for _, pid := range spids {
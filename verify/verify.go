@@ -0,0 +1,216 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+// Package verify checks the signatures output by the threshold signing protocols without access to any key share,
+// e.g. in a service that only knows the public key.
+package verify
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"errors"
+	"math/big"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/decred/dcrd/dcrec/edwards/v2"
+
+	"github.com/bnb-chain/tss-lib/common"
+	"github.com/bnb-chain/tss-lib/crypto"
+)
+
+// ECDSA verifies an ECDSA signature over SignatureData.M (the signed digest) against the public key.
+// If the signature data has a recovery id, it must recover the same public key.
+func ECDSA(data *common.SignatureData, pub *crypto.ECPoint) error {
+	if pub == nil || !pub.ValidateBasic() {
+		return errors.New("the public key is not a valid curve point")
+	}
+	r, s, err := rs(data)
+	if err != nil {
+		return err
+	}
+	if !ecdsa.Verify(pub.ToECDSAPubKey(), data.GetM(), r, s) {
+		return errors.New("signature verification failed")
+	}
+	if len(data.GetSignatureRecovery()) == 0 {
+		return nil
+	}
+	recovered, err := RecoverECDSAPublicKey(pub.Curve(), data)
+	if err != nil {
+		return err
+	}
+	if !recovered.Equals(pub) {
+		return errors.New("the recovery id does not recover the public key")
+	}
+	return nil
+}
+
+// ECDSAWithPublicKeyBytes verifies an ECDSA signature against a SEC1 compressed or uncompressed public key
+func ECDSAWithPublicKeyBytes(ec elliptic.Curve, data *common.SignatureData, pubKey []byte) error {
+	pub, err := ParseECDSAPublicKey(ec, pubKey)
+	if err != nil {
+		return err
+	}
+	return ECDSA(data, pub)
+}
+
+// EdDSA verifies a pure Ed25519 signature over SignatureData.M against the public key
+func EdDSA(data *common.SignatureData, pub *crypto.ECPoint) error {
+	if pub == nil || !pub.ValidateBasic() {
+		return errors.New("the public key is not a valid curve point")
+	}
+	sig, err := edwards.ParseSignature(data.GetSignature())
+	if err != nil {
+		return err
+	}
+	pk := edwards.PublicKey{
+		Curve: edwards.Edwards(),
+		X:     pub.X(),
+		Y:     pub.Y(),
+	}
+	if !edwards.Verify(&pk, data.GetM(), sig.R, sig.S) {
+		return errors.New("signature verification failed")
+	}
+	return nil
+}
+
+// EdDSAWithPublicKeyBytes verifies a pure Ed25519 signature against a 32-byte encoded public key
+func EdDSAWithPublicKeyBytes(data *common.SignatureData, pubKey []byte) error {
+	pub, err := ParseEdDSAPublicKey(pubKey)
+	if err != nil {
+		return err
+	}
+	return EdDSA(data, pub)
+}
+
+// RecoverECDSAPublicKey recovers the public key from an ECDSA signature, its recovery id and SignatureData.M.
+// It supports secp256k1 and the NIST curves of crypto/elliptic, which have a cofactor of 1.
+func RecoverECDSAPublicKey(ec elliptic.Curve, data *common.SignatureData) (*crypto.ECPoint, error) {
+	a, err := weierstrassA(ec)
+	if err != nil {
+		return nil, err
+	}
+	r, s, err := rs(data)
+	if err != nil {
+		return nil, err
+	}
+	if len(data.GetSignatureRecovery()) == 0 {
+		return nil, errors.New("the signature data has no recovery id")
+	}
+	recid := uint(data.GetSignatureRecovery()[0])
+	if recid > 3 {
+		return nil, errors.New("the signature data has an invalid recovery id")
+	}
+	N, P := ec.Params().N, ec.Params().P
+
+	// 1. R.x = r + (recid / 2) * N and R.y is the root with the parity of recid % 2
+	Rx := new(big.Int).Set(r)
+	if recid&2 != 0 {
+		Rx.Add(Rx, N)
+	}
+	if Rx.Cmp(P) >= 0 {
+		return nil, errors.New("the recovery id does not match a point on the curve")
+	}
+	Ry, err := decompressY(ec, a, Rx, recid&1 == 1)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. Q = r^-1 (sR - eG)
+	e := hashToInt(data.GetM(), ec)
+	sRx, sRy := ec.ScalarMult(Rx, Ry, s.Bytes())
+	eGx, eGy := ec.ScalarBaseMult(e.Bytes())
+	eGy.Sub(P, eGy)
+	Qx, Qy := ec.Add(sRx, sRy, eGx, eGy)
+	Qx, Qy = ec.ScalarMult(Qx, Qy, new(big.Int).ModInverse(r, N).Bytes())
+	return crypto.NewECPoint(ec, Qx, Qy)
+}
+
+// ParseECDSAPublicKey parses a SEC1 compressed (33-byte) or uncompressed (65-byte) public key on secp256k1 or one of
+// the NIST curves of crypto/elliptic
+func ParseECDSAPublicKey(ec elliptic.Curve, pubKey []byte) (*crypto.ECPoint, error) {
+	a, err := weierstrassA(ec)
+	if err != nil {
+		return nil, err
+	}
+	byteLen := (ec.Params().BitSize + 7) / 8
+	switch {
+	case len(pubKey) == 1+2*byteLen && pubKey[0] == 0x04:
+		x := new(big.Int).SetBytes(pubKey[1 : 1+byteLen])
+		y := new(big.Int).SetBytes(pubKey[1+byteLen:])
+		return crypto.NewECPoint(ec, x, y)
+	case len(pubKey) == 1+byteLen && (pubKey[0] == 0x02 || pubKey[0] == 0x03):
+		x := new(big.Int).SetBytes(pubKey[1:])
+		y, err := decompressY(ec, a, x, pubKey[0] == 0x03)
+		if err != nil {
+			return nil, err
+		}
+		return crypto.NewECPoint(ec, x, y)
+	default:
+		return nil, errors.New("the public key is not a SEC1 compressed or uncompressed point")
+	}
+}
+
+// ParseEdDSAPublicKey parses a 32-byte encoded Ed25519 public key
+func ParseEdDSAPublicKey(pubKey []byte) (*crypto.ECPoint, error) {
+	pk, err := edwards.ParsePubKey(pubKey)
+	if err != nil {
+		return nil, err
+	}
+	return crypto.NewECPoint(edwards.Edwards(), pk.X, pk.Y)
+}
+
+// ----- //
+
+func rs(data *common.SignatureData) (r, s *big.Int, err error) {
+	if data == nil || len(data.GetR()) == 0 || len(data.GetS()) == 0 {
+		return nil, nil, errors.New("the signature data has no R or S")
+	}
+	return new(big.Int).SetBytes(data.GetR()), new(big.Int).SetBytes(data.GetS()), nil
+}
+
+// weierstrassA returns the coefficient a of y^2 = x^3 + ax + b for the curves whose points are decompressed here.
+// Other curves, e.g. tss.Edwards(), are rejected rather than decoded with the wrong equation.
+func weierstrassA(ec elliptic.Curve) (*big.Int, error) {
+	if _, ok := ec.(*btcec.KoblitzCurve); ok {
+		return big.NewInt(0), nil
+	}
+	switch ec {
+	case elliptic.P224(), elliptic.P256(), elliptic.P384(), elliptic.P521():
+		return big.NewInt(-3), nil
+	}
+	return nil, errors.New("only secp256k1 and the NIST curves are supported")
+}
+
+// decompressY returns the y coordinate for x with the given parity, from y^2 = x^3 + ax + b
+func decompressY(ec elliptic.Curve, a, x *big.Int, odd bool) (*big.Int, error) {
+	P := ec.Params().P
+	y2 := new(big.Int).Exp(x, big.NewInt(3), P)
+	y2.Add(y2, new(big.Int).Mul(a, x))
+	y2.Add(y2, ec.Params().B)
+	y2.Mod(y2, P)
+	y := new(big.Int).ModSqrt(y2, P)
+	if y == nil {
+		return nil, errors.New("the x coordinate is not on the curve")
+	}
+	if (y.Bit(0) == 1) != odd {
+		y.Sub(P, y)
+	}
+	return y, nil
+}
+
+// hashToInt converts a digest to an integer as crypto/ecdsa does, truncating it to the bit length of the curve order
+func hashToInt(hash []byte, ec elliptic.Curve) *big.Int {
+	orderBits := ec.Params().N.BitLen()
+	orderBytes := (orderBits + 7) / 8
+	if len(hash) > orderBytes {
+		hash = hash[:orderBytes]
+	}
+	ret := new(big.Int).SetBytes(hash)
+	if excess := len(hash)*8 - orderBits; excess > 0 {
+		ret.Rsh(ret, uint(excess))
+	}
+	return ret
+}
@@ -0,0 +1,132 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package verify_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"math/big"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/decred/dcrd/dcrec/edwards/v2"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bnb-chain/tss-lib/common"
+	"github.com/bnb-chain/tss-lib/crypto"
+	"github.com/bnb-chain/tss-lib/tss"
+	. "github.com/bnb-chain/tss-lib/verify"
+)
+
+// ecdsaSignature signs with crypto/ecdsa and returns the public key and SignatureData, without a recovery id
+func ecdsaSignature(t *testing.T, ec elliptic.Curve) (*crypto.ECPoint, *common.SignatureData) {
+	sk, err := ecdsa.GenerateKey(ec, rand.Reader)
+	assert.NoError(t, err)
+	digest := sha256.Sum256([]byte("tss-lib verify"))
+	r, s, err := ecdsa.Sign(rand.Reader, sk, digest[:])
+	assert.NoError(t, err)
+	pub, err := crypto.NewECPoint(ec, sk.X, sk.Y)
+	assert.NoError(t, err)
+	return pub, &common.SignatureData{
+		R: r.FillBytes(make([]byte, 32)),
+		S: s.FillBytes(make([]byte, 32)),
+		M: digest[:],
+	}
+}
+
+func TestECDSAAndRecovery(t *testing.T) {
+	for _, ec := range []elliptic.Curve{tss.S256(), tss.P256()} {
+		pub, data := ecdsaSignature(t, ec)
+		assert.NoError(t, ECDSA(data, pub))
+
+		// exactly one of the two y parities recovers the public key
+		recovered := 0
+		for recid := byte(0); recid < 2; recid++ {
+			data.SignatureRecovery = []byte{recid}
+			Q, err := RecoverECDSAPublicKey(ec, data)
+			assert.NoError(t, err)
+			if Q.Equals(pub) {
+				recovered++
+				assert.NoError(t, ECDSA(data, pub))
+			} else {
+				assert.Error(t, ECDSA(data, pub), "an inconsistent recovery id should be rejected")
+			}
+		}
+		assert.Equal(t, 1, recovered, "the public key should be recoverable")
+
+		data.M = []byte("another digest")
+		assert.Error(t, ECDSA(data, pub), "a signature over another message should be rejected")
+	}
+}
+
+func TestRecoverECDSAPublicKeyMatchesBtcec(t *testing.T) {
+	sk, err := btcec.NewPrivateKey(btcec.S256())
+	assert.NoError(t, err)
+	digest := sha256.Sum256([]byte("tss-lib verify"))
+	compact, err := btcec.SignCompact(btcec.S256(), sk, digest[:], false)
+	assert.NoError(t, err)
+	data := &common.SignatureData{
+		R:                 compact[1:33],
+		S:                 compact[33:],
+		SignatureRecovery: []byte{compact[0] - 27},
+		M:                 digest[:],
+	}
+	Q, err := RecoverECDSAPublicKey(tss.S256(), data)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, Q.X().Cmp(sk.PubKey().X))
+	assert.Equal(t, 0, Q.Y().Cmp(sk.PubKey().Y))
+
+	// verify against the serialized forms of the public key
+	assert.NoError(t, ECDSAWithPublicKeyBytes(tss.S256(), data, sk.PubKey().SerializeCompressed()))
+	assert.NoError(t, ECDSAWithPublicKeyBytes(tss.S256(), data, sk.PubKey().SerializeUncompressed()))
+	assert.Error(t, ECDSAWithPublicKeyBytes(tss.S256(), data, sk.PubKey().SerializeCompressed()[1:]))
+}
+
+func TestParseECDSAPublicKeyP256(t *testing.T) {
+	sk, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+	for _, bz := range [][]byte{
+		elliptic.Marshal(elliptic.P256(), sk.X, sk.Y),
+		elliptic.MarshalCompressed(elliptic.P256(), sk.X, sk.Y),
+	} {
+		pub, err := ParseECDSAPublicKey(tss.P256(), bz)
+		assert.NoError(t, err)
+		assert.Equal(t, 0, pub.X().Cmp(sk.X))
+		assert.Equal(t, 0, pub.Y().Cmp(sk.Y))
+	}
+}
+
+func TestParseECDSAPublicKeyOtherCurve(t *testing.T) {
+	sk, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+	_, err = ParseECDSAPublicKey(tss.Edwards(), elliptic.MarshalCompressed(elliptic.P256(), sk.X, sk.Y))
+	assert.EqualError(t, err, "only secp256k1 and the NIST curves are supported")
+	_, err = RecoverECDSAPublicKey(tss.Edwards(), &common.SignatureData{R: []byte{1}, S: []byte{1}, SignatureRecovery: []byte{0}})
+	assert.Error(t, err)
+}
+
+func TestEdDSA(t *testing.T) {
+	sk, err := edwards.GeneratePrivateKey()
+	assert.NoError(t, err)
+	msg := []byte{0x00, 0x01, 0x02}
+	r, s, err := edwards.Sign(sk, msg)
+	assert.NoError(t, err)
+	data := &common.SignatureData{
+		Signature: edwards.NewSignature(r, s).Serialize(),
+		M:         msg,
+	}
+	pk := sk.PubKey()
+	pub, err := crypto.NewECPoint(tss.Edwards(), pk.X, pk.Y)
+	assert.NoError(t, err)
+	assert.NoError(t, EdDSA(data, pub))
+	assert.NoError(t, EdDSAWithPublicKeyBytes(data, pk.Serialize()))
+
+	data.M = big.NewInt(0x0102).Bytes()
+	assert.Error(t, EdDSA(data, pub), "dropping leading zeros of the message should break the signature")
+}